@@ -2,17 +2,20 @@ package app
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/kont1n/MSA_Rocket_Factory/assembly/internal/config"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/admin"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 )
 
 type App struct {
 	diContainer *diContainer
+	adminServer *admin.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -41,6 +44,13 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Служебный сервер
+	go func() {
+		if err := a.runAdminServer(ctx); err != nil {
+			errCh <- errors.Errorf("admin server crashed: %v", err)
+		}
+	}()
+
 	// Ожидание либо ошибки, либо завершения контекста (например, сигнал SIGINT/SIGTERM)
 	select {
 	case <-ctx.Done():
@@ -62,6 +72,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initAdminServer,
 	}
 
 	for _, f := range inits {
@@ -80,14 +91,50 @@ func (a *App) initDI(_ context.Context) error {
 }
 
 func (a *App) initLogger(_ context.Context) error {
+	cfg := config.AppConfig().Logger
+
 	return logger.Init(
-		config.AppConfig().Logger.Level(),
-		config.AppConfig().Logger.AsJson(),
+		cfg.Level(),
+		cfg.AsJson(),
+		logger.WithServiceName("assembly"),
+		logger.WithModuleLevels(cfg.ModuleLevels()),
+		logger.WithOutputs(cfg.Outputs()...),
+		logger.WithFile(logger.FileConfig{
+			Path:       cfg.FilePath(),
+			MaxSizeMB:  cfg.FileMaxSizeMB(),
+			MaxBackups: cfg.FileMaxBackups(),
+		}),
+		logger.WithOTLP(cfg.OTLPEndpoint()),
+		logger.WithStacktraceRate(cfg.StacktraceRate()),
 	)
 }
 
+func (a *App) initAdminServer(ctx context.Context) error {
+	a.adminServer = admin.New(
+		config.AppConfig().Admin.Address(),
+		admin.WithServiceName("assembly"),
+		admin.WithHealth(a.diContainer.HealthRegistry(ctx)),
+		admin.WithConfig(config.AppConfig().Effective),
+	)
+
+	// Служебный сервер останавливаем последним, чтобы health, метрики и pprof были доступны во время завершения
+	closer.AddPhase(closer.PhaseResources, "Admin server", a.adminServer.Stop)
+
+	return nil
+}
+
+func (a *App) runAdminServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🛠️ Admin server listening on %s", config.AppConfig().Admin.Address()))
+
+	return a.adminServer.Start(ctx)
+}
+
 func (a *App) initCloser(_ context.Context) error {
 	closer.SetLogger(logger.Logger())
+	closer.SetTimeouts(
+		config.AppConfig().Shutdown.Timeout(),
+		config.AppConfig().Shutdown.StepTimeout(),
+	)
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	assemblyConsumer "github.com/kont1n/MSA_Rocket_Factory/assembly/internal/service/consumer"
 	assemblyProducer "github.com/kont1n/MSA_Rocket_Factory/assembly/internal/service/producer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
 	wrappedKafka "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/producer"
@@ -32,6 +33,8 @@ type diContainer struct {
 	assemblyRecordedDecoder  kafkaConverter.AssemblyRecordedDecoder
 	syncProducer             sarama.SyncProducer
 	assemblyRecordedProducer wrappedKafka.Producer
+
+	healthRegistry *health.Registry
 }
 
 func NewDiContainer() *diContainer {
@@ -72,7 +75,7 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 		if err != nil {
 			panic(fmt.Sprintf("failed to create consumer group: %s\n", err.Error()))
 		}
-		closer.AddNamed("Kafka consumer group", func(ctx context.Context) error {
+		closer.AddPhase(closer.PhaseConsumers, "Kafka consumer group", func(ctx context.Context) error {
 			return d.consumerGroup.Close()
 		})
 
@@ -84,13 +87,16 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 
 func (d *diContainer) AssemblyRecordedConsumer() wrappedKafka.Consumer {
 	if d.assemblyRecordedConsumer == nil {
-		d.assemblyRecordedConsumer = wrappedKafkaConsumer.NewConsumer(
+		d.assemblyRecordedConsumer = wrappedKafkaConsumer.NewConcurrentConsumer(
 			d.ConsumerGroup(),
 			[]string{
 				config.AppConfig().AssemblyRecordedConsumer.Topic(),
 			},
-			logger.Logger(),
-			kafkaMiddleware.Logging(logger.Logger()),
+			logger.Named("kafka"),
+			config.AppConfig().AssemblyRecordedConsumer.Workers(),
+			kafkaMiddleware.Recover(logger.Named("kafka")),
+			kafkaMiddleware.Timeout(config.AppConfig().AssemblyRecordedConsumer.HandlerTimeout()),
+			kafkaMiddleware.Logging(logger.Named("kafka")),
 		)
 	}
 
@@ -114,7 +120,7 @@ func (d *diContainer) SyncProducer() sarama.SyncProducer {
 		if err != nil {
 			panic(fmt.Sprintf("failed to create sync producer: %s\n", err.Error()))
 		}
-		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+		closer.AddPhase(closer.PhaseProducers, "Kafka sync producer", func(ctx context.Context) error {
 			return p.Close()
 		})
 
@@ -129,9 +135,30 @@ func (d *diContainer) AssemblyRecordedProducer() wrappedKafka.Producer {
 		d.assemblyRecordedProducer = wrappedKafkaProducer.NewProducer(
 			d.SyncProducer(),
 			config.AppConfig().AssemblyRecordedProducer.Topic(),
-			logger.Logger(),
+			logger.Named("kafka"),
 		)
 	}
 
 	return d.assemblyRecordedProducer
 }
+
+func (d *diContainer) HealthRegistry(_ context.Context) *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+		registry.Register("kafka", health.Readiness, health.KafkaCheck(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().AssemblyRecordedConsumer.Config(),
+		))
+
+		watchCtx, cancel := context.WithCancel(context.Background())
+		registry.Start(watchCtx)
+		closer.AddNamed("Health checks", func(ctx context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
@@ -1,22 +1,27 @@
 package env
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
-	"github.com/caarlos0/env/v11"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type assemblyConsumerEnvConfig struct {
-	Topic   string `env:"CONSUMER_TOPIC_NAME,required"`
-	GroupID string `env:"CONSUMER_GROUP_ID,required"`
+	Topic          string        `env:"CONSUMER_TOPIC_NAME,required" desc:"Топик, из которого читает consumer"`
+	GroupID        string        `env:"CONSUMER_GROUP_ID,required" desc:"Группа потребителей"`
+	Workers        int           `env:"CONSUMER_WORKERS" envDefault:"1" validate:"min=1,max=256" desc:"Число параллельных обработчиков на партицию"`
+	HandlerTimeout time.Duration `env:"CONSUMER_HANDLER_TIMEOUT" envDefault:"30s" validate:"min=1s" desc:"Максимальное время обработки одного сообщения"`
 }
 
 type assemblyConsumerConfig struct {
 	raw assemblyConsumerEnvConfig
 }
 
-func NewAssemblyRecordedConsumerConfig() (*assemblyConsumerConfig, error) {
+func NewAssemblyRecordedConsumerConfig(loader *platformConfig.Loader) (*assemblyConsumerConfig, error) {
 	var raw assemblyConsumerEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -31,6 +36,14 @@ func (cfg *assemblyConsumerConfig) GroupID() string {
 	return cfg.raw.GroupID
 }
 
+func (cfg *assemblyConsumerConfig) Workers() int {
+	return cfg.raw.Workers
+}
+
+func (cfg *assemblyConsumerConfig) HandlerTimeout() time.Duration {
+	return cfg.raw.HandlerTimeout
+}
+
 func (cfg *assemblyConsumerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V4_0_0_0
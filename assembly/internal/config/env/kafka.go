@@ -1,20 +1,18 @@
 package env
 
-import (
-	"github.com/caarlos0/env/v11"
-)
+import platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 
 type kafkaEnvConfig struct {
-	Brokers []string `env:"KAFKA_BROKERS,required"`
+	Brokers []string `env:"KAFKA_BROKERS,required" validate:"min=1,hostport" desc:"Адреса брокеров Kafka"`
 }
 
 type kafkaConfig struct {
 	raw kafkaEnvConfig
 }
 
-func NewKafkaConfig() (*kafkaConfig, error) {
+func NewKafkaConfig(loader *platformConfig.Loader) (*kafkaConfig, error) {
 	var raw kafkaEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -2,20 +2,21 @@ package env
 
 import (
 	"github.com/IBM/sarama"
-	"github.com/caarlos0/env/v11"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type assemblyProducerEnvConfig struct {
-	TopicName string `env:"PRODUCER_TOPIC_NAME,required"`
+	TopicName string `env:"PRODUCER_TOPIC_NAME,required" desc:"Топик, в который пишет producer"`
 }
 
 type assemblyProducerConfig struct {
 	raw assemblyProducerEnvConfig
 }
 
-func NewAssemblyRecordedProducerConfig() (*assemblyProducerConfig, error) {
+func NewAssemblyRecordedProducerConfig(loader *platformConfig.Loader) (*assemblyProducerConfig, error) {
 	var raw assemblyProducerEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -1,10 +1,21 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
+	ModuleLevels() map[string]string
+	Outputs() []string
+	FilePath() string
+	FileMaxSizeMB() int
+	FileMaxBackups() int
+	OTLPEndpoint() string
+	StacktraceRate() int
 }
 
 type KafkaConfig interface {
@@ -19,5 +30,16 @@ type AssemblyProducerConfig interface {
 type AssemblyConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	Workers() int
+	HandlerTimeout() time.Duration
 	Config() *sarama.Config
 }
+
+type ShutdownConfig interface {
+	Timeout() time.Duration
+	StepTimeout() time.Duration
+}
+
+type AdminConfig interface {
+	Address() string
+}
@@ -7,16 +7,30 @@ import (
 
 	"github.com/kont1n/MSA_Rocket_Factory/assembly/internal/converter"
 	"github.com/kont1n/MSA_Rocket_Factory/assembly/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
 	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
 )
 
-type decoder struct{}
+type decoder struct {
+	registry *kafkaEvent.Registry[model.OrderPaidEvent]
+}
 
 func NewAssemblyRecordedDecoder() *decoder {
-	return &decoder{}
+	// Сообщения без заголовков CloudEvents считаются событиями версии v1
+	registry := kafkaEvent.NewRegistry[model.OrderPaidEvent](events.OrderPaidType, events.SchemaVersionV1).
+		Register(events.OrderPaidType, events.SchemaVersionV1, decodeOrderPaidV1)
+
+	return &decoder{registry: registry}
+}
+
+// Decode выбирает декодер по типу и версии события из заголовков сообщения.
+func (d *decoder) Decode(msg kafka.Message) (model.OrderPaidEvent, error) {
+	return d.registry.Decode(msg)
 }
 
-func (d *decoder) Decode(data []byte) (model.OrderPaidEvent, error) {
+func decodeOrderPaidV1(data []byte) (model.OrderPaidEvent, error) {
 	var pb eventsV1.OrderPaid
 	if err := proto.Unmarshal(data, &pb); err != nil {
 		return model.OrderPaidEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
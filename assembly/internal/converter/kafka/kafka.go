@@ -1,7 +1,10 @@
 package kafka
 
-import "github.com/kont1n/MSA_Rocket_Factory/assembly/internal/model"
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/assembly/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+)
 
 type AssemblyRecordedDecoder interface {
-	Decode(data []byte) (model.OrderPaidEvent, error)
+	Decode(msg kafka.Message) (model.OrderPaidEvent, error)
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func (s *service) OrderPaidHandler(ctx context.Context, msg kafka.Message) error {
-	event, err := s.assemblyRecordedDecoder.Decode(msg.Value)
+	event, err := s.assemblyRecordedDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode OrderPaid", zap.Error(err))
 		return err
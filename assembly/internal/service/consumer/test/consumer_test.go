@@ -59,7 +59,7 @@ func (s *ConsumerServiceSuite) TestOrderPaidHandler_Success() {
 	}
 
 	// Настраиваем моки
-	s.assemblyRecordedDecoder.DecodeFunc = func(data []byte) (model.OrderPaidEvent, error) {
+	s.assemblyRecordedDecoder.DecodeFunc = func(msg kafkaPkg.Message) (model.OrderPaidEvent, error) {
 		return event, nil
 	}
 
@@ -89,7 +89,7 @@ func (s *ConsumerServiceSuite) TestOrderPaidHandler_DecodeError() {
 
 	// Настраиваем мок для ошибки декодирования
 	expectedError := errors.New("decode error")
-	s.assemblyRecordedDecoder.DecodeFunc = func(data []byte) (model.OrderPaidEvent, error) {
+	s.assemblyRecordedDecoder.DecodeFunc = func(msg kafkaPkg.Message) (model.OrderPaidEvent, error) {
 		return model.OrderPaidEvent{}, expectedError
 	}
 
@@ -123,7 +123,7 @@ func (s *ConsumerServiceSuite) TestOrderPaidHandler_AssembleError() {
 	}
 
 	// Настраиваем моки
-	s.assemblyRecordedDecoder.DecodeFunc = func(data []byte) (model.OrderPaidEvent, error) {
+	s.assemblyRecordedDecoder.DecodeFunc = func(msg kafkaPkg.Message) (model.OrderPaidEvent, error) {
 		return event, nil
 	}
 
@@ -33,12 +33,12 @@ func (m *MockConsumer) Consume(ctx context.Context, handler kafkaPkg.MessageHand
 }
 
 type MockAssemblyRecordedDecoder struct {
-	DecodeFunc func(data []byte) (model.OrderPaidEvent, error)
+	DecodeFunc func(msg kafkaPkg.Message) (model.OrderPaidEvent, error)
 }
 
-func (m *MockAssemblyRecordedDecoder) Decode(data []byte) (model.OrderPaidEvent, error) {
+func (m *MockAssemblyRecordedDecoder) Decode(msg kafkaPkg.Message) (model.OrderPaidEvent, error) {
 	if m.DecodeFunc != nil {
-		return m.DecodeFunc(data)
+		return m.DecodeFunc(msg)
 	}
 	return model.OrderPaidEvent{}, nil
 }
@@ -2,16 +2,22 @@ package producer
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/kont1n/MSA_Rocket_Factory/assembly/internal/model"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
 	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
 )
 
+// eventSource — источник событий в заголовке ce_source.
+const eventSource = "assembly"
+
 type service struct {
 	assemblyProducer kafka.Producer
 }
@@ -36,7 +42,16 @@ func (p *service) ProduceAssembly(ctx context.Context, event model.ShipAssembled
 		return err
 	}
 
-	err = p.assemblyProducer.Send(ctx, []byte(event.EventUUID.String()), payload)
+	meta := kafkaEvent.Meta{
+		OccurredAt:    time.Now(),
+		ID:            event.EventUUID.String(),
+		Type:          events.ShipAssembledType,
+		SchemaVersion: events.SchemaVersionV1,
+		Source:        eventSource,
+		CorrelationID: event.OrderUUID.String(),
+	}
+
+	err = p.assemblyProducer.SendWithHeaders(ctx, []byte(event.EventUUID.String()), payload, meta.Headers())
 	if err != nil {
 		logger.Error(ctx, model.ErrSendToKafka.Error(), zap.Error(err))
 		return err
@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kont1n/MSA_Rocket_Factory/assembly/internal/model"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
 )
 
 func (s *ProducerServiceSuite) TestProduceAssembly_Success() {
@@ -20,11 +22,15 @@ func (s *ProducerServiceSuite) TestProduceAssembly_Success() {
 	}
 
 	// Настраиваем мок для успешной отправки
-	s.assemblyProducer.SendFunc = func(ctx context.Context, key, value []byte) error {
+	s.assemblyProducer.SendWithHeadersFunc = func(ctx context.Context, key, value []byte, headers map[string][]byte) error {
 		// Проверяем, что ключ соответствует EventUUID
 		assert.Equal(s.T(), event.EventUUID.String(), string(key))
 		// Проверяем, что value не пустой (protobuf сообщение)
 		assert.NotEmpty(s.T(), value)
+		// Проверяем метаданные события в заголовках
+		assert.Equal(s.T(), events.ShipAssembledType, string(headers[kafkaEvent.HeaderType]))
+		assert.Equal(s.T(), events.SchemaVersionV1, string(headers[kafkaEvent.HeaderSchemaVersion]))
+		assert.Equal(s.T(), event.OrderUUID.String(), string(headers[kafkaEvent.HeaderCorrelationID]))
 		return nil
 	}
 
@@ -47,7 +53,7 @@ func (s *ProducerServiceSuite) TestProduceAssembly_ProducerError() {
 
 	// Настраиваем мок для ошибки отправки
 	expectedError := errors.New("send error")
-	s.assemblyProducer.SendFunc = func(ctx context.Context, key, value []byte) error {
+	s.assemblyProducer.SendWithHeadersFunc = func(ctx context.Context, key, value []byte, headers map[string][]byte) error {
 		return expectedError
 	}
 
@@ -18,7 +18,8 @@ type ProducerServiceSuite struct {
 }
 
 type MockProducer struct {
-	SendFunc func(ctx context.Context, key, value []byte) error
+	SendFunc            func(ctx context.Context, key, value []byte) error
+	SendWithHeadersFunc func(ctx context.Context, key, value []byte, headers map[string][]byte) error
 }
 
 func (m *MockProducer) Send(ctx context.Context, key, value []byte) error {
@@ -28,6 +29,13 @@ func (m *MockProducer) Send(ctx context.Context, key, value []byte) error {
 	return nil
 }
 
+func (m *MockProducer) SendWithHeaders(ctx context.Context, key, value []byte, headers map[string][]byte) error {
+	if m.SendWithHeadersFunc != nil {
+		return m.SendWithHeadersFunc(ctx, key, value, headers)
+	}
+	return nil
+}
+
 func (s *ProducerServiceSuite) SetupSuite() {
 	// Инициализируем logger для тестов
 	if err := logger.Init("debug", false); err != nil {
@@ -41,6 +49,7 @@ func (s *ProducerServiceSuite) SetupSuite() {
 func (s *ProducerServiceSuite) SetupTest() {
 	// Сбрасываем моки перед каждым тестом
 	s.assemblyProducer.SendFunc = nil
+	s.assemblyProducer.SendWithHeadersFunc = nil
 }
 
 func (s *ProducerServiceSuite) TearDownSuite() {
@@ -0,0 +1,66 @@
+#!/bin/bash
+# Генерирует локальный CA и сертификаты сервисов для mTLS в dev-окружении.
+# Сертификаты пригодны и для сервера, и для клиента (serverAuth + clientAuth).
+# Использование: ./gen-dev-certs.sh [каталог] (по умолчанию deploy/tls/certs)
+# Закрытые ключи доступны только владельцу (600). Если процесс сервиса в контейнере работает
+# не от владельца файлов (order-service — appuser из группы 1001), задайте TLS_KEY_GROUP:
+# ключи сервисов получат эту группу и права 640
+
+set -euo pipefail
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+OUT_DIR="${1:-$SCRIPT_DIR/certs}"
+DAYS="${TLS_DEV_CERT_DAYS:-365}"
+SERVICES=(inventory-service payment-service order-service)
+KEY_GROUP="${TLS_KEY_GROUP:-}"
+
+if ! command -v openssl &> /dev/null; then
+  echo "❌ Ошибка: openssl не найден в системе!"
+  exit 1
+fi
+
+mkdir -p "$OUT_DIR"
+
+if [ ! -f "$OUT_DIR/ca.pem" ]; then
+  echo "🔐 Создаём локальный CA..."
+  openssl ecparam -name prime256v1 -genkey -noout -out "$OUT_DIR/ca-key.pem"
+  openssl req -x509 -new -key "$OUT_DIR/ca-key.pem" -sha256 -days "$DAYS" \
+    -subj "/O=Rocket Factory/CN=Rocket Factory Dev CA" \
+    -out "$OUT_DIR/ca.pem"
+  chmod 600 "$OUT_DIR/ca-key.pem"
+else
+  echo "ℹ️ CA уже существует, используем $OUT_DIR/ca.pem"
+fi
+
+for service in "${SERVICES[@]}"; do
+  echo "📜 Выпускаем сертификат для $service..."
+
+  ext_file="$(mktemp)"
+  cat > "$ext_file" <<EXT
+basicConstraints = CA:FALSE
+keyUsage = digitalSignature, keyEncipherment
+extendedKeyUsage = serverAuth, clientAuth
+subjectAltName = DNS:${service}, DNS:localhost, IP:127.0.0.1
+EXT
+
+  openssl ecparam -name prime256v1 -genkey -noout -out "$OUT_DIR/$service-key.pem"
+  openssl req -new -key "$OUT_DIR/$service-key.pem" \
+    -subj "/O=Rocket Factory/CN=$service" \
+    -out "$OUT_DIR/$service.csr"
+  openssl x509 -req -in "$OUT_DIR/$service.csr" \
+    -CA "$OUT_DIR/ca.pem" -CAkey "$OUT_DIR/ca-key.pem" -CAcreateserial \
+    -days "$DAYS" -sha256 -extfile "$ext_file" \
+    -out "$OUT_DIR/$service.pem"
+
+  rm -f "$ext_file" "$OUT_DIR/$service.csr"
+  if [ -n "$KEY_GROUP" ]; then
+    chgrp "$KEY_GROUP" "$OUT_DIR/$service-key.pem"
+    chmod 640 "$OUT_DIR/$service-key.pem"
+  else
+    chmod 600 "$OUT_DIR/$service-key.pem"
+  fi
+done
+
+rm -f "$OUT_DIR/ca.srl"
+
+echo "✅ Сертификаты созданы в $OUT_DIR"
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/app"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/catalog"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
+)
+
+// stdio — имя файла, означающее stdin для import и stdout для export
+const stdio = "-"
+
+func newImportCommand() *cobra.Command {
+	var (
+		file       string
+		format     string
+		dryRun     bool
+		errorsFile string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import parts from CSV, JSON Lines or YAML with upsert by part_uuid",
+		Example: "  inventory import --file parts.csv --dry-run\n" +
+			"  inventory import --file parts.yaml --errors-file rejected.jsonl",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: loadCLIConfig,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			catalogFormat, err := catalog.ParseFormat(format, file)
+			if err != nil {
+				return err
+			}
+
+			in, err := openInput(file)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = in.Close() }()
+
+			rows, err := catalog.Read(in, catalogFormat)
+			if err != nil {
+				return err
+			}
+
+			report, err := withPartService(cmd.Context(), func(ctx context.Context, partService service.InventoryService) (*catalog.Report, error) {
+				return catalog.NewImporter(partService, dryRun).Import(ctx, rows)
+			})
+			if err != nil {
+				return err
+			}
+
+			if err = report.WriteDiff(cmd.OutOrStdout()); err != nil {
+				return err
+			}
+
+			if errorsFile != "" {
+				if err = writeErrorsFile(errorsFile, report); err != nil {
+					return err
+				}
+			}
+
+			if failed := report.Count(catalog.ActionFailed); failed > 0 {
+				return fmt.Errorf("%d of %d rows were rejected", failed, len(report.Entries))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", `catalog file, "-" for stdin`)
+	cmd.Flags().StringVar(&format, "format", "", "csv, jsonl or yaml; derived from the file extension when omitted")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "validate and print the diff without writing anything")
+	cmd.Flags().StringVar(&errorsFile, "errors-file", "", "write rejected rows as JSON Lines to this file")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func newExportCommand() *cobra.Command {
+	var (
+		file          string
+		format        string
+		categories    []string
+		manufacturers []string
+		tags          []string
+	)
+
+	cmd := &cobra.Command{
+		Use:               "export",
+		Short:             "Export parts to CSV, JSON Lines or YAML",
+		Example:           "  inventory export --file parts.yaml\n  inventory export --format jsonl --category ENGINE > engines.jsonl",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: loadCLIConfig,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format == "" && file == stdio {
+				format = string(catalog.FormatJSONL)
+			}
+
+			catalogFormat, err := catalog.ParseFormat(format, file)
+			if err != nil {
+				return err
+			}
+
+			filter := &model.Filter{ManufacturerNames: manufacturers, Tags: tags}
+			for _, name := range categories {
+				category, ok := model.ParseCategory(name)
+				if !ok {
+					return fmt.Errorf("unknown category %q", name)
+				}
+				filter.Categories = append(filter.Categories, category)
+			}
+
+			out, err := openOutput(file)
+			if err != nil {
+				return err
+			}
+
+			writer, err := catalog.NewWriter(out, catalogFormat)
+			if err != nil {
+				return errors.Join(err, out.Close())
+			}
+
+			count, err := withPartService(cmd.Context(), func(ctx context.Context, partService service.InventoryService) (int, error) {
+				return catalog.Export(ctx, partService, filter, writer)
+			})
+			if err = errors.Join(err, out.Close()); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.ErrOrStderr(), "exported: %d\n", count)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", stdio, `output file, "-" for stdout`)
+	cmd.Flags().StringVar(&format, "format", "", "csv, jsonl or yaml; derived from the file extension when omitted")
+	cmd.Flags().StringSliceVar(&categories, "category", nil, "export only these categories")
+	cmd.Flags().StringSliceVar(&manufacturers, "manufacturer", nil, "export only parts of these manufacturers")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "export only parts with any of these tags")
+
+	return cmd
+}
+
+// withPartService поднимает сервис деталей поверх MongoDB из конфигурации
+// и закрывает подключения после выполнения fn
+func withPartService[T any](ctx context.Context, fn func(context.Context, service.InventoryService) (T, error)) (T, error) {
+	ctx, done := withShutdown(ctx)
+	defer done()
+
+	return fn(ctx, app.NewDiContainer().PartService(ctx))
+}
+
+func openInput(path string) (io.ReadCloser, error) {
+	if path == stdio {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path) //nolint:gosec // путь задаёт оператор
+}
+
+func openOutput(path string) (io.WriteCloser, error) {
+	if path == stdio {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+func writeErrorsFile(path string, report *catalog.Report) error {
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(report.WriteErrors(out), out.Close())
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
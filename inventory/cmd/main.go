@@ -3,10 +3,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
+	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/app"
@@ -15,7 +16,36 @@ import (
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 )
 
-func init() {
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// newRootCommand собирает CLI: без подкоманды запускается сервис,
+// import/export работают с каталогом, config-reference печатает справочник конфигурации
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:               "inventory",
+		Short:             "Inventory service of the rocket factory",
+		SilenceUsage:      true,
+		PersistentPreRunE: loadConfig,
+		RunE:              runService,
+		CompletionOptions: cobra.CompletionOptions{DisableDefaultCmd: true},
+	}
+
+	root.AddCommand(
+		newConfigReferenceCommand(),
+		newImportCommand(),
+		newExportCommand(),
+		newMigrateCommand(),
+	)
+
+	return root
+}
+
+// loadConfig загружает конфигурацию перед запуском сервиса или подкоманды
+func loadConfig(_ *cobra.Command, _ []string) error {
 	// В Docker контейнере используем переменные окружения
 	// В локальной разработке пытаемся загрузить .env файл
 	configPath := "../deploy/compose/inventory/.env"
@@ -24,13 +54,39 @@ func init() {
 		// Если .env файл не найден, пробуем загрузить конфигурацию из переменных окружения
 		err = config.Load()
 		if err != nil {
-			panic(fmt.Errorf("failed to load config: %w", err))
+			return fmt.Errorf("failed to load config: %w", err)
 		}
 	}
+
+	return nil
 }
 
-func main() {
-	appCtx, appCancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+// loadCLIConfig — loadConfig для служебных команд: их результат печатается в stdout,
+// поэтому логгер сервиса в них не нужен
+func loadCLIConfig(cmd *cobra.Command, args []string) error {
+	logger.SetNopLogger()
+	return loadConfig(cmd, args)
+}
+
+// newConfigReferenceCommand — справочник ключей конфигурации: go run ./cmd config-reference
+func newConfigReferenceCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "config-reference",
+		Short: "Print configuration reference in markdown",
+		Args:  cobra.NoArgs,
+		// Справочнику не нужна загруженная конфигурация
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := config.WriteReference(cmd.OutOrStdout()); err != nil {
+				return fmt.Errorf("failed to write config reference: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func runService(cmd *cobra.Command, _ []string) error {
+	appCtx, appCancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
 	defer appCancel()
 	defer gracefulShutdown()
 
@@ -39,21 +95,40 @@ func main() {
 	a, err := app.New(appCtx)
 	if err != nil {
 		logger.Error(appCtx, "❌ Не удалось создать приложение", zap.Error(err))
-		return
+		return err
 	}
 
 	err = a.Run(appCtx)
 	if err != nil {
 		logger.Error(appCtx, "❌ Ошибка при работе приложения", zap.Error(err))
-		return
+		return err
+	}
+
+	return nil
+}
+
+// withShutdown отменяет контекст служебной команды по сигналу и возвращает функцию,
+// закрывающую подключения, зарегистрированные DI-контейнером в closer
+func withShutdown(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+
+	return ctx, func() {
+		cancel()
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), closer.ShutdownTimeout())
+		defer shutdownCancel()
+		_ = closer.CloseAll(shutdownCtx)
 	}
 }
 
 func gracefulShutdown() {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), closer.ShutdownTimeout())
 	defer cancel()
 
 	if err := closer.CloseAll(ctx); err != nil {
 		logger.Error(ctx, "❌ Ошибка при завершении работы", zap.Error(err))
 	}
+
+	// Логгер закрываем последним, чтобы в синки попал отчёт о завершении
+	_ = logger.Shutdown(ctx)
 }
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/app"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/migrator"
+)
+
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "migrate",
+		Short:             "Manage MongoDB schema migrations",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: loadCLIConfig,
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up",
+			Short: "Apply pending migrations",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				applied, err := withMigrator(cmd.Context(), func(ctx context.Context, m *migrator.Migrator) ([]migrator.Migration, error) {
+					return m.Up(ctx)
+				})
+
+				for _, migration := range applied {
+					_, _ = fmt.Fprintf(cmd.OutOrStdout(), "applied %d_%s\n", migration.Version, migration.Name)
+				}
+				if err != nil {
+					return err
+				}
+
+				if len(applied) == 0 {
+					_, err = fmt.Fprintln(cmd.OutOrStdout(), "no pending migrations")
+				}
+				return err
+			},
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "Show applied and pending migrations",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				statuses, err := withMigrator(cmd.Context(), func(ctx context.Context, m *migrator.Migrator) ([]migrator.Status, error) {
+					return m.Status(ctx)
+				})
+				if err != nil {
+					return err
+				}
+
+				out := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintln(out, "VERSION\tNAME\tAPPLIED AT")
+				for _, status := range statuses {
+					appliedAt := "pending"
+					if !status.AppliedAt.IsZero() {
+						appliedAt = status.AppliedAt.Format(time.RFC3339)
+					}
+					_, _ = fmt.Fprintf(out, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+				}
+
+				return out.Flush()
+			},
+		},
+	)
+
+	return cmd
+}
+
+// withMigrator подключается к MongoDB из конфигурации и закрывает подключение после выполнения fn
+func withMigrator[T any](ctx context.Context, fn func(context.Context, *migrator.Migrator) (T, error)) (T, error) {
+	ctx, done := withShutdown(ctx)
+	defer done()
+
+	return fn(ctx, app.NewDiContainer().MongoMigrator(ctx))
+}
@@ -0,0 +1,30 @@
+// Package fixtures содержит каталоги деталей, справочник складов, граф совместимости и наборы деталей, которыми заполняются пустые хранилища.
+// Файлы читаются тем же загрузчиком, что и `inventory import`.
+package fixtures
+
+import _ "embed"
+
+// Parts — стартовый каталог MongoDB
+//
+//go:embed parts.yaml
+var Parts []byte
+
+// InMemoryParts — каталог in-memory репозитория
+//
+//go:embed inmemory.yaml
+var InMemoryParts []byte
+
+// Warehouses — справочник складов
+//
+//go:embed warehouses.yaml
+var Warehouses []byte
+
+// Compatibility — рёбра графа совместимости деталей
+//
+//go:embed compatibility.yaml
+var Compatibility []byte
+
+// Kits — наборы деталей для сборки ракет
+//
+//go:embed kits.yaml
+var Kits []byte
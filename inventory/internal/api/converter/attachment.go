@@ -0,0 +1,80 @@
+package converter
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelAttachmentUpload конвертирует описание загружаемого вложения из protobuf
+func ToModelAttachmentUpload(info *inventoryV1.AttachmentInfo, content io.Reader) (*model.AttachmentUpload, error) {
+	partUuid, err := uuid.Parse(info.GetPartUuid())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid part_uuid", model.ErrInvalidAttachment)
+	}
+
+	return &model.AttachmentUpload{
+		PartUuid:       partUuid,
+		FileName:       info.GetFileName(),
+		ContentType:    info.GetContentType(),
+		Kind:           toModelAttachmentKind(info.GetKind()),
+		ChecksumSha256: info.GetChecksumSha256(),
+		Content:        content,
+	}, nil
+}
+
+func ToProtoAttachment(attachment *model.Attachment) *inventoryV1.Attachment {
+	return &inventoryV1.Attachment{
+		AttachmentUuid: attachment.AttachmentUuid.String(),
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		Kind:           toProtoAttachmentKind(attachment.Kind),
+		SizeBytes:      attachment.SizeBytes,
+		ChecksumSha256: attachment.ChecksumSha256,
+		CreatedAt:      timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func toProtoAttachments(attachments []model.Attachment) []*inventoryV1.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	protoAttachments := make([]*inventoryV1.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		protoAttachments = append(protoAttachments, ToProtoAttachment(&attachment))
+	}
+
+	return protoAttachments
+}
+
+func toModelAttachmentKind(kind inventoryV1.AttachmentKind) model.AttachmentKind {
+	switch kind {
+	case inventoryV1.AttachmentKind_ATTACHMENT_KIND_DRAWING:
+		return model.AttachmentDrawing
+	case inventoryV1.AttachmentKind_ATTACHMENT_KIND_DATASHEET:
+		return model.AttachmentDatasheet
+	case inventoryV1.AttachmentKind_ATTACHMENT_KIND_IMAGE:
+		return model.AttachmentImage
+	default:
+		return model.AttachmentOther
+	}
+}
+
+func toProtoAttachmentKind(kind model.AttachmentKind) inventoryV1.AttachmentKind {
+	switch kind {
+	case model.AttachmentDrawing:
+		return inventoryV1.AttachmentKind_ATTACHMENT_KIND_DRAWING
+	case model.AttachmentDatasheet:
+		return inventoryV1.AttachmentKind_ATTACHMENT_KIND_DATASHEET
+	case model.AttachmentImage:
+		return inventoryV1.AttachmentKind_ATTACHMENT_KIND_IMAGE
+	default:
+		return inventoryV1.AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+	}
+}
@@ -8,37 +8,91 @@ import (
 )
 
 func ToModelPart(req *inventoryV1.ListPartsRequest) *model.Filter {
-	if req.Filter != nil {
-		uuids := make([]uuid.UUID, 0, len(req.Filter.PartUuid))
-		for _, uuidStr := range req.Filter.PartUuid {
+	return ToModelFilter(req.GetFilter())
+}
+
+// ToModelFilter конвертирует фильтр деталей из protobuf
+func ToModelFilter(protoFilter *inventoryV1.PartsFilter) *model.Filter {
+	if protoFilter != nil {
+		uuids := make([]uuid.UUID, 0, len(protoFilter.PartUuid))
+		for _, uuidStr := range protoFilter.PartUuid {
 			if uuid, err := uuid.Parse(uuidStr); err == nil {
 				uuids = append(uuids, uuid)
 			}
 		}
 
-		categories := make([]model.Category, 0, len(req.Filter.Category))
-		for _, protoCategory := range req.Filter.Category {
-			switch protoCategory {
-			case inventoryV1.Category_CATEGORY_ENGINE:
-				categories = append(categories, model.ENGINE)
-			case inventoryV1.Category_CATEGORY_FUEL:
-				categories = append(categories, model.FUEL)
-			case inventoryV1.Category_CATEGORY_PORTHOLE:
-				categories = append(categories, model.PORTHOLE)
-			case inventoryV1.Category_CATEGORY_WING:
-				categories = append(categories, model.WING)
-			}
+		tagMatch := model.TagMatchAny
+		if protoFilter.TagMatch == inventoryV1.TagMatchMode_TAG_MATCH_MODE_ALL {
+			tagMatch = model.TagMatchAll
+		}
+
+		metadata := make([]model.MetadataPredicate, 0, len(protoFilter.Metadata))
+		for _, protoPredicate := range protoFilter.Metadata {
+			metadata = append(metadata, toModelMetadataPredicate(protoPredicate))
 		}
 
 		filter := &model.Filter{
 			Uuids:                 uuids,
-			Names:                 req.Filter.PartName,
-			Categories:            categories,
-			ManufacturerCountries: req.Filter.ManufacturerCountry,
-			Tags:                  req.Filter.Tags,
+			Names:                 protoFilter.PartName,
+			Categories:            ToModelCategories(protoFilter.Category),
+			ManufacturerCountries: protoFilter.ManufacturerCountry,
+			ManufacturerNames:     protoFilter.ManufacturerName,
+			Tags:                  protoFilter.Tags,
+			TagMatch:              tagMatch,
+			PriceMin:              protoFilter.PriceMin,
+			PriceMax:              protoFilter.PriceMax,
+			MinStock:              protoFilter.MinStock,
+			InStockOnly:           protoFilter.InStockOnly,
+			Metadata:              metadata,
+			Warehouses:            protoFilter.Warehouse,
 		}
 		return filter
 	}
 
 	return nil
 }
+
+func toModelMetadataPredicate(protoPredicate *inventoryV1.MetadataPredicate) model.MetadataPredicate {
+	predicate := model.MetadataPredicate{
+		Key:      protoPredicate.Key,
+		Operator: toModelMetadataOperator(protoPredicate.Operator),
+	}
+
+	switch kind := protoPredicate.GetValue().GetKind().(type) {
+	case *inventoryV1.Value_StringValue:
+		predicate.Kind = model.ValueKindString
+		predicate.Value.StringValue = kind.StringValue
+	case *inventoryV1.Value_Int64Value:
+		predicate.Kind = model.ValueKindInt64
+		predicate.Value.Int64Value = kind.Int64Value
+	case *inventoryV1.Value_DoubleValue:
+		predicate.Kind = model.ValueKindFloat64
+		predicate.Value.Float64Value = kind.DoubleValue
+	case *inventoryV1.Value_BoolValue:
+		predicate.Kind = model.ValueKindBool
+		predicate.Value.BoolValue = kind.BoolValue
+	}
+
+	return predicate
+}
+
+func toModelMetadataOperator(protoOperator inventoryV1.MetadataOperator) model.MetadataOperator {
+	switch protoOperator {
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_EQ:
+		return model.MetadataEq
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_NE:
+		return model.MetadataNe
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_GT:
+		return model.MetadataGt
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_GTE:
+		return model.MetadataGte
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_LT:
+		return model.MetadataLt
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_LTE:
+		return model.MetadataLte
+	case inventoryV1.MetadataOperator_METADATA_OPERATOR_EXISTS:
+		return model.MetadataExists
+	default:
+		return 0
+	}
+}
@@ -2,6 +2,7 @@ package converter
 
 import (
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
@@ -241,3 +242,42 @@ func (s *ConverterSuite) TestProtoToModel_MixedInvalidUUIDs() {
 	assert.Len(s.T(), result.Uuids, 1)
 	assert.Equal(s.T(), validUUID, result.Uuids[0])
 }
+
+func (s *ConverterSuite) TestToModelPart_ExtendedFilter() {
+	req := &inventoryV1.ListPartsRequest{
+		Filter: &inventoryV1.PartsFilter{
+			PriceMin:         lo.ToPtr(10.0),
+			PriceMax:         lo.ToPtr(20.0),
+			MinStock:         lo.ToPtr(int64(5)),
+			InStockOnly:      true,
+			ManufacturerName: []string{"SpaceX"},
+			Tags:             []string{"engine"},
+			TagMatch:         inventoryV1.TagMatchMode_TAG_MATCH_MODE_ALL,
+			Metadata: []*inventoryV1.MetadataPredicate{
+				{
+					Key:      "thrust",
+					Operator: inventoryV1.MetadataOperator_METADATA_OPERATOR_GTE,
+					Value:    &inventoryV1.Value{Kind: &inventoryV1.Value_DoubleValue{DoubleValue: 100}},
+				},
+				{
+					Key:      "material",
+					Operator: inventoryV1.MetadataOperator_METADATA_OPERATOR_EXISTS,
+				},
+			},
+		},
+	}
+
+	result := ToModelPart(req)
+
+	s.Require().NotNil(result)
+	assert.Equal(s.T(), 10.0, *result.PriceMin)
+	assert.Equal(s.T(), 20.0, *result.PriceMax)
+	assert.Equal(s.T(), int64(5), *result.MinStock)
+	assert.True(s.T(), result.InStockOnly)
+	assert.Equal(s.T(), []string{"SpaceX"}, result.ManufacturerNames)
+	assert.Equal(s.T(), model.TagMatchAll, result.TagMatch)
+	assert.Equal(s.T(), []model.MetadataPredicate{
+		{Key: "thrust", Operator: model.MetadataGte, Kind: model.ValueKindFloat64, Value: model.Value{Float64Value: 100}},
+		{Key: "material", Operator: model.MetadataExists},
+	}, result.Metadata)
+}
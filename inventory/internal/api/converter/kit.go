@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelCategories конвертирует категории из запроса, пропуская неизвестные
+func ToModelCategories(protoCategories []inventoryV1.Category) []model.Category {
+	categories := make([]model.Category, 0, len(protoCategories))
+	for _, protoCategory := range protoCategories {
+		if category := toModelCategory(protoCategory); category != model.UNKNOWN {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
+func ToProtoCompatibleParts(compatible []model.CompatiblePart) []*inventoryV1.CompatiblePart {
+	protoCompatible := make([]*inventoryV1.CompatiblePart, 0, len(compatible))
+	for _, item := range compatible {
+		protoCompatible = append(protoCompatible, &inventoryV1.CompatiblePart{
+			Part: ToProtoPart(&item.Part),
+			Note: item.Note,
+		})
+	}
+
+	return protoCompatible
+}
+
+func ToProtoKit(kit *model.Kit) *inventoryV1.Kit {
+	items := make([]*inventoryV1.KitItem, 0, len(kit.Items))
+	for _, item := range kit.Items {
+		items = append(items, &inventoryV1.KitItem{PartUuid: item.PartUuid.String(), Quantity: item.Quantity})
+	}
+
+	return &inventoryV1.Kit{
+		KitUuid:     kit.KitUuid.String(),
+		Name:        kit.Name,
+		Description: kit.Description,
+		Items:       items,
+	}
+}
+
+func ToProtoKits(kits []model.Kit) []*inventoryV1.Kit {
+	protoKits := make([]*inventoryV1.Kit, 0, len(kits))
+	for _, kit := range kits {
+		protoKits = append(protoKits, ToProtoKit(&kit))
+	}
+
+	return protoKits
+}
+
+func ToProtoKitExpansion(expansion *model.KitExpansion) *inventoryV1.ExpandKitResponse {
+	lines := make([]*inventoryV1.KitLine, 0, len(expansion.Lines))
+	for _, line := range expansion.Lines {
+		lines = append(lines, &inventoryV1.KitLine{Part: ToProtoPart(&line.Part), Quantity: line.Quantity})
+	}
+
+	return &inventoryV1.ExpandKitResponse{
+		Kit:        ToProtoKit(&expansion.Kit),
+		Lines:      lines,
+		TotalPrice: expansion.TotalPrice,
+	}
+}
@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelManufacturer конвертирует производителя из запроса в модель.
+// Даты из запроса игнорируются: ими управляет сервис.
+func ToModelManufacturer(protoManufacturer *inventoryV1.Manufacturer) (*model.Manufacturer, error) {
+	id, err := parseManufacturerUuid(protoManufacturer.GetManufacturerUuid())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid manufacturer_uuid", model.ErrInvalidManufacturer)
+	}
+
+	return &model.Manufacturer{
+		ManufacturerUuid: id,
+		Name:             protoManufacturer.GetName(),
+		Country:          protoManufacturer.GetCountry(),
+		Website:          protoManufacturer.GetUrl(),
+	}, nil
+}
+
+func ToProtoManufacturer(manufacturer *model.Manufacturer) *inventoryV1.Manufacturer {
+	protoManufacturer := &inventoryV1.Manufacturer{
+		Name:    manufacturer.Name,
+		Country: manufacturer.Country,
+		Url:     manufacturer.Website,
+	}
+
+	// У детали без производителя ссылка на справочник пустая
+	if manufacturer.ManufacturerUuid != uuid.Nil {
+		protoManufacturer.ManufacturerUuid = manufacturer.ManufacturerUuid.String()
+		protoManufacturer.CreatedAt = timestamppb.New(manufacturer.CreatedAt)
+		protoManufacturer.UpdatedAt = timestamppb.New(manufacturer.UpdatedAt)
+	}
+
+	return protoManufacturer
+}
+
+func ToProtoManufacturers(manufacturers []model.Manufacturer) []*inventoryV1.Manufacturer {
+	protoManufacturers := make([]*inventoryV1.Manufacturer, 0, len(manufacturers))
+	for _, manufacturer := range manufacturers {
+		protoManufacturers = append(protoManufacturers, ToProtoManufacturer(&manufacturer))
+	}
+
+	return protoManufacturers
+}
+
+// ToModelManufacturerFilter конвертирует условия выборки производителей
+func ToModelManufacturerFilter(req *inventoryV1.ListManufacturersRequest) *model.ManufacturerFilter {
+	return &model.ManufacturerFilter{Countries: req.GetCountry()}
+}
+
+// parseManufacturerUuid разбирает необязательный идентификатор производителя: пустая строка — uuid.Nil
+func parseManufacturerUuid(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(value)
+}
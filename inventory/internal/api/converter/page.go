@@ -0,0 +1,105 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+var sortFields = map[string]model.SortField{
+	"created_at": model.SortByCreatedAt,
+	"name":       model.SortByName,
+	"price":      model.SortByPrice,
+	"stock":      model.SortByStock,
+}
+
+// ToModelPageRequest собирает параметры страницы из запроса ListParts
+func ToModelPageRequest(req *inventoryV1.ListPartsRequest) (*model.PageRequest, error) {
+	sort, err := toModelSort(req.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := toModelReadMask(req.GetReadMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.PageRequest{
+		Size:   int(req.GetPageSize()),
+		Token:  req.GetPageToken(),
+		Sort:   sort,
+		Fields: fields,
+	}
+	if req.PriceAt != nil {
+		page.PriceAt = req.PriceAt.AsTime()
+	}
+
+	return page, nil
+}
+
+// toModelSort разбирает order_by вида "price desc"
+func toModelSort(orderBy string) (model.Sort, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return model.Sort{}, nil
+	}
+
+	field, ok := sortFields[parts[0]]
+	if !ok || len(parts) > 2 {
+		return model.Sort{}, fmt.Errorf("%w: unsupported order_by %q", model.ErrInvalidOrderBy, orderBy)
+	}
+
+	sort := model.Sort{Field: field}
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			sort.Desc = true
+		default:
+			return model.Sort{}, fmt.Errorf("%w: unsupported order_by %q", model.ErrInvalidOrderBy, orderBy)
+		}
+	}
+
+	return sort, nil
+}
+
+// toModelReadMask проверяет, что маска ссылается на поля верхнего уровня Part
+func toModelReadMask(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	fields := (&inventoryV1.Part{}).ProtoReflect().Descriptor().Fields()
+	for _, path := range paths {
+		if fields.ByName(protoreflect.Name(path)) == nil {
+			return nil, fmt.Errorf("%w: unknown field %q", model.ErrInvalidReadMask, path)
+		}
+	}
+
+	return paths, nil
+}
+
+// ApplyReadMask очищает поля детали, не перечисленные в маске. part_uuid остаётся всегда
+func ApplyReadMask(part *inventoryV1.Part, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	keep := map[protoreflect.Name]bool{"part_uuid": true}
+	for _, path := range paths {
+		keep[protoreflect.Name(path)] = true
+	}
+
+	message := part.ProtoReflect()
+	message.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[field.Name()] {
+			message.Clear(field)
+		}
+		return true
+	})
+}
@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ConverterSuite) TestToModelPageRequest() {
+	priceAt := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	page, err := ToModelPageRequest(&inventoryV1.ListPartsRequest{
+		PageSize:  20,
+		PageToken: "token",
+		OrderBy:   "price desc",
+		ReadMask:  &fieldmaskpb.FieldMask{Paths: []string{"name", "price"}},
+		PriceAt:   timestamppb.New(priceAt),
+	})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), &model.PageRequest{
+		Size:    20,
+		Token:   "token",
+		Sort:    model.Sort{Field: model.SortByPrice, Desc: true},
+		Fields:  []string{"name", "price"},
+		PriceAt: priceAt,
+	}, page)
+}
+
+func (s *ConverterSuite) TestToModelPageRequest_Invalid() {
+	_, err := ToModelPageRequest(&inventoryV1.ListPartsRequest{OrderBy: "weight"})
+	assert.ErrorIs(s.T(), err, model.ErrInvalidOrderBy)
+
+	_, err = ToModelPageRequest(&inventoryV1.ListPartsRequest{
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"dimensions.length"}},
+	})
+	assert.ErrorIs(s.T(), err, model.ErrInvalidReadMask)
+}
+
+func (s *ConverterSuite) TestApplyReadMask() {
+	part := &inventoryV1.Part{
+		PartUuid:    "id",
+		Name:        "Engine",
+		Description: "long description",
+		Price:       10,
+		Metadata:    map[string]*inventoryV1.Value{"k": {}},
+	}
+
+	ApplyReadMask(part, []string{"name"})
+
+	assert.True(s.T(), proto.Equal(&inventoryV1.Part{PartUuid: "id", Name: "Engine"}, part))
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
@@ -31,13 +32,6 @@ func ToProtoPart(part *model.Part) *inventoryV1.Part {
 		Weight: part.Dimensions.Weight,
 	}
 
-	// Конвертируем Manufacturer
-	manufacturer := &inventoryV1.Manufacturer{
-		Name:    part.Manufacturer.Name,
-		Country: part.Manufacturer.Country,
-		Url:     part.Manufacturer.Website,
-	}
-
 	// Конвертируем Metadata
 	metadata := make(map[string]*inventoryV1.Value)
 	for key, value := range part.Metadata {
@@ -55,18 +49,27 @@ func ToProtoPart(part *model.Part) *inventoryV1.Part {
 		metadata[key] = protoValue
 	}
 
+	var manufacturerUuid string
+	if part.ManufacturerUuid != uuid.Nil {
+		manufacturerUuid = part.ManufacturerUuid.String()
+	}
+
 	return &inventoryV1.Part{
-		PartUuid:      part.OrderUuid.String(),
-		Name:          part.Name,
-		Description:   part.Description,
-		Price:         part.Price,
-		StockQuantity: part.StockQuantity,
-		Category:      protoCategory,
-		Dimensions:    dimensions,
-		Manufacturer:  manufacturer,
-		Tags:          part.Tags,
-		Metadata:      metadata,
-		CreatedAt:     timestamppb.New(part.CreatedAt),
-		UpdatedAt:     timestamppb.New(part.UpdatedAt),
+		PartUuid:         part.PartUuid.String(),
+		Name:             part.Name,
+		Description:      part.Description,
+		Price:            part.Price,
+		StockQuantity:    part.StockQuantity,
+		Stock:            toProtoStock(part.Stock),
+		Category:         protoCategory,
+		Dimensions:       dimensions,
+		ManufacturerUuid: manufacturerUuid,
+		Manufacturer:     ToProtoManufacturer(&part.Manufacturer),
+		Tags:             part.Tags,
+		Metadata:         metadata,
+		ReorderThreshold: part.ReorderThreshold,
+		Attachments:      toProtoAttachments(part.Attachments),
+		CreatedAt:        timestamppb.New(part.CreatedAt),
+		UpdatedAt:        timestamppb.New(part.UpdatedAt),
 	}
 }
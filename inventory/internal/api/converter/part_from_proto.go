@@ -0,0 +1,100 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelPartFromProto конвертирует деталь из запроса в модель.
+// Пустой part_uuid оставляет uuid.Nil — идентификатор выдаст сервис.
+// Даты из запроса игнорируются: ими управляет сервис.
+func ToModelPartFromProto(protoPart *inventoryV1.Part) (*model.Part, error) {
+	var id uuid.UUID
+	if protoPart.PartUuid != "" {
+		parsed, err := uuid.Parse(protoPart.PartUuid)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid part_uuid", model.ErrInvalidPart)
+		}
+		id = parsed
+	}
+
+	var dimensions model.Dimensions
+	if protoPart.Dimensions != nil {
+		dimensions = model.Dimensions{
+			Length: protoPart.Dimensions.Length,
+			Width:  protoPart.Dimensions.Width,
+			Height: protoPart.Dimensions.Height,
+			Weight: protoPart.Dimensions.Weight,
+		}
+	}
+
+	// Ссылка на справочник берётся из детали, а если её нет — из вложенного производителя
+	manufacturerUuid := protoPart.ManufacturerUuid
+	if manufacturerUuid == "" {
+		manufacturerUuid = protoPart.GetManufacturer().GetManufacturerUuid()
+	}
+	manufacturerId, err := parseManufacturerUuid(manufacturerUuid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid manufacturer_uuid", model.ErrInvalidPart)
+	}
+
+	var manufacturer model.Manufacturer
+	if protoPart.Manufacturer != nil {
+		manufacturer = model.Manufacturer{
+			Name:    protoPart.Manufacturer.Name,
+			Country: protoPart.Manufacturer.Country,
+			Website: protoPart.Manufacturer.Url,
+		}
+	}
+
+	metadata := make(map[string]model.Value, len(protoPart.Metadata))
+	for key, protoValue := range protoPart.Metadata {
+		var value model.Value
+		switch kind := protoValue.GetKind().(type) {
+		case *inventoryV1.Value_StringValue:
+			value.StringValue = kind.StringValue
+		case *inventoryV1.Value_Int64Value:
+			value.Int64Value = kind.Int64Value
+		case *inventoryV1.Value_DoubleValue:
+			value.Float64Value = kind.DoubleValue
+		case *inventoryV1.Value_BoolValue:
+			value.BoolValue = kind.BoolValue
+		}
+		metadata[key] = value
+	}
+
+	return &model.Part{
+		PartUuid:         id,
+		Name:             protoPart.Name,
+		Description:      protoPart.Description,
+		Price:            protoPart.Price,
+		StockQuantity:    protoPart.StockQuantity,
+		Stock:            toModelStock(protoPart.Stock),
+		Category:         toModelCategory(protoPart.Category),
+		Dimensions:       dimensions,
+		ManufacturerUuid: manufacturerId,
+		Manufacturer:     manufacturer,
+		Tags:             protoPart.Tags,
+		Metadata:         metadata,
+		ReorderThreshold: protoPart.ReorderThreshold,
+	}, nil
+}
+
+func toModelCategory(protoCategory inventoryV1.Category) model.Category {
+	switch protoCategory {
+	case inventoryV1.Category_CATEGORY_ENGINE:
+		return model.ENGINE
+	case inventoryV1.Category_CATEGORY_FUEL:
+		return model.FUEL
+	case inventoryV1.Category_CATEGORY_PORTHOLE:
+		return model.PORTHOLE
+	case inventoryV1.Category_CATEGORY_WING:
+		return model.WING
+	default:
+		return model.UNKNOWN
+	}
+}
@@ -0,0 +1,71 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ConverterSuite) TestToModelPartFromProto_Full() {
+	id := uuid.New()
+	protoPart := &inventoryV1.Part{
+		PartUuid:      id.String(),
+		Name:          "Porthole",
+		Price:         12.5,
+		StockQuantity: 3,
+		Category:      inventoryV1.Category_CATEGORY_PORTHOLE,
+		Dimensions:    &inventoryV1.Dimensions{Length: 1, Width: 2, Height: 3, Weight: 4},
+		Manufacturer:  &inventoryV1.Manufacturer{Name: "Glass Co", Country: "Germany", Url: "https://glass.example"},
+		Tags:          []string{"glass"},
+		Metadata: map[string]*inventoryV1.Value{
+			"material": {Kind: &inventoryV1.Value_StringValue{StringValue: "quartz"}},
+			"layers":   {Kind: &inventoryV1.Value_Int64Value{Int64Value: 3}},
+		},
+	}
+
+	part, err := ToModelPartFromProto(protoPart)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), id, part.PartUuid)
+	assert.Equal(s.T(), model.PORTHOLE, part.Category)
+	assert.Equal(s.T(), 4.0, part.Dimensions.Weight)
+	assert.Equal(s.T(), "https://glass.example", part.Manufacturer.Website)
+	assert.Equal(s.T(), "quartz", part.Metadata["material"].StringValue)
+	assert.Equal(s.T(), int64(3), part.Metadata["layers"].Int64Value)
+}
+
+func (s *ConverterSuite) TestToModelPartFromProto_EmptyUUID() {
+	part, err := ToModelPartFromProto(&inventoryV1.Part{Name: "No id"})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), uuid.Nil, part.PartUuid)
+	assert.Equal(s.T(), model.UNKNOWN, part.Category)
+}
+
+func (s *ConverterSuite) TestToModelPartFromProto_InvalidUUID() {
+	part, err := ToModelPartFromProto(&inventoryV1.Part{PartUuid: "not-a-uuid"})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Nil(s.T(), part)
+}
+
+func (s *ConverterSuite) TestToModelPartFromProto_ManufacturerUuid() {
+	manufacturerId := uuid.New()
+
+	part, err := ToModelPartFromProto(&inventoryV1.Part{ManufacturerUuid: manufacturerId.String()})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), manufacturerId, part.ManufacturerUuid)
+
+	// Ссылку можно передать и во вложенном производителе
+	part, err = ToModelPartFromProto(&inventoryV1.Part{
+		Manufacturer: &inventoryV1.Manufacturer{ManufacturerUuid: manufacturerId.String()},
+	})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), manufacturerId, part.ManufacturerUuid)
+
+	part, err = ToModelPartFromProto(&inventoryV1.Part{ManufacturerUuid: "not-a-uuid"})
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Nil(s.T(), part)
+}
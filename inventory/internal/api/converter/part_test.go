@@ -15,7 +15,7 @@ func (s *ConverterSuite) TestToProtoPart_EngineCategory() {
 	partUUID := uuid.New()
 	now := time.Now()
 	part := &model.Part{
-		OrderUuid:     partUUID,
+		PartUuid:      partUUID,
 		Name:          "Rocket Engine",
 		Description:   "Powerful rocket engine",
 		Price:         1500.75,
@@ -83,7 +83,7 @@ func (s *ConverterSuite) TestToProtoPart_EngineCategory() {
 
 func (s *ConverterSuite) TestToProtoPart_FuelCategory() {
 	part := &model.Part{
-		OrderUuid:    uuid.New(),
+		PartUuid:     uuid.New(),
 		Name:         "Rocket Fuel",
 		Category:     model.FUEL,
 		Dimensions:   model.Dimensions{},
@@ -101,7 +101,7 @@ func (s *ConverterSuite) TestToProtoPart_FuelCategory() {
 
 func (s *ConverterSuite) TestToProtoPart_PortholeCategory() {
 	part := &model.Part{
-		OrderUuid:    uuid.New(),
+		PartUuid:     uuid.New(),
 		Name:         "Space Porthole",
 		Category:     model.PORTHOLE,
 		Dimensions:   model.Dimensions{},
@@ -119,7 +119,7 @@ func (s *ConverterSuite) TestToProtoPart_PortholeCategory() {
 
 func (s *ConverterSuite) TestToProtoPart_WingCategory() {
 	part := &model.Part{
-		OrderUuid:    uuid.New(),
+		PartUuid:     uuid.New(),
 		Name:         "Rocket Wing",
 		Category:     model.WING,
 		Dimensions:   model.Dimensions{},
@@ -137,7 +137,7 @@ func (s *ConverterSuite) TestToProtoPart_WingCategory() {
 
 func (s *ConverterSuite) TestToProtoPart_UnknownCategory() {
 	part := &model.Part{
-		OrderUuid:    uuid.New(),
+		PartUuid:     uuid.New(),
 		Name:         "Unknown Part",
 		Category:     model.UNKNOWN,
 		Dimensions:   model.Dimensions{},
@@ -155,7 +155,7 @@ func (s *ConverterSuite) TestToProtoPart_UnknownCategory() {
 
 func (s *ConverterSuite) TestToProtoPart_AllMetadataTypes() {
 	part := &model.Part{
-		OrderUuid:    uuid.New(),
+		PartUuid:     uuid.New(),
 		Name:         "Test Part",
 		Category:     model.ENGINE,
 		Dimensions:   model.Dimensions{},
@@ -190,7 +190,7 @@ func (s *ConverterSuite) TestToProtoPart_AllMetadataTypes() {
 
 func (s *ConverterSuite) TestToProtoPart_EmptyMetadata() {
 	part := &model.Part{
-		OrderUuid:    uuid.New(),
+		PartUuid:     uuid.New(),
 		Name:         "Test Part",
 		Category:     model.ENGINE,
 		Dimensions:   model.Dimensions{},
@@ -208,7 +208,7 @@ func (s *ConverterSuite) TestToProtoPart_EmptyMetadata() {
 
 func (s *ConverterSuite) TestToProtoPart_ZeroValues() {
 	part := &model.Part{
-		OrderUuid:     uuid.New(),
+		PartUuid:      uuid.New(),
 		Name:          "",
 		Description:   "",
 		Price:         0,
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoPriceHistory(history []model.PricePeriod) []*inventoryV1.PricePeriod {
+	periods := make([]*inventoryV1.PricePeriod, 0, len(history))
+	for _, period := range history {
+		protoPeriod := &inventoryV1.PricePeriod{
+			Price:         period.Price,
+			EffectiveFrom: timestamppb.New(period.EffectiveFrom),
+		}
+		if period.EffectiveTo != nil {
+			protoPeriod.EffectiveTo = timestamppb.New(*period.EffectiveTo)
+		}
+		periods = append(periods, protoPeriod)
+	}
+
+	return periods
+}
@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelSearchRequest конвертирует запрос поиска деталей из protobuf
+func ToModelSearchRequest(req *inventoryV1.SearchPartsRequest) *model.SearchRequest {
+	return &model.SearchRequest{
+		Text:      req.GetQuery(),
+		Filter:    ToModelFilter(req.GetFilter()),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+// ToProtoSearchResult конвертирует найденную деталь в protobuf
+func ToProtoSearchResult(hit *model.SearchHit) *inventoryV1.SearchResult {
+	highlights := make([]*inventoryV1.Highlight, 0, len(hit.Highlights))
+	for _, highlight := range hit.Highlights {
+		highlights = append(highlights, &inventoryV1.Highlight{
+			Field:   highlight.Field,
+			Snippet: highlight.Snippet,
+		})
+	}
+
+	return &inventoryV1.SearchResult{
+		Part:       ToProtoPart(&hit.Part),
+		Score:      hit.Score,
+		Highlights: highlights,
+	}
+}
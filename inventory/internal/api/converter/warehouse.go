@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToModelStockTransfer конвертирует запрос перемещения из protobuf
+func ToModelStockTransfer(req *inventoryV1.TransferStockRequest) (*model.StockTransfer, error) {
+	partUuid, err := uuid.Parse(req.GetPartUuid())
+	if err != nil {
+		return nil, model.ErrInvalidTransfer
+	}
+
+	return &model.StockTransfer{
+		PartUuid:      partUuid,
+		FromWarehouse: req.GetFromWarehouse(),
+		ToWarehouse:   req.GetToWarehouse(),
+		Quantity:      req.GetQuantity(),
+		Reason:        req.GetReason(),
+	}, nil
+}
+
+func ToProtoStockTransfer(transfer *model.StockTransfer) *inventoryV1.StockTransfer {
+	return &inventoryV1.StockTransfer{
+		TransferUuid:  transfer.TransferUuid.String(),
+		PartUuid:      transfer.PartUuid.String(),
+		FromWarehouse: transfer.FromWarehouse,
+		ToWarehouse:   transfer.ToWarehouse,
+		Quantity:      transfer.Quantity,
+		Reason:        transfer.Reason,
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+	}
+}
+
+func ToProtoWarehouses(warehouses []model.Warehouse) []*inventoryV1.Warehouse {
+	protoWarehouses := make([]*inventoryV1.Warehouse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		protoWarehouses = append(protoWarehouses, &inventoryV1.Warehouse{
+			Code:     warehouse.Code,
+			Name:     warehouse.Name,
+			Location: warehouse.Location,
+		})
+	}
+
+	return protoWarehouses
+}
+
+func toProtoStock(stock []model.StockLevel) []*inventoryV1.StockLevel {
+	if len(stock) == 0 {
+		return nil
+	}
+
+	levels := make([]*inventoryV1.StockLevel, 0, len(stock))
+	for _, level := range stock {
+		levels = append(levels, &inventoryV1.StockLevel{Warehouse: level.Warehouse, Quantity: level.Quantity})
+	}
+
+	return levels
+}
+
+func toModelStock(stock []*inventoryV1.StockLevel) []model.StockLevel {
+	if len(stock) == 0 {
+		return nil
+	}
+
+	levels := make([]model.StockLevel, 0, len(stock))
+	for _, level := range stock {
+		levels = append(levels, model.StockLevel{Warehouse: level.GetWarehouse(), Quantity: level.GetQuantity()})
+	}
+
+	return levels
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// ToProtoPartEvent конвертирует событие изменения детали в protobuf
+func ToProtoPartEvent(event *model.PartEvent) *inventoryV1.WatchPartsResponse {
+	var eventType inventoryV1.PartEventType
+	switch event.Type {
+	case model.PartCreated:
+		eventType = inventoryV1.PartEventType_PART_EVENT_TYPE_CREATED
+	case model.PartUpdated:
+		eventType = inventoryV1.PartEventType_PART_EVENT_TYPE_UPDATED
+	case model.PartDeleted:
+		eventType = inventoryV1.PartEventType_PART_EVENT_TYPE_DELETED
+	case model.PartProgress:
+		// Прогресс несёт только позицию потока, детали в нём нет
+		return &inventoryV1.WatchPartsResponse{
+			Type:        inventoryV1.PartEventType_PART_EVENT_TYPE_PROGRESS,
+			ResumeToken: event.ResumeToken,
+			OccurredAt:  timestamppb.New(event.OccurredAt),
+		}
+	default:
+		eventType = inventoryV1.PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+	}
+
+	return &inventoryV1.WatchPartsResponse{
+		Type:        eventType,
+		Part:        ToProtoPart(&event.Part),
+		ResumeToken: event.ResumeToken,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+	}
+}
@@ -1,16 +1,41 @@
 package v1
 
 import (
+	"sync"
+
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
 	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
 )
 
+// Server — gRPC API инвентаря вместе с управлением долгоживущими подписками
+type Server interface {
+	inventoryV1.InventoryServiceServer
+
+	// CloseStreams завершает активные подписки WatchParts. Без этого GracefulStop
+	// ждал бы их до истечения таймаута остановки
+	CloseStreams()
+}
+
+var _ Server = (*api)(nil)
+
 type api struct {
 	inventoryV1.UnimplementedInventoryServiceServer
 
 	inventoryService service.InventoryService
+
+	streamsDone  chan struct{}
+	closeStreams sync.Once
 }
 
 func NewAPI(inventoryService service.InventoryService) *api {
-	return &api{inventoryService: inventoryService}
+	return &api{
+		inventoryService: inventoryService,
+		streamsDone:      make(chan struct{}),
+	}
+}
+
+func (a *api) CloseStreams() {
+	a.closeStreams.Do(func() {
+		close(a.streamsDone)
+	})
 }
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+// downloadChunkSize — размер фрагмента содержимого в потоке DownloadAttachment
+const downloadChunkSize = 64 << 10
+
+func (a *api) UploadAttachment(stream inventoryV1.InventoryService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "attachment info is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain attachment info")
+	}
+
+	content := &chunkReader{stream: stream}
+	upload, err := converter.ToModelAttachmentUpload(info, content)
+	if err != nil {
+		return toStatus(err)
+	}
+
+	attachment, err := a.inventoryService.UploadAttachment(ctx, upload)
+	if err != nil {
+		// Ошибка приёма потока уже несёт статус: отмена клиентом, невалидный фрагмент
+		if content.recvErr != nil {
+			return content.recvErr
+		}
+
+		logger.Error(ctx, "Failed to upload attachment",
+			zap.String("part_uuid", info.GetPartUuid()),
+			zap.String("file_name", info.GetFileName()),
+			zap.Error(err),
+		)
+
+		return toStatus(err)
+	}
+
+	return stream.SendAndClose(&inventoryV1.UploadAttachmentResponse{
+		Attachment: converter.ToProtoAttachment(attachment),
+	})
+}
+
+func (a *api) DownloadAttachment(req *inventoryV1.DownloadAttachmentRequest, stream inventoryV1.InventoryService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+
+	partUuid, err := uuid.Parse(req.GetPartUuid())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid part uuid")
+	}
+
+	attachmentUuid, err := uuid.Parse(req.GetAttachmentUuid())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attachment uuid")
+	}
+
+	attachment, content, err := a.inventoryService.DownloadAttachment(ctx, partUuid, attachmentUuid)
+	if err != nil {
+		logger.Error(ctx, "Failed to download attachment",
+			zap.String("part_uuid", partUuid.String()),
+			zap.String("attachment_uuid", attachmentUuid.String()),
+			zap.Error(err),
+		)
+
+		return toStatus(err)
+	}
+	defer func() { _ = content.Close() }()
+
+	err = stream.Send(&inventoryV1.DownloadAttachmentResponse{
+		Data: &inventoryV1.DownloadAttachmentResponse_Attachment{Attachment: converter.ToProtoAttachment(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Send сериализует сообщение до возврата, поэтому буфер используется повторно
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, buf)
+		if n > 0 {
+			err = stream.Send(&inventoryV1.DownloadAttachmentResponse{
+				Data: &inventoryV1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case errors.Is(readErr, io.EOF), errors.Is(readErr, io.ErrUnexpectedEOF):
+			return nil
+		case readErr != nil:
+			logger.Error(ctx, "Failed to read attachment content",
+				zap.String("part_uuid", partUuid.String()),
+				zap.String("attachment_uuid", attachmentUuid.String()),
+				zap.Error(readErr),
+			)
+
+			return status.Error(codes.Internal, "failed to read attachment content")
+		}
+	}
+}
+
+// chunkReader читает содержимое вложения из потока UploadAttachment.
+// Описание передаётся только первым сообщением, повтор считается ошибкой клиента
+type chunkReader struct {
+	stream  inventoryV1.InventoryService_UploadAttachmentServer
+	pending []byte
+	// recvErr — ошибка приёма сообщения, её статус возвращается клиенту как есть
+	recvErr error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		msg, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.recvErr = err
+			return 0, err
+		}
+
+		if _, ok := msg.GetData().(*inventoryV1.UploadAttachmentRequest_Info); ok {
+			return 0, fmt.Errorf("%w: attachment info must be sent only in the first message", model.ErrInvalidAttachment)
+		}
+		r.pending = msg.GetChunk()
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
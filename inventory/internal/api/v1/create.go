@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreatePart(ctx context.Context, req *inventoryV1.CreatePartRequest) (*inventoryV1.CreatePartResponse, error) {
+	part, err := converter.ToModelPartFromProto(req.GetPart())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	created, err := a.inventoryService.CreatePart(ctx, part)
+	if err != nil {
+		logger.Error(ctx, "Failed to create part",
+			zap.String("part_uuid", part.PartUuid.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.CreatePartResponse{Part: converter.ToProtoPart(created)}, nil
+}
+
+func (a *api) BatchCreateParts(ctx context.Context, req *inventoryV1.BatchCreatePartsRequest) (*inventoryV1.BatchCreatePartsResponse, error) {
+	parts := make([]model.Part, 0, len(req.GetParts()))
+	for _, protoPart := range req.GetParts() {
+		part, err := converter.ToModelPartFromProto(protoPart)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		parts = append(parts, *part)
+	}
+
+	created, err := a.inventoryService.BatchCreateParts(ctx, parts)
+	if err != nil {
+		logger.Error(ctx, "Failed to create parts",
+			zap.Int("count", len(parts)),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	protoParts := make([]*inventoryV1.Part, 0, len(*created))
+	for _, part := range *created {
+		protoParts = append(protoParts, converter.ToProtoPart(&part))
+	}
+
+	return &inventoryV1.BatchCreatePartsResponse{Parts: protoParts}, nil
+}
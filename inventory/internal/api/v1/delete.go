@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeletePart(ctx context.Context, req *inventoryV1.DeletePartRequest) (*inventoryV1.DeletePartResponse, error) {
+	id, err := uuid.Parse(req.PartUuid)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part uuid")
+	}
+
+	err = a.inventoryService.DeletePart(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to delete part",
+			zap.String("part_uuid", id.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.DeletePartResponse{}, nil
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// toStatus переводит ошибки изменения каталога в gRPC-статусы. Текст внутренних ошибок
+// клиенту не передаётся: он может раскрыть устройство хранилища, вызывающий уже записал его в лог
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidPart), errors.Is(err, model.ErrInvalidUpdateMask),
+		errors.Is(err, model.ErrInvalidPageToken), errors.Is(err, model.ErrInvalidReadMask),
+		errors.Is(err, model.ErrInvalidOrderBy), errors.Is(err, model.ErrInvalidSearch),
+		errors.Is(err, model.ErrInvalidTransfer), errors.Is(err, model.ErrInvalidPrice),
+		errors.Is(err, model.ErrInvalidManufacturer), errors.Is(err, model.ErrInvalidKit),
+		errors.Is(err, model.ErrInvalidCompatibility), errors.Is(err, model.ErrInvalidAttachment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPartNotFound):
+		return status.Errorf(codes.NotFound, "part not found")
+	case errors.Is(err, model.ErrWarehouseNotFound), errors.Is(err, model.ErrPriceNotFound),
+		errors.Is(err, model.ErrManufacturerNotFound), errors.Is(err, model.ErrKitNotFound),
+		errors.Is(err, model.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrInsufficientStock), errors.Is(err, model.ErrManufacturerInUse),
+		errors.Is(err, model.ErrKitUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrAttachmentTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, model.ErrInvalidResumeToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrResumeTokenExpired):
+		return status.Errorf(codes.FailedPrecondition, "resume token expired, reload parts with ListParts and watch again")
+	case errors.Is(err, model.ErrPartAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "part already exists")
+	case errors.Is(err, model.ErrManufacturerAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrPartConflict):
+		return status.Error(codes.Aborted, "part was modified concurrently, read it and retry the update")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
@@ -21,7 +21,12 @@ func (a *api) GetPart(ctx context.Context, req *inventoryV1.GetPartRequest) (*in
 		return nil, status.Errorf(codes.InvalidArgument, "invalid part uuid")
 	}
 
-	part, err := a.inventoryService.GetPart(ctx, id)
+	var part *model.Part
+	if req.PriceAt != nil {
+		part, err = a.inventoryService.GetPartAt(ctx, id, req.PriceAt.AsTime())
+	} else {
+		part, err = a.inventoryService.GetPart(ctx, id)
+	}
 	if err != nil {
 		logger.Error(ctx, "Failed to get part",
 			zap.String("part_uuid", id.String()),
@@ -32,6 +37,10 @@ func (a *api) GetPart(ctx context.Context, req *inventoryV1.GetPartRequest) (*in
 			return nil, status.Errorf(codes.NotFound, "part not found")
 		}
 
+		if errors.Is(err, model.ErrPriceNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
 		if errors.Is(err, model.ErrConvertFromRepo) {
 			return nil, status.Errorf(codes.Internal, "failed to get part")
 		}
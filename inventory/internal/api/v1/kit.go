@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetCompatibleParts(ctx context.Context, req *inventoryV1.GetCompatiblePartsRequest) (*inventoryV1.GetCompatiblePartsResponse, error) {
+	id, err := uuid.Parse(req.GetPartUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part uuid")
+	}
+
+	compatible, err := a.inventoryService.GetCompatibleParts(ctx, id, converter.ToModelCategories(req.GetCategory()))
+	if err != nil {
+		logger.Error(ctx, "Failed to get compatible parts",
+			zap.String("part_uuid", id.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.GetCompatiblePartsResponse{CompatibleParts: converter.ToProtoCompatibleParts(compatible)}, nil
+}
+
+func (a *api) ListKits(ctx context.Context, _ *inventoryV1.ListKitsRequest) (*inventoryV1.ListKitsResponse, error) {
+	kits, err := a.inventoryService.ListKits(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to get list kits", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.ListKitsResponse{Kits: converter.ToProtoKits(kits)}, nil
+}
+
+func (a *api) ExpandKit(ctx context.Context, req *inventoryV1.ExpandKitRequest) (*inventoryV1.ExpandKitResponse, error) {
+	id, err := uuid.Parse(req.GetKitUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid kit uuid")
+	}
+
+	expansion, err := a.inventoryService.ExpandKit(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to expand kit",
+			zap.String("kit_uuid", id.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return converter.ToProtoKitExpansion(expansion), nil
+}
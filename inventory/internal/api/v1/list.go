@@ -17,7 +17,12 @@ import (
 func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest) (*inventoryV1.ListPartsResponse, error) {
 	filter := converter.ToModelPart(req)
 
-	parts, err := a.inventoryService.ListParts(ctx, filter)
+	page, err := converter.ToModelPageRequest(req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	result, err := a.inventoryService.ListParts(ctx, filter, page)
 	if err != nil {
 		logger.Error(ctx, "Failed to get list part",
 			zap.Any("filter", filter),
@@ -28,14 +33,18 @@ func (a *api) ListParts(ctx context.Context, req *inventoryV1.ListPartsRequest)
 			return nil, status.Errorf(codes.Internal, "failed to get list parts")
 		}
 
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatus(err)
 	}
 
-	protoParts := make([]*inventoryV1.Part, 0, len(*parts))
-	for _, part := range *parts {
+	protoParts := make([]*inventoryV1.Part, 0, len(result.Parts))
+	for _, part := range result.Parts {
 		protoPart := converter.ToProtoPart(&part)
+		converter.ApplyReadMask(protoPart, page.Fields)
 		protoParts = append(protoParts, protoPart)
 	}
 
-	return &inventoryV1.ListPartsResponse{Parts: protoParts}, nil
+	return &inventoryV1.ListPartsResponse{
+		Parts:         protoParts,
+		NextPageToken: result.NextPageToken,
+	}, nil
 }
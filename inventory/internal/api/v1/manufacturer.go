@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateManufacturer(ctx context.Context, req *inventoryV1.CreateManufacturerRequest) (*inventoryV1.CreateManufacturerResponse, error) {
+	manufacturer, err := converter.ToModelManufacturer(req.GetManufacturer())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	created, err := a.inventoryService.CreateManufacturer(ctx, manufacturer)
+	if err != nil {
+		logger.Error(ctx, "Failed to create manufacturer",
+			zap.String("name", manufacturer.Name),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.CreateManufacturerResponse{Manufacturer: converter.ToProtoManufacturer(created)}, nil
+}
+
+func (a *api) GetManufacturer(ctx context.Context, req *inventoryV1.GetManufacturerRequest) (*inventoryV1.GetManufacturerResponse, error) {
+	id, err := uuid.Parse(req.GetManufacturerUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid manufacturer uuid")
+	}
+
+	manufacturer, err := a.inventoryService.GetManufacturer(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to get manufacturer",
+			zap.String("manufacturer_uuid", id.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.GetManufacturerResponse{Manufacturer: converter.ToProtoManufacturer(manufacturer)}, nil
+}
+
+func (a *api) ListManufacturers(ctx context.Context, req *inventoryV1.ListManufacturersRequest) (*inventoryV1.ListManufacturersResponse, error) {
+	manufacturers, err := a.inventoryService.ListManufacturers(ctx, converter.ToModelManufacturerFilter(req))
+	if err != nil {
+		logger.Error(ctx, "Failed to get list manufacturers", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.ListManufacturersResponse{Manufacturers: converter.ToProtoManufacturers(manufacturers)}, nil
+}
+
+func (a *api) UpdateManufacturer(ctx context.Context, req *inventoryV1.UpdateManufacturerRequest) (*inventoryV1.UpdateManufacturerResponse, error) {
+	if req.GetManufacturer().GetManufacturerUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "manufacturer uuid is required")
+	}
+
+	manufacturer, err := converter.ToModelManufacturer(req.GetManufacturer())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	updated, err := a.inventoryService.UpdateManufacturer(ctx, manufacturer, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		logger.Error(ctx, "Failed to update manufacturer",
+			zap.String("manufacturer_uuid", manufacturer.ManufacturerUuid.String()),
+			zap.Strings("update_mask", req.GetUpdateMask().GetPaths()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.UpdateManufacturerResponse{Manufacturer: converter.ToProtoManufacturer(updated)}, nil
+}
+
+func (a *api) DeleteManufacturer(ctx context.Context, req *inventoryV1.DeleteManufacturerRequest) (*inventoryV1.DeleteManufacturerResponse, error) {
+	id, err := uuid.Parse(req.GetManufacturerUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid manufacturer uuid")
+	}
+
+	if err = a.inventoryService.DeleteManufacturer(ctx, id); err != nil {
+		logger.Error(ctx, "Failed to delete manufacturer",
+			zap.String("manufacturer_uuid", id.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.DeleteManufacturerResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetPriceHistory(ctx context.Context, req *inventoryV1.GetPriceHistoryRequest) (*inventoryV1.GetPriceHistoryResponse, error) {
+	id, err := uuid.Parse(req.GetPartUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part uuid")
+	}
+
+	history, err := a.inventoryService.GetPriceHistory(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to get price history",
+			zap.String("part_uuid", id.String()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.GetPriceHistoryResponse{Prices: converter.ToProtoPriceHistory(history)}, nil
+}
+
+func (a *api) SchedulePrice(ctx context.Context, req *inventoryV1.SchedulePriceRequest) (*inventoryV1.SchedulePriceResponse, error) {
+	id, err := uuid.Parse(req.GetPartUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part uuid")
+	}
+
+	var from time.Time
+	if req.EffectiveFrom != nil {
+		from = req.EffectiveFrom.AsTime()
+	}
+
+	history, err := a.inventoryService.SchedulePrice(ctx, id, req.GetPrice(), from)
+	if err != nil {
+		logger.Error(ctx, "Failed to schedule price",
+			zap.String("part_uuid", id.String()),
+			zap.Float64("price", req.GetPrice()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.SchedulePriceResponse{Prices: converter.ToProtoPriceHistory(history)}, nil
+}
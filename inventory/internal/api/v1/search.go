@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) SearchParts(ctx context.Context, req *inventoryV1.SearchPartsRequest) (*inventoryV1.SearchPartsResponse, error) {
+	page, err := a.inventoryService.SearchParts(ctx, converter.ToModelSearchRequest(req))
+	if err != nil {
+		logger.Error(ctx, "Failed to search parts",
+			zap.String("query", req.GetQuery()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	results := make([]*inventoryV1.SearchResult, 0, len(page.Hits))
+	for _, hit := range page.Hits {
+		results = append(results, converter.ToProtoSearchResult(&hit))
+	}
+
+	return &inventoryV1.SearchPartsResponse{
+		Results:       results,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
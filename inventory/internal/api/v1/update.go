@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdatePart(ctx context.Context, req *inventoryV1.UpdatePartRequest) (*inventoryV1.UpdatePartResponse, error) {
+	if req.GetPart().GetPartUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "part uuid is required")
+	}
+
+	part, err := converter.ToModelPartFromProto(req.GetPart())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	updated, err := a.inventoryService.UpdatePart(ctx, part, req.GetUpdateMask().GetPaths())
+	if err != nil {
+		logger.Error(ctx, "Failed to update part",
+			zap.String("part_uuid", part.PartUuid.String()),
+			zap.Strings("update_mask", req.GetUpdateMask().GetPaths()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.UpdatePartResponse{Part: converter.ToProtoPart(updated)}, nil
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListWarehouses(ctx context.Context, _ *inventoryV1.ListWarehousesRequest) (*inventoryV1.ListWarehousesResponse, error) {
+	warehouses, err := a.inventoryService.ListWarehouses(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to get list warehouses", zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.ListWarehousesResponse{Warehouses: converter.ToProtoWarehouses(warehouses)}, nil
+}
+
+func (a *api) TransferStock(ctx context.Context, req *inventoryV1.TransferStockRequest) (*inventoryV1.TransferStockResponse, error) {
+	transfer, err := converter.ToModelStockTransfer(req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	part, err := a.inventoryService.TransferStock(ctx, transfer)
+	if err != nil {
+		logger.Error(ctx, "Failed to transfer stock",
+			zap.String("part_uuid", req.GetPartUuid()),
+			zap.String("from_warehouse", req.GetFromWarehouse()),
+			zap.String("to_warehouse", req.GetToWarehouse()),
+			zap.Int64("quantity", req.GetQuantity()),
+			zap.Error(err),
+		)
+
+		return nil, toStatus(err)
+	}
+
+	return &inventoryV1.TransferStockResponse{
+		Part:     converter.ToProtoPart(part),
+		Transfer: converter.ToProtoStockTransfer(transfer),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) WatchParts(req *inventoryV1.WatchPartsRequest, stream inventoryV1.InventoryService_WatchPartsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// При остановке сервера обрываем подписку: клиент переподключится с resume_token
+	go func() {
+		select {
+		case <-a.streamsDone:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := a.inventoryService.WatchParts(ctx, &model.WatchRequest{
+		Filter:      converter.ToModelFilter(req.GetFilter()),
+		ResumeToken: req.GetResumeToken(),
+		// Заголовки ответа сообщают клиенту, что подписка зарегистрирована
+		Subscribed: func() {
+			if err := stream.SendHeader(metadata.MD{}); err != nil {
+				logger.Warn(ctx, "Failed to send WatchParts header", zap.Error(err))
+			}
+		},
+		Handle: func(event model.PartEvent) error {
+			return stream.Send(converter.ToProtoPartEvent(&event))
+		},
+	})
+
+	switch {
+	case err == nil:
+		return nil
+	case stream.Context().Err() != nil:
+		// Клиент отключился сам
+		return status.FromContextError(stream.Context().Err()).Err()
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Unavailable, "server is shutting down")
+	default:
+		logger.Error(ctx, "Failed to watch parts",
+			zap.String("resume_token", req.GetResumeToken()),
+			zap.Error(err),
+		)
+
+		return toStatus(err)
+	}
+}
@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
+	"buf.build/go/protovalidate"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/config"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/admin"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/health"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/interceptors"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
 )
@@ -21,6 +25,7 @@ type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
 	listener    net.Listener
+	adminServer *admin.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -35,6 +40,14 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
+	go func() {
+		if err := a.runAdminServer(ctx); err != nil {
+			logger.Error(ctx, "❌ Ошибка при работе служебного сервера", zap.Error(err))
+		}
+	}()
+
+	a.startPriceSync(ctx)
+
 	return a.runGRPCServer(ctx)
 }
 
@@ -43,6 +56,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initAdminServer,
 		a.initListener,
 		a.initGRPCServer,
 	}
@@ -63,14 +77,99 @@ func (a *App) initDI(_ context.Context) error {
 }
 
 func (a *App) initLogger(_ context.Context) error {
+	cfg := config.AppConfig().Logger
+
 	return logger.Init(
-		config.AppConfig().Logger.Level(),
-		config.AppConfig().Logger.AsJson(),
+		cfg.Level(),
+		cfg.AsJson(),
+		logger.WithServiceName("inventory"),
+		logger.WithModuleLevels(cfg.ModuleLevels()),
+		logger.WithOutputs(cfg.Outputs()...),
+		logger.WithFile(logger.FileConfig{
+			Path:       cfg.FilePath(),
+			MaxSizeMB:  cfg.FileMaxSizeMB(),
+			MaxBackups: cfg.FileMaxBackups(),
+		}),
+		logger.WithOTLP(cfg.OTLPEndpoint()),
+		logger.WithStacktraceRate(cfg.StacktraceRate()),
 	)
 }
 
+func (a *App) initAdminServer(ctx context.Context) error {
+	a.adminServer = admin.New(
+		config.AppConfig().Admin.Address(),
+		admin.WithServiceName("inventory"),
+		admin.WithHealth(a.diContainer.HealthRegistry(ctx)),
+		admin.WithConfig(config.AppConfig().Effective),
+	)
+	a.adminServer.Registry().MustRegister(a.diContainer.CacheMetrics())
+
+	// Служебный сервер останавливаем последним, чтобы health, метрики и pprof были доступны во время завершения
+	closer.AddPhase(closer.PhaseResources, "Admin server", a.adminServer.Stop)
+
+	return nil
+}
+
+func (a *App) runAdminServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🛠️ Admin server listening on %s", config.AppConfig().Admin.Address()))
+
+	return a.adminServer.Start(ctx)
+}
+
+// startPriceSync периодически переносит в детали цены, запланированные на прошедший момент.
+// GetPart и ListParts берут цену из истории сами, синхронизация нужна фильтру и сортировке по цене
+func (a *App) startPriceSync(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	closer.AddPhase(closer.PhaseConsumers, "Price sync", func(closeCtx context.Context) error {
+		cancel()
+
+		select {
+		case <-done:
+			return nil
+		case <-closeCtx.Done():
+			return closeCtx.Err()
+		}
+	})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(config.AppConfig().Pricing.SyncInterval())
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.syncPrices(ctx)
+			}
+		}
+	}()
+}
+
+func (a *App) syncPrices(ctx context.Context) {
+	updated, err := a.diContainer.PartService(ctx).SyncPrices(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error(ctx, "❌ Не удалось синхронизировать цены деталей", zap.Error(err))
+		}
+		return
+	}
+
+	if updated > 0 {
+		logger.Info(ctx, "💰 Применены запланированные цены деталей", zap.Int64("parts", updated))
+	}
+}
+
 func (a *App) initCloser(_ context.Context) error {
 	closer.SetLogger(logger.Logger())
+	closer.SetTimeouts(
+		config.AppConfig().Shutdown.Timeout(),
+		config.AppConfig().Shutdown.StepTimeout(),
+	)
 	return nil
 }
 
@@ -94,8 +193,17 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
-	closer.AddNamed("gRPC server", func(ctx context.Context) error {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return fmt.Errorf("failed to create request validator: %w", err)
+	}
+
+	a.grpcServer = grpc.NewServer(append(
+		[]grpc.ServerOption{grpc.Creds(a.diContainer.ServerCredentials(ctx))},
+		interceptors.ServerOptions(logger.Named("grpc"), interceptors.WithValidation(validator))...,
+	)...)
+	closer.AddPhase(closer.PhaseServers, "gRPC server", func(ctx context.Context) error {
+		a.diContainer.InventoryV1API(ctx).CloseStreams()
 		a.grpcServer.GracefulStop()
 		return nil
 	})
@@ -103,7 +211,7 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	reflection.Register(a.grpcServer)
 
 	// Регистрируем health service для проверки работоспособности
-	health.RegisterService(a.grpcServer)
+	health.RegisterService(a.grpcServer, a.diContainer.HealthRegistry(ctx))
 
 	inventoryV1.RegisterInventoryServiceServer(a.grpcServer, a.diContainer.InventoryV1API(ctx))
 
@@ -4,33 +4,57 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/IBM/sarama"
+	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
 	inventoryV1API "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/api/v1"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/config"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository"
+	cacheRepository "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/cache"
 	inventoryRepository "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/mongo"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
 	inventoryService "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service/part"
+	lowStockProducer "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service/producer"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage"
+	localStorage "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/local"
+	s3Storage "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/s3"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
-	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
+	wrappedKafka "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	wrappedKafkaProducer "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/producer"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/migrator"
+	platformTLS "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/tls"
 )
 
 type diContainer struct {
-	inventoryAPIv1      inventoryV1.InventoryServiceServer
+	inventoryAPIv1      inventoryV1API.Server
 	inventoryService    service.InventoryService
 	inventoryRepository repository.InventoryRepository
+	cacheMetrics        *cacheRepository.Metrics
+	redisClient         *redis.Client
+	lowStockProducer    service.LowStockProducer
+	blobStorage         storage.BlobStorage
+	syncProducer        sarama.SyncProducer
+	lowStockKafka       wrappedKafka.Producer
 	mongoDBClient       *mongo.Client
 	mongoDBHandle       *mongo.Database
+	mongoMigrator       *migrator.Migrator
+	healthRegistry      *health.Registry
+	tlsSource           *platformTLS.Source
 }
 
 func NewDiContainer() *diContainer {
 	return &diContainer{}
 }
 
-func (d *diContainer) InventoryV1API(ctx context.Context) inventoryV1.InventoryServiceServer {
+func (d *diContainer) InventoryV1API(ctx context.Context) inventoryV1API.Server {
 	if d.inventoryAPIv1 == nil {
 		d.inventoryAPIv1 = inventoryV1API.NewAPI(d.PartService(ctx))
 	}
@@ -39,18 +63,158 @@ func (d *diContainer) InventoryV1API(ctx context.Context) inventoryV1.InventoryS
 
 func (d *diContainer) PartService(ctx context.Context) service.InventoryService {
 	if d.inventoryService == nil {
-		d.inventoryService = inventoryService.NewService(d.PartRepository(ctx))
+		d.inventoryService = inventoryService.NewService(
+			d.PartRepository(ctx),
+			d.LowStockProducer(ctx),
+			config.AppConfig().LowStock.ReorderPolicy(),
+			d.BlobStorage(ctx),
+			config.AppConfig().Storage.AttachmentMaxSize(),
+		)
 	}
 	return d.inventoryService
 }
 
+// BlobStorage возвращает хранилище содержимого вложений выбранного драйвера
+func (d *diContainer) BlobStorage(ctx context.Context) storage.BlobStorage {
+	if d.blobStorage == nil {
+		cfg := config.AppConfig().Storage
+
+		var (
+			blobs storage.BlobStorage
+			err   error
+		)
+		switch cfg.Driver() {
+		case "s3":
+			blobs, err = s3Storage.NewStorage(ctx, cfg.S3())
+		default:
+			blobs, err = localStorage.NewStorage(cfg.LocalPath())
+		}
+		if err != nil {
+			panic(fmt.Sprintf("failed to create attachment storage: %v", err))
+		}
+
+		d.blobStorage = blobs
+	}
+
+	return d.blobStorage
+}
+
+// LowStockProducer возвращает producer событий InventoryLowStock или nil, если оповещения выключены
+func (d *diContainer) LowStockProducer(_ context.Context) service.LowStockProducer {
+	if d.lowStockProducer == nil {
+		if !config.AppConfig().LowStock.Enabled() {
+			return nil
+		}
+		d.lowStockProducer = lowStockProducer.NewService(d.LowStockKafkaProducer())
+	}
+	return d.lowStockProducer
+}
+
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().LowStock.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create sync producer: %s\n", err.Error()))
+		}
+		closer.AddPhase(closer.PhaseProducers, "Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.syncProducer = p
+	}
+
+	return d.syncProducer
+}
+
+func (d *diContainer) LowStockKafkaProducer() wrappedKafka.Producer {
+	if d.lowStockKafka == nil {
+		d.lowStockKafka = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().LowStock.Topic(),
+			logger.Named("kafka"),
+		)
+	}
+
+	return d.lowStockKafka
+}
+
 func (d *diContainer) PartRepository(ctx context.Context) repository.InventoryRepository {
 	if d.inventoryRepository == nil {
+		if config.AppConfig().Mongo.MigrateOnStart() {
+			d.migrateMongo(ctx)
+		}
+
 		d.inventoryRepository = inventoryRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+
+		if cfg := config.AppConfig().Cache; cfg.Enabled() {
+			cacheCfg := cacheRepository.Config{Size: cfg.Size(), TTL: cfg.TTL()}
+			if cfg.SharedEnabled() {
+				cacheCfg.Shared = cacheRepository.NewRedisShared(d.RedisClient(ctx))
+				cacheCfg.SharedTTL = cfg.SharedTTL()
+			}
+
+			d.inventoryRepository = cacheRepository.NewRepository(d.inventoryRepository, cacheCfg, d.CacheMetrics())
+		}
 	}
 	return d.inventoryRepository
 }
 
+// CacheMetrics возвращает счётчики попаданий и промахов кэша репозитория деталей
+func (d *diContainer) CacheMetrics() *cacheRepository.Metrics {
+	if d.cacheMetrics == nil {
+		d.cacheMetrics = cacheRepository.NewMetrics()
+	}
+	return d.cacheMetrics
+}
+
+// RedisClient возвращает клиент Redis общего кэша. Недоступность Redis не мешает старту:
+// кэш тогда просто не используется
+func (d *diContainer) RedisClient(_ context.Context) *redis.Client {
+	if d.redisClient == nil {
+		client := redis.NewClient(config.AppConfig().Cache.RedisOptions())
+
+		closer.AddNamed("Redis client", func(ctx context.Context) error {
+			return client.Close()
+		})
+
+		d.redisClient = client
+	}
+
+	return d.redisClient
+}
+
+// MongoMigrator возвращает мигратор схемы MongoDB (журнал в коллекции schema_migrations)
+func (d *diContainer) MongoMigrator(ctx context.Context) *migrator.Migrator {
+	if d.mongoMigrator == nil {
+		m, err := inventoryRepository.NewMigrator(d.MongoDBHandle(ctx))
+		if err != nil {
+			panic(fmt.Sprintf("failed to create MongoDB migrator: %v", err))
+		}
+
+		d.mongoMigrator = m
+	}
+
+	return d.mongoMigrator
+}
+
+// migrateMongo применяет миграции до создания репозитория: без индексов и схемы сервис не стартует
+func (d *diContainer) migrateMongo(ctx context.Context) {
+	applied, err := d.MongoMigrator(ctx).Up(ctx)
+	for _, migration := range applied {
+		logger.Info(ctx, "✅ Применена миграция MongoDB",
+			zap.Int64("version", migration.Version),
+			zap.String("name", migration.Name),
+		)
+	}
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate MongoDB: %v", err))
+	}
+}
+
 func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 	if d.mongoDBClient == nil {
 		client, err := mongo.Connect(ctx, options.Client().ApplyURI(config.AppConfig().Mongo.URI()))
@@ -79,3 +243,49 @@ func (d *diContainer) MongoDBHandle(ctx context.Context) *mongo.Database {
 	}
 	return d.mongoDBHandle
 }
+
+func (d *diContainer) HealthRegistry(ctx context.Context) *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+		registry.Register("mongo", health.Readiness, health.MongoCheck(d.MongoDBClient(ctx)))
+
+		watchCtx, cancel := context.WithCancel(context.Background())
+		registry.Start(watchCtx)
+		closer.AddNamed("Health checks", func(ctx context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
+
+// TLSSource возвращает источник сертификатов для mTLS или nil, если TLS выключен
+func (d *diContainer) TLSSource(_ context.Context) *platformTLS.Source {
+	if d.tlsSource == nil && config.AppConfig().TLS.Enabled() {
+		source, err := platformTLS.NewSource(config.AppConfig().TLS, logger.Named("tls"))
+		if err != nil {
+			panic(fmt.Sprintf("failed to load TLS certificates: %v", err))
+		}
+
+		source.Watch(context.Background())
+		closer.AddNamed("TLS certificates reloader", func(ctx context.Context) error {
+			return source.Close()
+		})
+
+		d.tlsSource = source
+	}
+
+	return d.tlsSource
+}
+
+// ServerCredentials возвращает credentials gRPC-сервера: mTLS, если он включён, иначе insecure
+func (d *diContainer) ServerCredentials(ctx context.Context) credentials.TransportCredentials {
+	if source := d.TLSSource(ctx); source != nil {
+		return source.ServerCredentials()
+	}
+
+	return insecure.NewCredentials()
+}
@@ -0,0 +1,103 @@
+package catalog
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// Change — отличие поля детали в каталоге от сохранённого значения
+type Change struct {
+	Field string
+	Old   string
+	New   string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s → %s", c.Field, c.Old, c.New)
+}
+
+// Diff сравнивает изменяемые поля детали. Идентификатор и даты не сравниваются.
+func Diff(old, updated *model.Part) []Change {
+	var changes []Change
+
+	add := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, Change{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	add("name", strconv.Quote(old.Name), strconv.Quote(updated.Name))
+	add("description", strconv.Quote(old.Description), strconv.Quote(updated.Description))
+	add("price", formatNumber(old.Price), formatNumber(updated.Price))
+	add("stock_quantity", strconv.FormatInt(old.StockQuantity, 10), strconv.FormatInt(updated.StockQuantity, 10))
+	add("stock", formatStock(old.Stock), formatStock(updated.Stock))
+	add("reorder_threshold", formatThreshold(old.ReorderThreshold), formatThreshold(updated.ReorderThreshold))
+	add("category", old.Category.String(), updated.Category.String())
+	add("dimensions.length", formatNumber(old.Dimensions.Length), formatNumber(updated.Dimensions.Length))
+	add("dimensions.width", formatNumber(old.Dimensions.Width), formatNumber(updated.Dimensions.Width))
+	add("dimensions.height", formatNumber(old.Dimensions.Height), formatNumber(updated.Dimensions.Height))
+	add("dimensions.weight", formatNumber(old.Dimensions.Weight), formatNumber(updated.Dimensions.Weight))
+	// Страна и сайт относятся к справочнику производителей: импорт детали их не меняет
+	if model.ManufacturerKey(old.Manufacturer.Name) != model.ManufacturerKey(updated.Manufacturer.Name) {
+		add("manufacturer.name", strconv.Quote(old.Manufacturer.Name), strconv.Quote(updated.Manufacturer.Name))
+	}
+	add("tags", formatTags(old.Tags), formatTags(updated.Tags))
+
+	keys := slices.Sorted(maps.Keys(old.Metadata))
+	for key := range updated.Metadata {
+		if _, ok := old.Metadata[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		add("metadata."+key, formatMetaValue(old.Metadata, key), formatMetaValue(updated.Metadata, key))
+	}
+
+	return changes
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func formatThreshold(threshold *int64) string {
+	if threshold == nil {
+		return "<none>"
+	}
+
+	return strconv.FormatInt(*threshold, 10)
+}
+
+func formatStock(stock []model.StockLevel) string {
+	levels := make([]string, 0, len(stock))
+	for _, level := range stock {
+		levels = append(levels, level.Warehouse+stockSeparator+strconv.FormatInt(level.Quantity, 10))
+	}
+
+	return "[" + strings.Join(levels, ", ") + "]"
+}
+
+func formatTags(tags []string) string {
+	return "[" + strings.Join(tags, ", ") + "]"
+}
+
+func formatMetaValue(metadata map[string]model.Value, key string) string {
+	value, ok := metadata[key]
+	if !ok {
+		return "<none>"
+	}
+
+	data, err := newMetaValue(value).MarshalJSON()
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// Lister — постраничное чтение каталога для выгрузки
+type Lister interface {
+	ListParts(ctx context.Context, filter *model.Filter, page *model.PageRequest) (*model.PartsPage, error)
+}
+
+// Export выгружает детали, подходящие под фильтр, страницами максимального размера.
+// Возвращает число записанных деталей.
+func Export(ctx context.Context, lister Lister, filter *model.Filter, w *Writer) (int, error) {
+	page := &model.PageRequest{Size: model.MaxPageSize}
+	count := 0
+
+	for {
+		result, err := lister.ListParts(ctx, filter, page)
+		if err != nil {
+			return count, err
+		}
+
+		for i := range result.Parts {
+			if err = w.Write(&result.Parts[i]); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if result.NextPageToken == "" {
+			return count, w.Close()
+		}
+		page.Token = result.NextPageToken
+	}
+}
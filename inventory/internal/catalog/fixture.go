@@ -0,0 +1,157 @@
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// LoadFixture разбирает YAML-каталог из пакета fixtures.
+// В отличие от импорта, любая ошибка строки делает фикстуру непригодной.
+func LoadFixture(data []byte) ([]model.Part, error) {
+	rows, err := Read(bytes.NewReader(data), FormatYAML)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]model.Part, 0, len(rows))
+	for _, row := range rows {
+		if row.Err != nil {
+			return nil, fmt.Errorf("fixture line %d: %w", row.Line, row.Err)
+		}
+		parts = append(parts, *row.Part)
+	}
+
+	return parts, nil
+}
+
+// LinkManufacturers заводит справочник производителей по деталям фикстуры.
+// Производители с одинаковым ключом объединяются, детали получают ссылку на запись справочника.
+func LinkManufacturers(parts []model.Part, now time.Time) []model.Manufacturer {
+	var manufacturers []model.Manufacturer
+	byKey := make(map[string]int)
+
+	for i := range parts {
+		key := model.ManufacturerKey(parts[i].Manufacturer.Name)
+		if key == "" {
+			continue
+		}
+
+		index, ok := byKey[key]
+		if !ok {
+			manufacturer := parts[i].Manufacturer
+			manufacturer.ManufacturerUuid = uuid.New()
+			manufacturer.CreatedAt = now
+			manufacturer.UpdatedAt = now
+
+			index = len(manufacturers)
+			byKey[key] = index
+			manufacturers = append(manufacturers, manufacturer)
+		}
+
+		parts[i].ManufacturerUuid = manufacturers[index].ManufacturerUuid
+		parts[i].Manufacturer = manufacturers[index]
+	}
+
+	return manufacturers
+}
+
+// LoadWarehouses разбирает YAML-справочник складов из пакета fixtures
+func LoadWarehouses(data []byte) ([]model.Warehouse, error) {
+	var records []struct {
+		Code     string `yaml:"code"`
+		Name     string `yaml:"name"`
+		Location string `yaml:"location"`
+	}
+	if err := yaml.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse warehouses: %w", err)
+	}
+
+	warehouses := make([]model.Warehouse, 0, len(records))
+	for i, record := range records {
+		if record.Code == "" {
+			return nil, fmt.Errorf("warehouse #%d: code must not be empty", i)
+		}
+		warehouses = append(warehouses, model.Warehouse{Code: record.Code, Name: record.Name, Location: record.Location})
+	}
+
+	return warehouses, nil
+}
+
+// LoadCompatibility разбирает YAML-граф совместимости из пакета fixtures
+func LoadCompatibility(data []byte) ([]model.Compatibility, error) {
+	var records []struct {
+		PartUuid       string `yaml:"part_uuid"`
+		CompatibleUuid string `yaml:"compatible_uuid"`
+		Note           string `yaml:"note"`
+	}
+	if err := yaml.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse compatibility: %w", err)
+	}
+
+	edges := make([]model.Compatibility, 0, len(records))
+	for i, record := range records {
+		partUuid, err := uuid.Parse(record.PartUuid)
+		if err != nil {
+			return nil, fmt.Errorf("compatibility #%d: invalid part_uuid: %w", i, err)
+		}
+
+		compatibleUuid, err := uuid.Parse(record.CompatibleUuid)
+		if err != nil {
+			return nil, fmt.Errorf("compatibility #%d: invalid compatible_uuid: %w", i, err)
+		}
+
+		edge := model.Compatibility{PartUuid: partUuid, CompatibleUuid: compatibleUuid, Note: record.Note}
+		if err = edge.Validate(); err != nil {
+			return nil, fmt.Errorf("compatibility #%d: %w", i, err)
+		}
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
+
+// LoadKits разбирает YAML-справочник наборов из пакета fixtures
+func LoadKits(data []byte) ([]model.Kit, error) {
+	var records []struct {
+		KitUuid     string `yaml:"kit_uuid"`
+		Name        string `yaml:"name"`
+		Description string `yaml:"description"`
+		Items       []struct {
+			PartUuid string `yaml:"part_uuid"`
+			Quantity int64  `yaml:"quantity"`
+		} `yaml:"items"`
+	}
+	if err := yaml.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse kits: %w", err)
+	}
+
+	kits := make([]model.Kit, 0, len(records))
+	for i, record := range records {
+		kitUuid, err := uuid.Parse(record.KitUuid)
+		if err != nil {
+			return nil, fmt.Errorf("kit #%d: invalid kit_uuid: %w", i, err)
+		}
+
+		kit := model.Kit{KitUuid: kitUuid, Name: record.Name, Description: record.Description}
+		for _, item := range record.Items {
+			partUuid, err := uuid.Parse(item.PartUuid)
+			if err != nil {
+				return nil, fmt.Errorf("kit #%d: invalid part_uuid: %w", i, err)
+			}
+			kit.Items = append(kit.Items, model.KitItem{PartUuid: partUuid, Quantity: item.Quantity})
+		}
+
+		if err = kit.Validate(); err != nil {
+			return nil, fmt.Errorf("kit #%d: %w", i, err)
+		}
+		kits = append(kits, kit)
+	}
+
+	return kits, nil
+}
@@ -0,0 +1,36 @@
+package catalog
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Format — формат файла каталога
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+	FormatYAML  Format = "yaml"
+)
+
+// ParseFormat разбирает формат, заданный явно, или определяет его по расширению файла
+func ParseFormat(name, path string) (Format, error) {
+	if name == "" {
+		name = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	switch strings.ToLower(name) {
+	case "csv":
+		return FormatCSV, nil
+	case "jsonl", "ndjson":
+		return FormatJSONL, nil
+	case "yaml", "yml":
+		return FormatYAML, nil
+	case "":
+		return "", fmt.Errorf("format is not specified and can't be derived from file name %q", path)
+	default:
+		return "", fmt.Errorf("unsupported format %q, expected csv, jsonl or yaml", name)
+	}
+}
@@ -0,0 +1,121 @@
+package catalog_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/fixtures"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/catalog"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *CatalogSuite) TestRoundTrip() {
+	parts, err := catalog.LoadFixture(fixtures.Parts)
+	s.Require().NoError(err)
+	s.Require().Len(parts, 3)
+
+	for _, format := range []catalog.Format{catalog.FormatCSV, catalog.FormatJSONL, catalog.FormatYAML} {
+		var buf bytes.Buffer
+
+		writer, err := catalog.NewWriter(&buf, format)
+		s.Require().NoError(err)
+		for i := range parts {
+			s.Require().NoError(writer.Write(&parts[i]))
+		}
+		s.Require().NoError(writer.Close())
+
+		rows, err := catalog.Read(&buf, format)
+		s.Require().NoError(err, format)
+		s.Require().Len(rows, len(parts), format)
+
+		for i, row := range rows {
+			s.Require().NoError(row.Err, format)
+			assert.Equal(s.T(), parts[i], *row.Part, format)
+		}
+	}
+}
+
+func (s *CatalogSuite) TestMetadataKeepsType() {
+	parts, err := catalog.LoadFixture(fixtures.Parts)
+	s.Require().NoError(err)
+
+	// 400000.0 в YAML — дробное число, после выгрузки оно не должно стать целым
+	assert.Equal(s.T(), model.Value{Float64Value: 400000}, parts[2].Metadata["capacity"])
+	assert.Equal(s.T(), model.Value{Int64Value: 150}, parts[0].Metadata["test_fires"])
+
+	var buf bytes.Buffer
+	writer, err := catalog.NewWriter(&buf, catalog.FormatJSONL)
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Write(&parts[2]))
+	assert.Contains(s.T(), buf.String(), `"capacity":400000.0`)
+}
+
+func (s *CatalogSuite) TestReadRowErrors() {
+	input := strings.Join([]string{
+		`{"name":"Valve","price":10,"stock_quantity":1,"category":"FUEL","dimensions":{"length":1,"width":1,"height":1,"weight":1}}`,
+		`{"name":"Valve","colour":"red"}`,
+		`{"part_uuid":"not-a-uuid","name":"","price":-1,"category":"ROCKET","dimensions":{"length":1,"width":1,"height":1,"weight":1}}`,
+		``,
+		`{"name":"Valve","price":10,"category":"FUEL","dimensions":{"length":1,"width":1,"height":1,"weight":1},"metadata":{"bad":[1]}}`,
+	}, "\n")
+
+	rows, err := catalog.Read(strings.NewReader(input), catalog.FormatJSONL)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 4)
+
+	s.Require().NoError(rows[0].Err)
+	assert.Equal(s.T(), model.FUEL, rows[0].Part.Category)
+
+	for _, row := range rows[1:] {
+		assert.ErrorIs(s.T(), row.Err, model.ErrInvalidPart)
+	}
+	assert.Equal(s.T(), []int{1, 2, 3, 5}, []int{rows[0].Line, rows[1].Line, rows[2].Line, rows[3].Line})
+
+	assert.ErrorContains(s.T(), rows[1].Err, "colour")
+	assert.ErrorContains(s.T(), rows[2].Err, `part_uuid "not-a-uuid" is not a valid UUID`)
+	assert.ErrorContains(s.T(), rows[2].Err, `category "ROCKET" is unknown`)
+	assert.ErrorContains(s.T(), rows[2].Err, "name must not be empty")
+	assert.NotContains(s.T(), rows[2].Err.Error(), "category must be specified")
+}
+
+func (s *CatalogSuite) TestReadCSV() {
+	input := "name,price,stock_quantity,stock,category,length,width,height,weight,tags,metadata\n" +
+		"Valve,10.5,0,BAIKONUR:1|PLESETSK:2,fuel,1,2,3,4,a|b,\"{\"\"rating\"\":2.5}\"\n" +
+		"Broken,ten,3,BAIKONUR,FUEL,1,2,3,4,,\n"
+
+	rows, err := catalog.Read(strings.NewReader(input), catalog.FormatCSV)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 2)
+
+	s.Require().NoError(rows[0].Err)
+	assert.Equal(s.T(), 2, rows[0].Line)
+	assert.Equal(s.T(), []string{"a", "b"}, rows[0].Part.Tags)
+	assert.Equal(s.T(), model.Value{Float64Value: 2.5}, rows[0].Part.Metadata["rating"])
+	assert.Equal(s.T(), []model.StockLevel{{Warehouse: "BAIKONUR", Quantity: 1}, {Warehouse: "PLESETSK", Quantity: 2}}, rows[0].Part.Stock)
+	assert.Equal(s.T(), int64(3), rows[0].Part.StockQuantity)
+
+	assert.Equal(s.T(), 3, rows[1].Line)
+	assert.ErrorContains(s.T(), rows[1].Err, `price "ten" is not a number`)
+	assert.ErrorContains(s.T(), rows[1].Err, `stock "BAIKONUR" must be WAREHOUSE:quantity`)
+
+	_, err = catalog.Read(strings.NewReader("name,colour\n"), catalog.FormatCSV)
+	assert.ErrorContains(s.T(), err, `unknown csv column "colour"`)
+}
+
+func (s *CatalogSuite) TestParseFormat() {
+	format, err := catalog.ParseFormat("", "parts.YML")
+	s.Require().NoError(err)
+	assert.Equal(s.T(), catalog.FormatYAML, format)
+
+	format, err = catalog.ParseFormat("jsonl", "parts.txt")
+	s.Require().NoError(err)
+	assert.Equal(s.T(), catalog.FormatJSONL, format)
+
+	_, err = catalog.ParseFormat("", "parts")
+	assert.Error(s.T(), err)
+
+	_, err = catalog.ParseFormat("xml", "")
+	assert.Error(s.T(), err)
+}
@@ -0,0 +1,114 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// Store — операции каталога, через которые идёт импорт.
+// Реализуется сервисом деталей, поэтому импорт проходит те же проверки, что и gRPC API.
+type Store interface {
+	GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	UpdatePart(ctx context.Context, part *model.Part, paths []string) (*model.Part, error)
+}
+
+// Importer загружает строки каталога с upsert по part_uuid
+type Importer struct {
+	store  Store
+	dryRun bool
+}
+
+func NewImporter(store Store, dryRun bool) *Importer {
+	return &Importer{store: store, dryRun: dryRun}
+}
+
+// Import применяет строки по порядку. Ошибка строки не прерывает импорт,
+// а попадает в отчёт; функция возвращает ошибку только при отмене контекста.
+func (i *Importer) Import(ctx context.Context, rows []Row) (*Report, error) {
+	report := &Report{DryRun: i.dryRun}
+	seen := make(map[uuid.UUID]int, len(rows))
+
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		if row.Err != nil {
+			report.add(Entry{Line: row.Line, Action: ActionFailed, Err: row.Err})
+			continue
+		}
+
+		if row.Part.PartUuid != uuid.Nil {
+			if line, ok := seen[row.Part.PartUuid]; ok {
+				report.add(Entry{
+					Line:     row.Line,
+					PartUuid: row.Part.PartUuid,
+					Action:   ActionFailed,
+					Err:      fmt.Errorf("%w: duplicate part_uuid, first seen at line %d", model.ErrInvalidPart, line),
+				})
+				continue
+			}
+			seen[row.Part.PartUuid] = row.Line
+		}
+
+		report.add(i.upsert(ctx, row))
+	}
+
+	return report, nil
+}
+
+func (i *Importer) upsert(ctx context.Context, row Row) Entry {
+	entry := Entry{Line: row.Line, PartUuid: row.Part.PartUuid}
+
+	var existing *model.Part
+	if row.Part.PartUuid != uuid.Nil {
+		part, err := i.store.GetPart(ctx, row.Part.PartUuid)
+		switch {
+		case err == nil:
+			existing = part
+		case !errors.Is(err, model.ErrPartNotFound):
+			entry.Action, entry.Err = ActionFailed, err
+			return entry
+		}
+	}
+
+	if existing == nil {
+		entry.Action = ActionCreated
+		if i.dryRun {
+			return entry
+		}
+
+		created, err := i.store.CreatePart(ctx, row.Part)
+		if err != nil {
+			entry.Action, entry.Err = ActionFailed, err
+			return entry
+		}
+		entry.PartUuid = created.PartUuid
+
+		return entry
+	}
+
+	entry.Changes = Diff(existing, row.Part)
+	if len(entry.Changes) == 0 {
+		entry.Action = ActionUnchanged
+		return entry
+	}
+
+	entry.Action = ActionUpdated
+	if i.dryRun {
+		return entry
+	}
+
+	// Запись каталога описывает деталь целиком, поэтому обновляются все изменяемые поля
+	if _, err := i.store.UpdatePart(ctx, row.Part, nil); err != nil {
+		entry.Action, entry.Err = ActionFailed, err
+	}
+
+	return entry
+}
@@ -0,0 +1,135 @@
+package catalog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/catalog"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+var (
+	detail1 = uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834")
+	detail2 = uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e835")
+	newPart = uuid.MustParse("7d1f0c9e-4a53-4b8e-9a3e-0f3c2c1b5d11")
+)
+
+func (s *CatalogSuite) importRows() []catalog.Row {
+	changed, err := s.service.GetPart(s.ctx, detail1)
+	s.Require().NoError(err)
+	changed.Price = 150
+	changed.Tags = []string{"tag1"}
+
+	same, err := s.service.GetPart(s.ctx, detail2)
+	s.Require().NoError(err)
+
+	created := *same
+	created.PartUuid = newPart
+	created.Name = "Detail 3"
+
+	anonymous := *same
+	anonymous.PartUuid = uuid.Nil
+	anonymous.Name = "Detail 4"
+
+	return []catalog.Row{
+		{Line: 1, Part: changed},
+		{Line: 2, Part: same},
+		{Line: 3, Part: &created},
+		{Line: 4, Part: &anonymous},
+		{Line: 5, Part: &created},
+		{Line: 6, Err: model.ErrInvalidPart},
+	}
+}
+
+func (s *CatalogSuite) TestImportDryRun() {
+	report, err := catalog.NewImporter(s.service, true).Import(s.ctx, s.importRows())
+	s.Require().NoError(err)
+
+	assert.Equal(s.T(), 2, report.Count(catalog.ActionCreated))
+	assert.Equal(s.T(), 1, report.Count(catalog.ActionUpdated))
+	assert.Equal(s.T(), 1, report.Count(catalog.ActionUnchanged))
+	assert.Equal(s.T(), 2, report.Count(catalog.ActionFailed))
+
+	assert.Equal(s.T(), []catalog.Change{
+		{Field: "price", Old: "100", New: "150"},
+		{Field: "tags", Old: "[tag1, tag2]", New: "[tag1]"},
+	}, report.Entries[0].Changes)
+
+	// В режиме dry-run ничего не записывается
+	part, err := s.service.GetPart(s.ctx, detail1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 100.0, part.Price)
+
+	_, err = s.service.GetPart(s.ctx, newPart)
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+
+	var diff bytes.Buffer
+	s.Require().NoError(report.WriteDiff(&diff))
+	assert.Contains(s.T(), diff.String(), "~ line 1: update "+detail1.String()+"\n    price: 100 → 150\n")
+	assert.Contains(s.T(), diff.String(), "+ line 4: create <new uuid>\n")
+	assert.Contains(s.T(), diff.String(), "! line 5: invalid part: duplicate part_uuid, first seen at line 3\n")
+	assert.Contains(s.T(), diff.String(), "created: 2, updated: 1, unchanged: 1, failed: 2 (dry run, nothing was written)\n")
+}
+
+func (s *CatalogSuite) TestImport() {
+	report, err := catalog.NewImporter(s.service, false).Import(s.ctx, s.importRows())
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 2, report.Count(catalog.ActionFailed))
+
+	part, err := s.service.GetPart(s.ctx, detail1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 150.0, part.Price)
+	assert.Equal(s.T(), []string{"tag1"}, part.Tags)
+
+	part, err = s.service.GetPart(s.ctx, newPart)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Detail 3", part.Name)
+
+	// Деталь без part_uuid получает идентификатор от сервиса
+	assert.NotEqual(s.T(), uuid.Nil, report.Entries[3].PartUuid)
+
+	// Повторный импорт тех же строк ничего не меняет
+	report, err = catalog.NewImporter(s.service, false).Import(s.ctx, s.importRows()[:3])
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 3, report.Count(catalog.ActionUnchanged))
+}
+
+func (s *CatalogSuite) TestWriteErrors() {
+	report, err := catalog.NewImporter(s.service, true).Import(s.ctx, s.importRows())
+	s.Require().NoError(err)
+
+	var out bytes.Buffer
+	s.Require().NoError(report.WriteErrors(&out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	s.Require().Len(lines, 2)
+
+	var first struct {
+		Line     int    `json:"line"`
+		PartUuid string `json:"part_uuid"`
+		Error    string `json:"error"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(s.T(), 5, first.Line)
+	assert.Equal(s.T(), newPart.String(), first.PartUuid)
+	assert.Contains(s.T(), first.Error, "duplicate part_uuid")
+}
+
+func (s *CatalogSuite) TestExport() {
+	var buf bytes.Buffer
+	writer, err := catalog.NewWriter(&buf, catalog.FormatJSONL)
+	s.Require().NoError(err)
+
+	count, err := catalog.Export(s.ctx, s.service, &model.Filter{Names: []string{"Detail 2"}}, writer)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 1, count)
+
+	rows, err := catalog.Read(&buf, catalog.FormatJSONL)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	assert.Equal(s.T(), detail2, rows[0].Part.PartUuid)
+}
@@ -0,0 +1,155 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// MetaValue — значение метаданных с явным типом.
+// Тип берётся из литерала: строка, целое, дробное (с точкой или экспонентой) или bool,
+// поэтому 400000.0 остаётся дробным после экспорта и повторного импорта.
+type MetaValue struct {
+	Kind  model.ValueKind
+	Value model.Value
+}
+
+// newMetaValue определяет тип значения так же, как это делает конвертер gRPC API
+func newMetaValue(value model.Value) MetaValue {
+	switch {
+	case value.StringValue != "":
+		return MetaValue{Kind: model.ValueKindString, Value: value}
+	case value.Int64Value != 0:
+		return MetaValue{Kind: model.ValueKindInt64, Value: value}
+	case value.Float64Value != 0:
+		return MetaValue{Kind: model.ValueKindFloat64, Value: value}
+	default:
+		return MetaValue{Kind: model.ValueKindBool, Value: value}
+	}
+}
+
+func (v MetaValue) MarshalJSON() ([]byte, error) {
+	switch v.Kind {
+	case model.ValueKindString:
+		return json.Marshal(v.Value.StringValue)
+	case model.ValueKindInt64:
+		return []byte(strconv.FormatInt(v.Value.Int64Value, 10)), nil
+	case model.ValueKindFloat64:
+		return formatFloat(v.Value.Float64Value)
+	default:
+		return json.Marshal(v.Value.BoolValue)
+	}
+}
+
+func (v *MetaValue) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var raw any
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	switch value := raw.(type) {
+	case string:
+		*v = MetaValue{Kind: model.ValueKindString, Value: model.Value{StringValue: value}}
+	case bool:
+		*v = MetaValue{Kind: model.ValueKindBool, Value: model.Value{BoolValue: value}}
+	case json.Number:
+		return v.parseNumber(value.String())
+	default:
+		return fmt.Errorf("metadata value must be a string, number or bool, got %s", data)
+	}
+
+	return nil
+}
+
+func (v MetaValue) MarshalYAML() (any, error) {
+	switch v.Kind {
+	case model.ValueKindString:
+		return v.Value.StringValue, nil
+	case model.ValueKindInt64:
+		return v.Value.Int64Value, nil
+	case model.ValueKindFloat64:
+		text, err := formatFloat(v.Value.Float64Value)
+		if err != nil {
+			return nil, err
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: string(text)}, nil
+	default:
+		return v.Value.BoolValue, nil
+	}
+}
+
+func (v *MetaValue) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: metadata value must be a scalar", node.Line)
+	}
+
+	switch node.ShortTag() {
+	case "!!str":
+		*v = MetaValue{Kind: model.ValueKindString, Value: model.Value{StringValue: node.Value}}
+	case "!!bool":
+		var value bool
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		*v = MetaValue{Kind: model.ValueKindBool, Value: model.Value{BoolValue: value}}
+	case "!!int":
+		var value int64
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		*v = MetaValue{Kind: model.ValueKindInt64, Value: model.Value{Int64Value: value}}
+	case "!!float":
+		var value float64
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		*v = MetaValue{Kind: model.ValueKindFloat64, Value: model.Value{Float64Value: value}}
+	default:
+		return fmt.Errorf("line %d: metadata value must be a string, number or bool", node.Line)
+	}
+
+	return nil
+}
+
+func (v *MetaValue) parseNumber(text string) error {
+	if !strings.ContainsAny(text, ".eE") {
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return fmt.Errorf("metadata value %s: %w", text, err)
+		}
+		*v = MetaValue{Kind: model.ValueKindInt64, Value: model.Value{Int64Value: value}}
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return fmt.Errorf("metadata value %s: %w", text, err)
+	}
+	*v = MetaValue{Kind: model.ValueKindFloat64, Value: model.Value{Float64Value: value}}
+
+	return nil
+}
+
+// formatFloat записывает дробное число так, чтобы его нельзя было принять за целое
+func formatFloat(value float64) ([]byte, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, fmt.Errorf("metadata value %v is not a finite number", value)
+	}
+
+	text := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(text, ".eE") {
+		text += ".0"
+	}
+
+	return []byte(text), nil
+}
@@ -0,0 +1,283 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// Row — разобранная строка каталога.
+// Line — номер строки файла (для YAML — строка начала элемента), Err — ошибка схемы.
+type Row struct {
+	Line int
+	Part *model.Part
+	Err  error
+}
+
+// csvColumns — колонки CSV: вложенные поля развёрнуты, теги разделены «|», метаданные — JSON-объект,
+// остатки по складам записываются как «СКЛАД:количество» через «|»
+var csvColumns = []string{
+	"part_uuid", "name", "description", "price", "stock_quantity", "stock", "reorder_threshold", "category",
+	"length", "width", "height", "weight",
+	"manufacturer_name", "manufacturer_country", "manufacturer_website",
+	"tags", "metadata",
+}
+
+const (
+	tagSeparator   = "|"
+	stockSeparator = ":"
+)
+
+// Read разбирает каталог. Ошибки отдельных строк возвращаются в Row.Err,
+// ошибка функции означает, что файл нельзя прочитать целиком.
+func Read(r io.Reader, format Format) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSONL:
+		return readJSONL(r)
+	case FormatYAML:
+		return readYAML(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func newRow(line int, record *Record) Row {
+	part, err := record.ToPart()
+	return Row{Line: line, Part: part, Err: err}
+}
+
+func readJSONL(r io.Reader) ([]Row, error) {
+	var rows []Row
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.DisallowUnknownFields()
+
+		var record Record
+		if err := decoder.Decode(&record); err != nil {
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: %v", model.ErrInvalidPart, err)})
+			continue
+		}
+
+		rows = append(rows, newRow(line, &record))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read jsonl: %w", err)
+	}
+
+	return rows, nil
+}
+
+func readYAML(r io.Reader) ([]Row, error) {
+	var document yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse yaml: %w", err)
+	}
+
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.SequenceNode {
+		return nil, errors.New("yaml catalog must be a sequence of parts")
+	}
+
+	items := document.Content[0].Content
+	rows := make([]Row, 0, len(items))
+
+	for _, item := range items {
+		record, err := decodeYAMLRecord(item)
+		if err != nil {
+			rows = append(rows, Row{Line: item.Line, Err: fmt.Errorf("%w: %v", model.ErrInvalidPart, err)})
+			continue
+		}
+
+		rows = append(rows, newRow(item.Line, record))
+	}
+
+	return rows, nil
+}
+
+// decodeYAMLRecord разбирает элемент последовательности, запрещая неизвестные поля
+func decodeYAMLRecord(item *yaml.Node) (*Record, error) {
+	data, err := yaml.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var record Record
+	if err = decoder.Decode(&record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func readCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !slices.Contains(csvColumns, name) {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		columns[name] = i
+	}
+
+	var rows []Row
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rows = append(rows, Row{Line: parseErr.Line, Err: fmt.Errorf("%w: %v", model.ErrInvalidPart, err)})
+				continue
+			}
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if len(fields) != len(header) {
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: expected %d fields, got %d", model.ErrInvalidPart, len(header), len(fields))})
+			continue
+		}
+
+		record, err := csvRecord(columns, fields)
+		if err != nil {
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: %v", model.ErrInvalidPart, err)})
+			continue
+		}
+
+		rows = append(rows, newRow(line, record))
+	}
+
+	return rows, nil
+}
+
+func csvRecord(columns map[string]int, fields []string) (*Record, error) {
+	get := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+
+	var problems []string
+	parseFloat := func(name string) float64 {
+		text := get(name)
+		if text == "" {
+			return 0
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s %q is not a number", name, text))
+		}
+		return value
+	}
+
+	record := &Record{
+		PartUuid:    get("part_uuid"),
+		Name:        get("name"),
+		Description: get("description"),
+		Price:       parseFloat("price"),
+		Category:    get("category"),
+		Dimensions: DimensionsRecord{
+			Length: parseFloat("length"),
+			Width:  parseFloat("width"),
+			Height: parseFloat("height"),
+			Weight: parseFloat("weight"),
+		},
+		Manufacturer: ManufacturerRecord{
+			Name:    get("manufacturer_name"),
+			Country: get("manufacturer_country"),
+			Website: get("manufacturer_website"),
+		},
+	}
+
+	if text := get("stock_quantity"); text != "" {
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("stock_quantity %q is not an integer", text))
+		}
+		record.StockQuantity = value
+	}
+
+	if text := get("stock"); text != "" {
+		for _, item := range strings.Split(text, tagSeparator) {
+			warehouse, quantity, ok := strings.Cut(strings.TrimSpace(item), stockSeparator)
+			value, err := strconv.ParseInt(strings.TrimSpace(quantity), 10, 64)
+			if !ok || err != nil {
+				problems = append(problems, fmt.Sprintf("stock %q must be WAREHOUSE:quantity", item))
+				continue
+			}
+			record.Stock = append(record.Stock, StockRecord{Warehouse: strings.TrimSpace(warehouse), Quantity: value})
+		}
+	}
+
+	if text := get("reorder_threshold"); text != "" {
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("reorder_threshold %q is not an integer", text))
+		}
+		record.ReorderThreshold = &value
+	}
+
+	if text := get("tags"); text != "" {
+		for _, tag := range strings.Split(text, tagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				record.Tags = append(record.Tags, tag)
+			}
+		}
+	}
+
+	if text := get("metadata"); text != "" {
+		if err := json.Unmarshal([]byte(text), &record.Metadata); err != nil {
+			problems = append(problems, fmt.Sprintf("metadata must be a JSON object: %v", err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.New(strings.Join(problems, "; "))
+	}
+
+	return record, nil
+}
@@ -0,0 +1,155 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// Record — строка каталога во внешнем формате (JSON Lines, YAML, CSV)
+type Record struct {
+	PartUuid         string               `json:"part_uuid,omitempty" yaml:"part_uuid,omitempty"`
+	Name             string               `json:"name" yaml:"name"`
+	Description      string               `json:"description,omitempty" yaml:"description,omitempty"`
+	Price            float64              `json:"price" yaml:"price"`
+	StockQuantity    int64                `json:"stock_quantity" yaml:"stock_quantity"`
+	Stock            []StockRecord        `json:"stock,omitempty" yaml:"stock,omitempty"`
+	ReorderThreshold *int64               `json:"reorder_threshold,omitempty" yaml:"reorder_threshold,omitempty"`
+	Category         string               `json:"category" yaml:"category"`
+	Dimensions       DimensionsRecord     `json:"dimensions" yaml:"dimensions"`
+	Manufacturer     ManufacturerRecord   `json:"manufacturer" yaml:"manufacturer"`
+	Tags             []string             `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Metadata         map[string]MetaValue `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+type DimensionsRecord struct {
+	Length float64 `json:"length" yaml:"length"`
+	Width  float64 `json:"width" yaml:"width"`
+	Height float64 `json:"height" yaml:"height"`
+	Weight float64 `json:"weight" yaml:"weight"`
+}
+
+// StockRecord — остаток на складе. Если остатки заданы, stock_quantity — их сумма
+type StockRecord struct {
+	Warehouse string `json:"warehouse" yaml:"warehouse"`
+	Quantity  int64  `json:"quantity" yaml:"quantity"`
+}
+
+type ManufacturerRecord struct {
+	Name    string `json:"name" yaml:"name"`
+	Country string `json:"country,omitempty" yaml:"country,omitempty"`
+	Website string `json:"website,omitempty" yaml:"website,omitempty"`
+}
+
+// categoryRequired — сообщение model.Part.Validate о незаданной категории
+const categoryRequired = "category must be specified"
+
+// ToPart проверяет запись по схеме каталога и переводит её в деталь.
+// Пустой part_uuid оставляет uuid.Nil: идентификатор выдаст сервис при создании.
+func (r *Record) ToPart() (*model.Part, error) {
+	var problems []string
+
+	partUuid := uuid.Nil
+	if r.PartUuid != "" {
+		parsed, err := uuid.Parse(r.PartUuid)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("part_uuid %q is not a valid UUID", r.PartUuid))
+		}
+		partUuid = parsed
+	}
+
+	category, ok := model.ParseCategory(r.Category)
+	if !ok {
+		problems = append(problems, fmt.Sprintf("category %q is unknown", r.Category))
+	}
+
+	part := &model.Part{
+		PartUuid:         partUuid,
+		Name:             r.Name,
+		Description:      r.Description,
+		Price:            r.Price,
+		StockQuantity:    r.StockQuantity,
+		ReorderThreshold: r.ReorderThreshold,
+		Category:         category,
+		Dimensions: model.Dimensions{
+			Length: r.Dimensions.Length,
+			Width:  r.Dimensions.Width,
+			Height: r.Dimensions.Height,
+			Weight: r.Dimensions.Weight,
+		},
+		Manufacturer: model.Manufacturer{
+			Name:    r.Manufacturer.Name,
+			Country: r.Manufacturer.Country,
+			Website: r.Manufacturer.Website,
+		},
+		Tags: r.Tags,
+	}
+
+	for _, level := range r.Stock {
+		part.Stock = append(part.Stock, model.StockLevel{Warehouse: level.Warehouse, Quantity: level.Quantity})
+	}
+	part.SyncStockQuantity()
+
+	if len(r.Metadata) > 0 {
+		part.Metadata = make(map[string]model.Value, len(r.Metadata))
+		for key, value := range r.Metadata {
+			part.Metadata[key] = value.Value
+		}
+	}
+
+	if err := part.Validate(); err != nil {
+		// Неизвестную категорию уже описали точнее, чем это делает Validate
+		for _, problem := range strings.Split(strings.TrimPrefix(err.Error(), model.ErrInvalidPart.Error()+": "), "; ") {
+			if ok || problem != categoryRequired {
+				problems = append(problems, problem)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", model.ErrInvalidPart, strings.Join(problems, "; "))
+	}
+
+	return part, nil
+}
+
+// NewRecord переводит деталь в запись каталога
+func NewRecord(part *model.Part) Record {
+	record := Record{
+		PartUuid:         part.PartUuid.String(),
+		Name:             part.Name,
+		Description:      part.Description,
+		Price:            part.Price,
+		StockQuantity:    part.StockQuantity,
+		ReorderThreshold: part.ReorderThreshold,
+		Category:         part.Category.String(),
+		Dimensions: DimensionsRecord{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		},
+		Manufacturer: ManufacturerRecord{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		},
+		Tags: part.Tags,
+	}
+
+	for _, level := range part.Stock {
+		record.Stock = append(record.Stock, StockRecord{Warehouse: level.Warehouse, Quantity: level.Quantity})
+	}
+
+	if len(part.Metadata) > 0 {
+		record.Metadata = make(map[string]MetaValue, len(part.Metadata))
+		for key, value := range part.Metadata {
+			record.Metadata[key] = newMetaValue(value)
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// Action — результат импорта строки
+type Action string
+
+const (
+	ActionCreated   Action = "create"
+	ActionUpdated   Action = "update"
+	ActionUnchanged Action = "unchanged"
+	ActionFailed    Action = "error"
+)
+
+// Entry — строка отчёта об импорте
+type Entry struct {
+	Line     int
+	PartUuid uuid.UUID
+	Action   Action
+	Changes  []Change
+	Err      error
+}
+
+// Report — итог импорта: по строке на каждую запись каталога
+type Report struct {
+	DryRun  bool
+	Entries []Entry
+	counts  map[Action]int
+}
+
+func (r *Report) add(entry Entry) {
+	if r.counts == nil {
+		r.counts = make(map[Action]int)
+	}
+
+	r.Entries = append(r.Entries, entry)
+	r.counts[entry.Action]++
+}
+
+// Count возвращает число строк с указанным результатом
+func (r *Report) Count(action Action) int {
+	return r.counts[action]
+}
+
+// WriteDiff печатает построчный отчёт: что создаётся, что и как меняется, какие строки отклонены
+func (r *Report) WriteDiff(w io.Writer) error {
+	for _, entry := range r.Entries {
+		var err error
+
+		switch entry.Action {
+		case ActionCreated:
+			_, err = fmt.Fprintf(w, "+ line %d: create %s\n", entry.Line, partLabel(entry.PartUuid))
+		case ActionUpdated:
+			_, err = fmt.Fprintf(w, "~ line %d: update %s\n", entry.Line, entry.PartUuid)
+			for _, change := range entry.Changes {
+				if err != nil {
+					break
+				}
+				_, err = fmt.Fprintf(w, "    %s\n", change)
+			}
+		case ActionFailed:
+			_, err = fmt.Fprintf(w, "! line %d: %v\n", entry.Line, entry.Err)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.WriteSummary(w)
+}
+
+// WriteSummary печатает итоговые счётчики
+func (r *Report) WriteSummary(w io.Writer) error {
+	mode := ""
+	if r.DryRun {
+		mode = " (dry run, nothing was written)"
+	}
+
+	_, err := fmt.Fprintf(w, "created: %d, updated: %d, unchanged: %d, failed: %d%s\n",
+		r.Count(ActionCreated), r.Count(ActionUpdated), r.Count(ActionUnchanged), r.Count(ActionFailed), mode)
+
+	return err
+}
+
+// rowError — строка файла ошибок в формате JSON Lines
+type rowError struct {
+	Line     int    `json:"line"`
+	PartUuid string `json:"part_uuid,omitempty"`
+	Error    string `json:"error"`
+}
+
+// WriteErrors записывает отклонённые строки в формате JSON Lines
+func (r *Report) WriteErrors(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	for _, entry := range r.Entries {
+		if entry.Action != ActionFailed {
+			continue
+		}
+
+		record := rowError{Line: entry.Line, Error: entry.Err.Error()}
+		if entry.PartUuid != uuid.Nil {
+			record.PartUuid = entry.PartUuid.String()
+		}
+
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func partLabel(partUuid uuid.UUID) string {
+	if partUuid == uuid.Nil {
+		return "<new uuid>"
+	}
+	return partUuid.String()
+}
@@ -0,0 +1,29 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/inmemory"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
+	partService "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service/part"
+)
+
+// CatalogSuite проверяет форматы каталога и импорт поверх in-memory репозитория
+type CatalogSuite struct {
+	suite.Suite
+	ctx     context.Context
+	service service.InventoryService
+}
+
+func (s *CatalogSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.service = partService.NewService(inmemory.NewRepository(), nil, model.ReorderPolicy{}, nil, 0)
+}
+
+func TestCatalogSuite(t *testing.T) {
+	suite.Run(t, new(CatalogSuite))
+}
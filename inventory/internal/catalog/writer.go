@@ -0,0 +1,129 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// Writer записывает детали в каталог построчно, не держа выгрузку в памяти
+type Writer struct {
+	w      io.Writer
+	format Format
+	csv    *csv.Writer
+	count  int
+}
+
+func NewWriter(w io.Writer, format Format) (*Writer, error) {
+	writer := &Writer{w: w, format: format}
+
+	switch format {
+	case FormatCSV:
+		writer.csv = csv.NewWriter(w)
+		if err := writer.csv.Write(csvColumns); err != nil {
+			return nil, err
+		}
+	case FormatJSONL, FormatYAML:
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+
+	return writer, nil
+}
+
+func (w *Writer) Write(part *model.Part) error {
+	record := NewRecord(part)
+	w.count++
+
+	switch w.format {
+	case FormatCSV:
+		fields, err := csvFields(&record)
+		if err != nil {
+			return err
+		}
+		return w.csv.Write(fields)
+	case FormatJSONL:
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = w.w.Write(append(data, '\n'))
+		return err
+	default:
+		// Элементы последовательности можно писать по одному: склейка остаётся корректным YAML
+		data, err := yaml.Marshal([]Record{record})
+		if err != nil {
+			return err
+		}
+		_, err = w.w.Write(data)
+		return err
+	}
+}
+
+// Close дописывает буферизованные данные. Пустой YAML-каталог записывается как [].
+func (w *Writer) Close() error {
+	switch w.format {
+	case FormatCSV:
+		w.csv.Flush()
+		return w.csv.Error()
+	case FormatYAML:
+		if w.count == 0 {
+			_, err := io.WriteString(w.w, "[]\n")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func csvFields(record *Record) ([]string, error) {
+	metadata := ""
+	if len(record.Metadata) > 0 {
+		data, err := json.Marshal(record.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		metadata = string(data)
+	}
+
+	number := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	threshold := ""
+	if record.ReorderThreshold != nil {
+		threshold = strconv.FormatInt(*record.ReorderThreshold, 10)
+	}
+
+	stock := make([]string, 0, len(record.Stock))
+	for _, level := range record.Stock {
+		stock = append(stock, level.Warehouse+stockSeparator+strconv.FormatInt(level.Quantity, 10))
+	}
+
+	return []string{
+		record.PartUuid,
+		record.Name,
+		record.Description,
+		number(record.Price),
+		strconv.FormatInt(record.StockQuantity, 10),
+		strings.Join(stock, tagSeparator),
+		threshold,
+		record.Category,
+		number(record.Dimensions.Length),
+		number(record.Dimensions.Width),
+		number(record.Dimensions.Height),
+		number(record.Dimensions.Weight),
+		record.Manufacturer.Name,
+		record.Manufacturer.Country,
+		record.Manufacturer.Website,
+		strings.Join(record.Tags, tagSeparator),
+		metadata,
+	}, nil
+}
@@ -1,49 +1,134 @@
 package config
 
 import (
+	"errors"
+	"io"
 	"os"
 
-	"github.com/joho/godotenv"
-
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/config/env"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 var appConfig *config
 
 type config struct {
-	Logger LoggerConfig
-	GRPC   GRPCConfig
-	Mongo  MongoConfig
+	Logger   LoggerConfig
+	GRPC     GRPCConfig
+	Mongo    MongoConfig
+	Shutdown ShutdownConfig
+	TLS      TLSConfig
+	Admin    AdminConfig
+	Kafka    KafkaConfig
+	LowStock LowStockConfig
+	Cache    CacheConfig
+	Pricing  PricingConfig
+	Storage  StorageConfig
+
+	effective map[string]string
 }
 
 func Load(path ...string) error {
-	err := godotenv.Load(path...)
-	if err != nil && !os.IsNotExist(err) {
+	loader, err := platformConfig.New(
+		platformConfig.WithFile(os.Getenv(platformConfig.FileEnv)),
+		platformConfig.WithDotEnv(path...),
+	)
+	if err != nil {
 		return err
 	}
 
-	loggerCfg, err := env.NewLoggerConfig()
+	cfg, err := load(loader)
 	if err != nil {
 		return err
 	}
 
-	GRPCCfg, err := env.NewGRPCConfig()
+	appConfig = cfg
+
+	return nil
+}
+
+// WriteReference выводит справочник всех ключей конфигурации сервиса в формате Markdown
+func WriteReference(w io.Writer) error {
+	loader, err := platformConfig.New(platformConfig.WithEnviron(nil))
 	if err != nil {
 		return err
 	}
 
-	mongoCfg, err := env.NewMongoConfig()
-	if err != nil {
-		return err
+	// На пустом окружении обязательные ключи не заполнены — нужны только их описания
+	_, _ = load(loader)
+
+	return platformConfig.WriteReference(w, "Inventory", loader.Keys())
+}
+
+// load читает все секции конфигурации и возвращает ошибки всех секций разом
+func load(loader *platformConfig.Loader) (*config, error) {
+	var errs []error
+
+	loggerCfg, err := env.NewLoggerConfig(loader)
+	errs = append(errs, err)
+
+	GRPCCfg, err := env.NewGRPCConfig(loader)
+	errs = append(errs, err)
+
+	mongoCfg, err := env.NewMongoConfig(loader)
+	errs = append(errs, err)
+
+	shutdownCfg, err := env.NewShutdownConfig(loader)
+	errs = append(errs, err)
+
+	tlsCfg, err := env.NewTLSConfig(loader)
+	errs = append(errs, err)
+
+	adminCfg, err := env.NewAdminConfig(loader)
+	errs = append(errs, err)
+
+	kafkaCfg, err := env.NewKafkaConfig(loader)
+	errs = append(errs, err)
+
+	lowStockCfg, err := env.NewLowStockConfig(loader)
+	errs = append(errs, err)
+
+	cacheCfg, err := env.NewCacheConfig(loader)
+	errs = append(errs, err)
+
+	pricingCfg, err := env.NewPricingConfig(loader)
+	errs = append(errs, err)
+
+	storageCfg, err := env.NewStorageConfig(loader)
+	errs = append(errs, err)
+
+	// Без брокеров события о низком остатке некуда публиковать
+	if kafkaCfg != nil && lowStockCfg != nil && lowStockCfg.Enabled() && len(kafkaCfg.Brokers()) == 0 {
+		errs = append(errs, errors.New("KAFKA_BROKERS: required when LOW_STOCK_ALERTS_ENABLED=true"))
 	}
 
-	appConfig = &config{
-		Logger: loggerCfg,
-		GRPC:   GRPCCfg,
-		Mongo:  mongoCfg,
+	// Адрес S3 нужен только выбранному драйверу s3
+	if storageCfg != nil && storageCfg.Driver() == "s3" && storageCfg.S3().Endpoint == "" {
+		errs = append(errs, errors.New("STORAGE_S3_ENDPOINT: required when STORAGE_DRIVER=s3"))
 	}
 
-	return nil
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &config{
+		Logger:    loggerCfg,
+		GRPC:      GRPCCfg,
+		Mongo:     mongoCfg,
+		Shutdown:  shutdownCfg,
+		TLS:       tlsCfg,
+		Admin:     adminCfg,
+		Kafka:     kafkaCfg,
+		LowStock:  lowStockCfg,
+		Cache:     cacheCfg,
+		Pricing:   pricingCfg,
+		Storage:   storageCfg,
+		effective: loader.Effective(),
+	}, nil
+}
+
+// Effective возвращает итоговые значения всех ключей конфигурации со скрытыми секретами
+func (c *config) Effective() map[string]string {
+	return c.effective
 }
 
 func AppConfig() *config {
@@ -0,0 +1,60 @@
+package env
+
+import (
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type cacheEnvConfig struct {
+	Enabled       bool          `env:"CACHE_ENABLED" envDefault:"false" desc:"Кэшировать GetPart и ListParts; любая запись в каталог сбрасывает кэш"`
+	Size          int           `env:"CACHE_SIZE" envDefault:"10000" validate:"min=1" desc:"Максимальное число записей локального LRU-кэша"`
+	TTL           time.Duration `env:"CACHE_TTL" envDefault:"5s" validate:"min=1ms" desc:"Время жизни записи локального кэша"`
+	RedisAddress  string        `env:"CACHE_REDIS_ADDRESS" validate:"hostport" desc:"Адрес Redis для общего кэша экземпляров сервиса; пусто — только локальный кэш"`
+	RedisPassword string        `env:"CACHE_REDIS_PASSWORD" desc:"Пароль Redis" secret:"true"`
+	RedisDB       int           `env:"CACHE_REDIS_DB" envDefault:"0" validate:"min=0" desc:"Номер базы Redis"`
+	RedisTTL      time.Duration `env:"CACHE_REDIS_TTL" envDefault:"1m" validate:"min=1s" desc:"Время жизни записи общего кэша"`
+}
+
+type cacheConfig struct {
+	raw cacheEnvConfig
+}
+
+func NewCacheConfig(loader *platformConfig.Loader) (*cacheConfig, error) {
+	var raw cacheEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &cacheConfig{raw: raw}, nil
+}
+
+func (cfg *cacheConfig) Enabled() bool {
+	return cfg.raw.Enabled
+}
+
+func (cfg *cacheConfig) Size() int {
+	return cfg.raw.Size
+}
+
+func (cfg *cacheConfig) TTL() time.Duration {
+	return cfg.raw.TTL
+}
+
+func (cfg *cacheConfig) SharedEnabled() bool {
+	return cfg.raw.RedisAddress != ""
+}
+
+func (cfg *cacheConfig) SharedTTL() time.Duration {
+	return cfg.raw.RedisTTL
+}
+
+func (cfg *cacheConfig) RedisOptions() *redis.Options {
+	return &redis.Options{
+		Addr:     cfg.raw.RedisAddress,
+		Password: cfg.raw.RedisPassword,
+		DB:       cfg.raw.RedisDB,
+	}
+}
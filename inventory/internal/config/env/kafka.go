@@ -0,0 +1,24 @@
+package env
+
+import platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+
+type kafkaEnvConfig struct {
+	Brokers []string `env:"KAFKA_BROKERS" validate:"hostport" desc:"Адреса брокеров Kafka; обязательны при LOW_STOCK_ALERTS_ENABLED=true"`
+}
+
+type kafkaConfig struct {
+	raw kafkaEnvConfig
+}
+
+func NewKafkaConfig(loader *platformConfig.Loader) (*kafkaConfig, error) {
+	var raw kafkaEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &kafkaConfig{raw: raw}, nil
+}
+
+func (cfg *kafkaConfig) Brokers() []string {
+	return cfg.raw.Brokers
+}
@@ -0,0 +1,68 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type lowStockEnvConfig struct {
+	Enabled            bool             `env:"LOW_STOCK_ALERTS_ENABLED" envDefault:"false" desc:"Публиковать событие InventoryLowStock, когда остаток детали опускается до порога дозаказа"`
+	TopicName          string           `env:"LOW_STOCK_TOPIC_NAME" envDefault:"inventory.low_stock" desc:"Топик событий InventoryLowStock"`
+	DefaultThreshold   int64            `env:"LOW_STOCK_DEFAULT_THRESHOLD" envDefault:"0" validate:"min=0" desc:"Порог дозаказа для деталей без собственного порога и порога категории"`
+	CategoryThresholds map[string]int64 `env:"LOW_STOCK_CATEGORY_THRESHOLDS" envSeparator:"," envKeyValSeparator:":" desc:"Пороги дозаказа категорий, например ENGINE:2,FUEL:10"`
+}
+
+type lowStockConfig struct {
+	raw        lowStockEnvConfig
+	categories map[model.Category]int64
+}
+
+func NewLowStockConfig(loader *platformConfig.Loader) (*lowStockConfig, error) {
+	var raw lowStockEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	categories := make(map[model.Category]int64, len(raw.CategoryThresholds))
+	for name, threshold := range raw.CategoryThresholds {
+		category, ok := model.ParseCategory(name)
+		if !ok {
+			return nil, fmt.Errorf("LOW_STOCK_CATEGORY_THRESHOLDS: unknown category %q", name)
+		}
+		if threshold < 0 {
+			return nil, fmt.Errorf("LOW_STOCK_CATEGORY_THRESHOLDS: threshold of %s must not be negative", name)
+		}
+		categories[category] = threshold
+	}
+
+	return &lowStockConfig{raw: raw, categories: categories}, nil
+}
+
+func (cfg *lowStockConfig) Enabled() bool {
+	return cfg.raw.Enabled
+}
+
+func (cfg *lowStockConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+func (cfg *lowStockConfig) ReorderPolicy() model.ReorderPolicy {
+	return model.ReorderPolicy{
+		Default:    cfg.raw.DefaultThreshold,
+		Categories: cfg.categories,
+	}
+}
+
+func (cfg *lowStockConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 3
+
+	return config
+}
@@ -3,25 +3,27 @@ package env
 import (
 	"fmt"
 
-	"github.com/caarlos0/env/v11"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type mongoEnvConfig struct {
-	Host     string `env:"MONGO_HOST,required"`
-	Port     string `env:"MONGO_PORT,required"`
-	Database string `env:"MONGO_DATABASE,required"`
-	User     string `env:"MONGO_INITDB_ROOT_USERNAME,required"`
-	Password string `env:"MONGO_INITDB_ROOT_PASSWORD,required"`
-	AuthDB   string `env:"MONGO_AUTH_DB,required"`
+	Host     string `env:"MONGO_HOST,required" desc:"Хост MongoDB"`
+	Port     string `env:"MONGO_PORT,required" validate:"port" desc:"Порт MongoDB"`
+	Database string `env:"MONGO_DATABASE,required" desc:"Имя базы данных MongoDB"`
+	User     string `env:"MONGO_INITDB_ROOT_USERNAME,required" desc:"Пользователь MongoDB"`
+	Password string `env:"MONGO_INITDB_ROOT_PASSWORD,required" desc:"Пароль пользователя MongoDB" secret:"true"`
+	AuthDB   string `env:"MONGO_AUTH_DB,required" desc:"База данных для аутентификации MongoDB"`
+	Direct   bool   `env:"MONGO_DIRECT_CONNECTION" envDefault:"false" desc:"Подключаться к узлу MongoDB напрямую, без обнаружения replica set (одноузловой replica set в docker-compose)"`
+	Migrate  bool   `env:"MONGO_MIGRATE_ON_START" envDefault:"true" desc:"Применять миграции схемы MongoDB при запуске сервиса; при false миграции применяются командой inventory migrate up"`
 }
 
 type mongoConfig struct {
 	raw mongoEnvConfig
 }
 
-func NewMongoConfig() (*mongoConfig, error) {
+func NewMongoConfig(loader *platformConfig.Loader) (*mongoConfig, error) {
 	var raw mongoEnvConfig
-	err := env.Parse(&raw)
+	err := loader.Parse(&raw)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +32,7 @@ func NewMongoConfig() (*mongoConfig, error) {
 }
 
 func (cfg *mongoConfig) URI() string {
-	return fmt.Sprintf(
+	uri := fmt.Sprintf(
 		"mongodb://%s:%s@%s:%s/%s?authSource=%s",
 		cfg.raw.User,
 		cfg.raw.Password,
@@ -39,8 +41,18 @@ func (cfg *mongoConfig) URI() string {
 		cfg.raw.Database,
 		cfg.raw.AuthDB,
 	)
+
+	if cfg.raw.Direct {
+		uri += "&directConnection=true"
+	}
+
+	return uri
 }
 
 func (cfg *mongoConfig) DatabaseName() string {
 	return cfg.raw.Database
 }
+
+func (cfg *mongoConfig) MigrateOnStart() bool {
+	return cfg.raw.Migrate
+}
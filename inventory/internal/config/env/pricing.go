@@ -0,0 +1,28 @@
+package env
+
+import (
+	"time"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type pricingEnvConfig struct {
+	SyncInterval time.Duration `env:"PRICE_SYNC_INTERVAL" envDefault:"1m" validate:"min=1s" desc:"Как часто запланированные цены переносятся в детали: от интервала зависят фильтр и сортировка ListParts по цене"`
+}
+
+type pricingConfig struct {
+	raw pricingEnvConfig
+}
+
+func NewPricingConfig(loader *platformConfig.Loader) (*pricingConfig, error) {
+	var raw pricingEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &pricingConfig{raw: raw}, nil
+}
+
+func (cfg *pricingConfig) SyncInterval() time.Duration {
+	return cfg.raw.SyncInterval
+}
@@ -0,0 +1,54 @@
+package env
+
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/s3"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type storageEnvConfig struct {
+	Driver            string `env:"STORAGE_DRIVER" envDefault:"local" validate:"oneof=local|s3" desc:"Хранилище содержимого вложений деталей: local — файлы на диске, s3 — S3-совместимое хранилище"`
+	LocalPath         string `env:"STORAGE_LOCAL_PATH" envDefault:"data/attachments" validate:"min=1" desc:"Каталог вложений для STORAGE_DRIVER=local"`
+	S3Endpoint        string `env:"STORAGE_S3_ENDPOINT" validate:"hostport" desc:"Адрес S3-совместимого хранилища для STORAGE_DRIVER=s3"`
+	S3Bucket          string `env:"STORAGE_S3_BUCKET" envDefault:"inventory-attachments" validate:"min=3,max=63" desc:"Бакет вложений; создаётся при запуске, если его нет"`
+	S3AccessKey       string `env:"STORAGE_S3_ACCESS_KEY" desc:"Ключ доступа S3"`
+	S3SecretKey       string `env:"STORAGE_S3_SECRET_KEY" desc:"Секретный ключ S3" secret:"true"`
+	S3Region          string `env:"STORAGE_S3_REGION" envDefault:"us-east-1" desc:"Регион S3"`
+	S3UseSSL          bool   `env:"STORAGE_S3_USE_SSL" envDefault:"false" desc:"Подключаться к S3 по HTTPS"`
+	AttachmentMaxSize int64  `env:"ATTACHMENT_MAX_SIZE" envDefault:"104857600" validate:"min=1" desc:"Максимальный размер вложения в байтах"`
+}
+
+type storageConfig struct {
+	raw storageEnvConfig
+}
+
+func NewStorageConfig(loader *platformConfig.Loader) (*storageConfig, error) {
+	var raw storageEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &storageConfig{raw: raw}, nil
+}
+
+func (cfg *storageConfig) Driver() string {
+	return cfg.raw.Driver
+}
+
+func (cfg *storageConfig) LocalPath() string {
+	return cfg.raw.LocalPath
+}
+
+func (cfg *storageConfig) S3() s3.Config {
+	return s3.Config{
+		Endpoint:  cfg.raw.S3Endpoint,
+		Bucket:    cfg.raw.S3Bucket,
+		AccessKey: cfg.raw.S3AccessKey,
+		SecretKey: cfg.raw.S3SecretKey,
+		Region:    cfg.raw.S3Region,
+		UseSSL:    cfg.raw.S3UseSSL,
+	}
+}
+
+func (cfg *storageConfig) AttachmentMaxSize() int64 {
+	return cfg.raw.AttachmentMaxSize
+}
@@ -1,8 +1,25 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/s3"
+)
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
+	ModuleLevels() map[string]string
+	Outputs() []string
+	FilePath() string
+	FileMaxSizeMB() int
+	FileMaxBackups() int
+	OTLPEndpoint() string
+	StacktraceRate() int
 }
 
 type GRPCConfig interface {
@@ -12,4 +29,54 @@ type GRPCConfig interface {
 type MongoConfig interface {
 	URI() string
 	DatabaseName() string
+	MigrateOnStart() bool
+}
+
+type ShutdownConfig interface {
+	Timeout() time.Duration
+	StepTimeout() time.Duration
+}
+
+type TLSConfig interface {
+	Enabled() bool
+	CAFile() string
+	CertFile() string
+	KeyFile() string
+	AllowedSANs() []string
+	ReloadInterval() time.Duration
+}
+
+type AdminConfig interface {
+	Address() string
+}
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type LowStockConfig interface {
+	Enabled() bool
+	Topic() string
+	ReorderPolicy() model.ReorderPolicy
+	Config() *sarama.Config
+}
+
+type CacheConfig interface {
+	Enabled() bool
+	Size() int
+	TTL() time.Duration
+	SharedEnabled() bool
+	SharedTTL() time.Duration
+	RedisOptions() *redis.Options
+}
+
+type PricingConfig interface {
+	SyncInterval() time.Duration
+}
+
+type StorageConfig interface {
+	Driver() string
+	LocalPath() string
+	S3() s3.Config
+	AttachmentMaxSize() int64
 }
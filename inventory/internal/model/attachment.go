@@ -0,0 +1,90 @@
+package model
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentKind — вид вложения детали
+type AttachmentKind int
+
+const (
+	// AttachmentOther — вид не указан
+	AttachmentOther AttachmentKind = iota
+	AttachmentDrawing
+	AttachmentDatasheet
+	AttachmentImage
+)
+
+// Attachment — файл, приложенный к детали. В детали хранится только описание,
+// содержимое лежит в блоб-хранилище по ключу BlobKey
+type Attachment struct {
+	AttachmentUuid uuid.UUID
+	FileName       string
+	ContentType    string
+	Kind           AttachmentKind
+	SizeBytes      int64
+	// ChecksumSha256 — контрольная сумма SHA-256 содержимого в hex
+	ChecksumSha256 string
+	CreatedAt      time.Time
+}
+
+// maxFileNameLength — предел длины имени файла вложения в байтах
+const maxFileNameLength = 255
+
+// BlobKey возвращает ключ содержимого вложения детали в блоб-хранилище
+func BlobKey(partUuid, attachmentUuid uuid.UUID) string {
+	return "parts/" + partUuid.String() + "/" + attachmentUuid.String()
+}
+
+// AttachmentUpload — загрузка вложения: описание от клиента и поток содержимого
+type AttachmentUpload struct {
+	PartUuid    uuid.UUID
+	FileName    string
+	ContentType string
+	Kind        AttachmentKind
+	// ChecksumSha256 — ожидаемая сумма содержимого в hex; пустая — не проверяется
+	ChecksumSha256 string
+	Content        io.Reader
+}
+
+// Validate проверяет описание загружаемого вложения
+func (u *AttachmentUpload) Validate() error {
+	switch {
+	case strings.TrimSpace(u.FileName) == "":
+		return fmt.Errorf("%w: file_name must not be empty", ErrInvalidAttachment)
+	case len(u.FileName) > maxFileNameLength:
+		return fmt.Errorf("%w: file_name must be at most %d bytes", ErrInvalidAttachment, maxFileNameLength)
+	case strings.ContainsAny(u.FileName, `/\`):
+		return fmt.Errorf("%w: file_name must not contain path separators", ErrInvalidAttachment)
+	case u.ChecksumSha256 != "" && !isSha256Hex(u.ChecksumSha256):
+		return fmt.Errorf("%w: checksum_sha256 must be 64 lowercase hex digits", ErrInvalidAttachment)
+	}
+
+	return nil
+}
+
+func isSha256Hex(s string) bool {
+	if len(s) != hex.EncodedLen(32) || strings.ToLower(s) != s {
+		return false
+	}
+
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// Attachment возвращает вложение детали по идентификатору
+func (p *Part) Attachment(attachmentUuid uuid.UUID) (*Attachment, error) {
+	for i := range p.Attachments {
+		if p.Attachments[i].AttachmentUuid == attachmentUuid {
+			return &p.Attachments[i], nil
+		}
+	}
+
+	return nil, ErrAttachmentNotFound
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAttachmentUpload_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		upload  AttachmentUpload
+		wantErr bool
+	}{
+		{
+			name:   "Корректное описание",
+			upload: AttachmentUpload{FileName: "engine.pdf"},
+		},
+		{
+			name:   "Корректная контрольная сумма",
+			upload: AttachmentUpload{FileName: "engine.pdf", ChecksumSha256: strings.Repeat("ab", 32)},
+		},
+		{
+			name:    "Пустое имя файла",
+			upload:  AttachmentUpload{FileName: "  "},
+			wantErr: true,
+		},
+		{
+			name:    "Слишком длинное имя файла",
+			upload:  AttachmentUpload{FileName: strings.Repeat("a", 256)},
+			wantErr: true,
+		},
+		{
+			name:    "Имя файла с путём",
+			upload:  AttachmentUpload{FileName: "../engine.pdf"},
+			wantErr: true,
+		},
+		{
+			name:    "Имя файла с обратной косой чертой",
+			upload:  AttachmentUpload{FileName: `drawings\engine.dwg`},
+			wantErr: true,
+		},
+		{
+			name:    "Контрольная сумма в верхнем регистре",
+			upload:  AttachmentUpload{FileName: "engine.pdf", ChecksumSha256: strings.Repeat("AB", 32)},
+			wantErr: true,
+		},
+		{
+			name:    "Контрольная сумма неверной длины",
+			upload:  AttachmentUpload{FileName: "engine.pdf", ChecksumSha256: "abcd"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.upload.Validate()
+			if tt.wantErr && !errors.Is(err, ErrInvalidAttachment) {
+				t.Errorf("Validate() = %v, want ErrInvalidAttachment", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestPart_Attachment(t *testing.T) {
+	drawing := Attachment{AttachmentUuid: uuid.New(), FileName: "engine.dwg", Kind: AttachmentDrawing}
+	part := Part{Attachments: []Attachment{drawing}}
+
+	found, err := part.Attachment(drawing.AttachmentUuid)
+	if err != nil || found.FileName != "engine.dwg" {
+		t.Errorf("Attachment() = %v, %v, want %s", found, err, drawing.FileName)
+	}
+
+	if _, err = part.Attachment(uuid.New()); !errors.Is(err, ErrAttachmentNotFound) {
+		t.Errorf("Attachment() error = %v, want ErrAttachmentNotFound", err)
+	}
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"slices"
+	"strings"
+)
+
 type Category int
 
 const (
@@ -37,3 +42,20 @@ func ToCategory(value int) Category {
 		return UNKNOWN
 	}
 }
+
+// categories — категории, которые можно назначить детали
+var categories = []Category{ENGINE, FUEL, PORTHOLE, WING}
+
+// ParseCategory разбирает имя категории без учёта регистра
+func ParseCategory(name string) (Category, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+
+	index := slices.IndexFunc(categories, func(c Category) bool {
+		return c.String() == name
+	})
+	if index < 0 {
+		return UNKNOWN, false
+	}
+
+	return categories[index], true
+}
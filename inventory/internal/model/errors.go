@@ -3,6 +3,33 @@ package model
 import "errors"
 
 var (
-	ErrPartNotFound    = errors.New("part not found")
-	ErrConvertFromRepo = errors.New("can't parse to model")
+	ErrPartNotFound              = errors.New("part not found")
+	ErrPartAlreadyExists         = errors.New("part already exists")
+	ErrPartConflict              = errors.New("part was modified concurrently")
+	ErrInvalidPart               = errors.New("invalid part")
+	ErrInvalidUpdateMask         = errors.New("invalid update mask")
+	ErrInvalidPageToken          = errors.New("invalid page token")
+	ErrInvalidReadMask           = errors.New("invalid read mask")
+	ErrInvalidOrderBy            = errors.New("invalid order_by")
+	ErrInvalidSearch             = errors.New("invalid search query")
+	ErrInvalidResumeToken        = errors.New("invalid resume token")
+	ErrResumeTokenExpired        = errors.New("resume token expired")
+	ErrWarehouseNotFound         = errors.New("warehouse not found")
+	ErrInvalidTransfer           = errors.New("invalid stock transfer")
+	ErrInsufficientStock         = errors.New("insufficient stock")
+	ErrInvalidPrice              = errors.New("invalid price")
+	ErrPriceNotFound             = errors.New("price not found")
+	ErrManufacturerNotFound      = errors.New("manufacturer not found")
+	ErrManufacturerAlreadyExists = errors.New("manufacturer already exists")
+	ErrInvalidManufacturer       = errors.New("invalid manufacturer")
+	ErrManufacturerInUse         = errors.New("manufacturer is referenced by parts")
+	ErrInvalidCompatibility      = errors.New("invalid compatibility")
+	ErrKitNotFound               = errors.New("kit not found")
+	ErrInvalidKit                = errors.New("invalid kit")
+	ErrKitUnavailable            = errors.New("kit is unavailable")
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrInvalidAttachment         = errors.New("invalid attachment")
+	ErrAttachmentTooLarge        = errors.New("attachment is too large")
+	ErrBlobNotFound              = errors.New("blob not found")
+	ErrConvertFromRepo           = errors.New("can't parse to model")
 )
@@ -7,5 +7,56 @@ type Filter struct {
 	Names                 []string
 	Categories            []Category
 	ManufacturerCountries []string
+	ManufacturerNames     []string
 	Tags                  []string
+	TagMatch              TagMatch
+	PriceMin              *float64
+	PriceMax              *float64
+	MinStock              *int64
+	InStockOnly           bool
+	Metadata              []MetadataPredicate
+	Warehouses            []string
+}
+
+// TagMatch — режим сравнения тегов в фильтре
+type TagMatch int
+
+const (
+	// TagMatchAny — деталь содержит хотя бы один из тегов фильтра
+	TagMatchAny TagMatch = iota
+	// TagMatchAll — деталь содержит все теги фильтра
+	TagMatchAll
+)
+
+// MetadataOperator — оператор сравнения значения метаданных
+type MetadataOperator int
+
+const (
+	MetadataEq MetadataOperator = iota + 1
+	MetadataNe
+	MetadataGt
+	MetadataGte
+	MetadataLt
+	MetadataLte
+	MetadataExists
+)
+
+// ValueKind — тип значения метаданных, с которым выполняется сравнение
+type ValueKind int
+
+const (
+	ValueKindString ValueKind = iota + 1
+	ValueKindInt64
+	ValueKindFloat64
+	ValueKindBool
+)
+
+// MetadataPredicate — условие на значение метаданных детали.
+// Отсутствующий ключ не удовлетворяет ни одному условию. Для сравнения берётся поле
+// значения типа Kind; незаполненное поле считается нулевым значением этого типа.
+type MetadataPredicate struct {
+	Key      string
+	Operator MetadataOperator
+	Kind     ValueKind
+	Value    Value
 }
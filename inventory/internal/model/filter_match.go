@@ -0,0 +1,99 @@
+package model
+
+import "slices"
+
+// Match проверяет, подходит ли деталь под фильтр. Пустой фильтр и пустые
+// списки значений выборку не ограничивают
+func (f *Filter) Match(part *Part) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.Uuids) > 0 && !slices.Contains(f.Uuids, part.PartUuid) {
+		return false
+	}
+
+	if len(f.Names) > 0 && !slices.Contains(f.Names, part.Name) {
+		return false
+	}
+
+	if len(f.Categories) > 0 && !slices.Contains(f.Categories, part.Category) {
+		return false
+	}
+
+	if len(f.ManufacturerCountries) > 0 && !slices.Contains(f.ManufacturerCountries, part.Manufacturer.Country) {
+		return false
+	}
+
+	if len(f.ManufacturerNames) > 0 && !slices.Contains(f.ManufacturerNames, part.Manufacturer.Name) {
+		return false
+	}
+
+	if len(f.Tags) > 0 && !f.matchTags(part.Tags) {
+		return false
+	}
+
+	if f.PriceMin != nil && part.Price < *f.PriceMin {
+		return false
+	}
+
+	if f.PriceMax != nil && part.Price > *f.PriceMax {
+		return false
+	}
+
+	if len(f.Warehouses) > 0 {
+		if !f.matchWarehouses(part) {
+			return false
+		}
+	} else if !f.matchStock(part.StockQuantity) {
+		return false
+	}
+
+	for _, predicate := range f.Metadata {
+		if !predicate.Match(part.Metadata) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchStock проверяет остаток по условиям min_stock и in_stock_only
+func (f *Filter) matchStock(quantity int64) bool {
+	if f.MinStock != nil && quantity < *f.MinStock {
+		return false
+	}
+
+	return !f.InStockOnly || quantity > 0
+}
+
+// matchWarehouses проверяет, что хотя бы на одном складе фильтра есть остаток детали,
+// удовлетворяющий условиям на остаток
+func (f *Filter) matchWarehouses(part *Part) bool {
+	for _, warehouse := range f.Warehouses {
+		if quantity, ok := part.StockAt(warehouse); ok && f.matchStock(quantity) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTags проверяет теги детали: для TagMatchAny достаточно одного тега из фильтра,
+// для TagMatchAll нужны все
+func (f *Filter) matchTags(partTags []string) bool {
+	if f.TagMatch == TagMatchAll {
+		for _, tag := range f.Tags {
+			if !slices.Contains(partTags, tag) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, tag := range f.Tags {
+		if slices.Contains(partTags, tag) {
+			return true
+		}
+	}
+	return false
+}
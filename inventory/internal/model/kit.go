@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Compatibility — ребро графа совместимости деталей. Совместимость симметрична:
+// ребро читается с любой стороны, PartUuid — деталь, со стороны которой оно прочитано
+type Compatibility struct {
+	PartUuid       uuid.UUID
+	CompatibleUuid uuid.UUID
+	Note           string
+}
+
+// Validate проверяет, что ребро связывает две разные детали
+func (c *Compatibility) Validate() error {
+	switch {
+	case c.PartUuid == uuid.Nil || c.CompatibleUuid == uuid.Nil:
+		return fmt.Errorf("%w: both part uuids must be specified", ErrInvalidCompatibility)
+	case c.PartUuid == c.CompatibleUuid:
+		return fmt.Errorf("%w: part can't be compatible with itself", ErrInvalidCompatibility)
+	}
+
+	return nil
+}
+
+// Reverse возвращает то же ребро, прочитанное со стороны другой детали
+func (c Compatibility) Reverse() Compatibility {
+	return Compatibility{PartUuid: c.CompatibleUuid, CompatibleUuid: c.PartUuid, Note: c.Note}
+}
+
+// CompatiblePart — деталь, совместимая с запрошенной, вместе с пояснением к ребру
+type CompatiblePart struct {
+	Part Part
+	Note string
+}
+
+// Kit — именованный набор деталей для сборки ракеты
+type Kit struct {
+	KitUuid     uuid.UUID
+	Name        string
+	Description string
+	Items       []KitItem
+}
+
+// KitItem — позиция набора: деталь и её количество
+type KitItem struct {
+	PartUuid uuid.UUID
+	Quantity int64
+}
+
+// KitLine — позиция развёрнутого набора
+type KitLine struct {
+	Part     Part
+	Quantity int64
+}
+
+// KitExpansion — набор, развёрнутый в детали по текущим ценам
+type KitExpansion struct {
+	Kit        Kit
+	Lines      []KitLine
+	TotalPrice float64
+}
+
+// Validate проверяет, что набор можно сохранить
+func (k *Kit) Validate() error {
+	switch {
+	case k.KitUuid == uuid.Nil:
+		return fmt.Errorf("%w: kit_uuid must be specified", ErrInvalidKit)
+	case strings.TrimSpace(k.Name) == "":
+		return fmt.Errorf("%w: name must not be empty", ErrInvalidKit)
+	case len(k.Items) == 0:
+		return fmt.Errorf("%w: kit must contain at least one item", ErrInvalidKit)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(k.Items))
+	for _, item := range k.Items {
+		switch {
+		case item.PartUuid == uuid.Nil:
+			return fmt.Errorf("%w: item part_uuid must be specified", ErrInvalidKit)
+		case item.Quantity <= 0:
+			return fmt.Errorf("%w: item %s quantity must be greater than 0", ErrInvalidKit, item.PartUuid)
+		case seen[item.PartUuid]:
+			return fmt.Errorf("%w: item %s is listed twice", ErrInvalidKit, item.PartUuid)
+		}
+		seen[item.PartUuid] = true
+	}
+
+	return nil
+}
+
+// PartUuids возвращает идентификаторы деталей набора в порядке позиций
+func (k *Kit) PartUuids() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(k.Items))
+	for _, item := range k.Items {
+		ids = append(ids, item.PartUuid)
+	}
+
+	return ids
+}
+
+// Expand разворачивает набор в детали и считает его стоимость по ценам parts.
+// Набор, деталь которого удалена из каталога, собрать нельзя
+func (k *Kit) Expand(parts []Part) (*KitExpansion, error) {
+	byUuid := make(map[uuid.UUID]*Part, len(parts))
+	for i := range parts {
+		byUuid[parts[i].PartUuid] = &parts[i]
+	}
+
+	expansion := &KitExpansion{Kit: *k, Lines: make([]KitLine, 0, len(k.Items))}
+	for _, item := range k.Items {
+		part, ok := byUuid[item.PartUuid]
+		if !ok {
+			return nil, fmt.Errorf("%w: part %s is not in catalog", ErrKitUnavailable, item.PartUuid)
+		}
+
+		expansion.Lines = append(expansion.Lines, KitLine{Part: *part, Quantity: item.Quantity})
+		expansion.TotalPrice += part.Price * float64(item.Quantity)
+	}
+
+	return expansion, nil
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestKit_Validate(t *testing.T) {
+	partUuid := uuid.New()
+
+	tests := []struct {
+		name string
+		kit  Kit
+		err  error
+	}{
+		{
+			name: "корректный набор",
+			kit:  Kit{KitUuid: uuid.New(), Name: "Первая ступень", Items: []KitItem{{PartUuid: partUuid, Quantity: 9}}},
+		},
+		{
+			name: "без названия",
+			kit:  Kit{KitUuid: uuid.New(), Name: " ", Items: []KitItem{{PartUuid: partUuid, Quantity: 1}}},
+			err:  ErrInvalidKit,
+		},
+		{
+			name: "без позиций",
+			kit:  Kit{KitUuid: uuid.New(), Name: "Первая ступень"},
+			err:  ErrInvalidKit,
+		},
+		{
+			name: "нулевое количество",
+			kit:  Kit{KitUuid: uuid.New(), Name: "Первая ступень", Items: []KitItem{{PartUuid: partUuid}}},
+			err:  ErrInvalidKit,
+		},
+		{
+			name: "деталь указана дважды",
+			kit: Kit{KitUuid: uuid.New(), Name: "Первая ступень", Items: []KitItem{
+				{PartUuid: partUuid, Quantity: 1},
+				{PartUuid: partUuid, Quantity: 2},
+			}},
+			err: ErrInvalidKit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.kit.Validate(); !errors.Is(err, tt.err) {
+				t.Errorf("Validate() = %v, expected %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestKit_Expand(t *testing.T) {
+	engine := Part{PartUuid: uuid.New(), Name: "Двигатель", Price: 100}
+	tank := Part{PartUuid: uuid.New(), Name: "Бак", Price: 30}
+	kit := Kit{KitUuid: uuid.New(), Name: "Первая ступень", Items: []KitItem{
+		{PartUuid: engine.PartUuid, Quantity: 9},
+		{PartUuid: tank.PartUuid, Quantity: 2},
+	}}
+
+	expansion, err := kit.Expand([]Part{tank, engine})
+	if err != nil {
+		t.Fatalf("Expand() = %v, expected nil", err)
+	}
+
+	if len(expansion.Lines) != 2 || expansion.Lines[0].Part.PartUuid != engine.PartUuid || expansion.Lines[1].Part.PartUuid != tank.PartUuid {
+		t.Errorf("Expand() lines = %+v, expected позиции в порядке набора", expansion.Lines)
+	}
+	if expansion.TotalPrice != 960 {
+		t.Errorf("Expand() total = %v, expected 960", expansion.TotalPrice)
+	}
+
+	if _, err = kit.Expand([]Part{engine}); !errors.Is(err, ErrKitUnavailable) {
+		t.Errorf("Expand() без детали = %v, expected ErrKitUnavailable", err)
+	}
+}
+
+func TestCompatibility_Validate(t *testing.T) {
+	partUuid := uuid.New()
+
+	if err := (&Compatibility{PartUuid: partUuid, CompatibleUuid: uuid.New()}).Validate(); err != nil {
+		t.Errorf("Validate() = %v, expected nil", err)
+	}
+
+	if err := (&Compatibility{PartUuid: partUuid, CompatibleUuid: partUuid}).Validate(); !errors.Is(err, ErrInvalidCompatibility) {
+		t.Errorf("Validate() для петли = %v, expected ErrInvalidCompatibility", err)
+	}
+}
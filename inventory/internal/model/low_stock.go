@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReorderPolicy — пороги дозаказа. Порог детали важнее порога её категории,
+// порог категории — важнее порога по умолчанию
+type ReorderPolicy struct {
+	Default    int64
+	Categories map[Category]int64
+}
+
+// Threshold возвращает порог дозаказа детали
+func (p ReorderPolicy) Threshold(part *Part) int64 {
+	if part.ReorderThreshold != nil {
+		return *part.ReorderThreshold
+	}
+
+	if threshold, ok := p.Categories[part.Category]; ok {
+		return threshold
+	}
+
+	return p.Default
+}
+
+// LowStock сообщает, опустился ли остаток детали до порога дозаказа при изменении.
+// Остаток, уже находившийся на пороге или ниже, повторно не сообщается
+func (p ReorderPolicy) LowStock(old, updated *Part) (threshold int64, crossed bool) {
+	threshold = p.Threshold(updated)
+	if updated.StockQuantity > threshold {
+		return threshold, false
+	}
+
+	return threshold, old.StockQuantity > p.Threshold(old)
+}
+
+// LowStockEvent — событие о падении остатка детали до порога дозаказа
+type LowStockEvent struct {
+	EventUUID             uuid.UUID
+	PartUUID              uuid.UUID
+	Name                  string
+	Category              Category
+	StockQuantity         int64
+	PreviousStockQuantity int64
+	Threshold             int64
+	OccurredAt            time.Time
+}
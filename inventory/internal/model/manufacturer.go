@@ -1,7 +1,81 @@
 package model
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Manufacturer — производитель из справочника. Детали ссылаются на него по ManufacturerUuid
 type Manufacturer struct {
-	Name    string
-	Country string
-	Website string
+	ManufacturerUuid uuid.UUID
+	Name             string
+	Country          string
+	Website          string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// ManufacturerFilter — условия выборки производителей
+type ManufacturerFilter struct {
+	Countries []string
+}
+
+// Match сообщает, подходит ли производитель под фильтр
+func (f *ManufacturerFilter) Match(manufacturer *Manufacturer) bool {
+	if f == nil || len(f.Countries) == 0 {
+		return true
+	}
+
+	for _, country := range f.Countries {
+		if country == manufacturer.Country {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ManufacturerKey — ключ уникальности производителя: название без учёта регистра и крайних пробелов
+func ManufacturerKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Validate проверяет, что производителя можно сохранить в справочник
+func (m *Manufacturer) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("%w: name must not be empty", ErrInvalidManufacturer)
+	}
+
+	return nil
+}
+
+// Apply переносит в производителя значения из src для полей, перечисленных в paths.
+// Пустой paths обновляет все поля. Идентификатор и даты не изменяются.
+func (m *Manufacturer) Apply(src *Manufacturer, paths []string) error {
+	if len(paths) == 0 {
+		paths = []string{"name", "country", "url"}
+	}
+
+	var unknown []string
+	for _, path := range paths {
+		switch path {
+		case "name":
+			m.Name = src.Name
+		case "country":
+			m.Country = src.Country
+		case "url":
+			m.Website = src.Website
+		default:
+			unknown = append(unknown, path)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: unknown fields %s", ErrInvalidUpdateMask, strings.Join(unknown, ", "))
+	}
+
+	return nil
 }
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestManufacturerKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"Energomash", "energomash"},
+		{"  SpaceX ", "spacex"},
+		{"ЭНЕРГОМАШ", "энергомаш"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if result := ManufacturerKey(tt.name); result != tt.expected {
+			t.Errorf("ManufacturerKey(%q) = %q, expected %q", tt.name, result, tt.expected)
+		}
+	}
+}
+
+func TestManufacturer_Validate(t *testing.T) {
+	if err := (&Manufacturer{Name: "SpaceX"}).Validate(); err != nil {
+		t.Errorf("Validate() = %v, expected nil", err)
+	}
+
+	if err := (&Manufacturer{Name: "   "}).Validate(); !errors.Is(err, ErrInvalidManufacturer) {
+		t.Errorf("Validate() с пустым названием = %v, expected ErrInvalidManufacturer", err)
+	}
+}
+
+func TestManufacturer_Apply(t *testing.T) {
+	src := &Manufacturer{Name: "Boeing", Country: "USA", Website: "https://boeing.com"}
+
+	tests := []struct {
+		name     string
+		paths    []string
+		expected Manufacturer
+		err      error
+	}{
+		{
+			name:     "пустая маска обновляет все поля",
+			expected: Manufacturer{Name: "Boeing", Country: "USA", Website: "https://boeing.com"},
+		},
+		{
+			name:     "только страна",
+			paths:    []string{"country"},
+			expected: Manufacturer{Name: "Energomash", Country: "USA", Website: "https://energomash.ru"},
+		},
+		{
+			name:  "неизвестное поле",
+			paths: []string{"manufacturer_uuid"},
+			err:   ErrInvalidUpdateMask,
+		},
+	}
+
+	for _, tt := range tests {
+		manufacturer := Manufacturer{Name: "Energomash", Country: "Russia", Website: "https://energomash.ru"}
+
+		err := manufacturer.Apply(src, tt.paths)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%s: Apply() = %v, expected %v", tt.name, err, tt.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Apply() = %v, expected nil", tt.name, err)
+		}
+		if manufacturer != tt.expected {
+			t.Errorf("%s: Apply() = %+v, expected %+v", tt.name, manufacturer, tt.expected)
+		}
+	}
+}
+
+func TestManufacturerFilter_Match(t *testing.T) {
+	manufacturer := &Manufacturer{Name: "SpaceX", Country: "USA"}
+
+	tests := []struct {
+		name     string
+		filter   *ManufacturerFilter
+		expected bool
+	}{
+		{"nil фильтр", nil, true},
+		{"пустой фильтр", &ManufacturerFilter{}, true},
+		{"страна совпадает", &ManufacturerFilter{Countries: []string{"Russia", "USA"}}, true},
+		{"страна не совпадает", &ManufacturerFilter{Countries: []string{"Russia"}}, false},
+	}
+
+	for _, tt := range tests {
+		if result := tt.filter.Match(manufacturer); result != tt.expected {
+			t.Errorf("%s: Match() = %v, expected %v", tt.name, result, tt.expected)
+		}
+	}
+}
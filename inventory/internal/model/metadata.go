@@ -0,0 +1,65 @@
+package model
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Match проверяет условие на метаданных детали
+func (p MetadataPredicate) Match(metadata map[string]Value) bool {
+	value, ok := metadata[p.Key]
+	if !ok {
+		return false
+	}
+
+	if p.Operator == MetadataExists {
+		return true
+	}
+
+	// Без типа значения сравнение невозможно
+	if p.Kind < ValueKindString || p.Kind > ValueKindBool {
+		return false
+	}
+
+	result := p.compare(value)
+
+	switch p.Operator {
+	case MetadataEq:
+		return result == 0
+	case MetadataNe:
+		return result != 0
+	case MetadataGt:
+		return result > 0
+	case MetadataGte:
+		return result >= 0
+	case MetadataLt:
+		return result < 0
+	case MetadataLte:
+		return result <= 0
+	default:
+		return false
+	}
+}
+
+// compare сравнивает значение детали со значением условия по полю типа Kind
+func (p MetadataPredicate) compare(value Value) int {
+	switch p.Kind {
+	case ValueKindString:
+		return strings.Compare(value.StringValue, p.Value.StringValue)
+	case ValueKindInt64:
+		return cmp.Compare(value.Int64Value, p.Value.Int64Value)
+	case ValueKindFloat64:
+		return cmp.Compare(value.Float64Value, p.Value.Float64Value)
+	case ValueKindBool:
+		return cmp.Compare(boolRank(value.BoolValue), boolRank(p.Value.BoolValue))
+	default:
+		return 0
+	}
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
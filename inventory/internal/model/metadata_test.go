@@ -0,0 +1,67 @@
+package model
+
+import "testing"
+
+func TestMetadataPredicate_Match(t *testing.T) {
+	metadata := map[string]Value{
+		"material": {StringValue: "steel"},
+		"stages":   {Int64Value: 2},
+		"thrust":   {Float64Value: 1500.5},
+		"reusable": {BoolValue: true},
+	}
+
+	tests := []struct {
+		name      string
+		predicate MetadataPredicate
+		expected  bool
+	}{
+		{
+			name:      "Строка равна",
+			predicate: MetadataPredicate{Key: "material", Operator: MetadataEq, Kind: ValueKindString, Value: Value{StringValue: "steel"}},
+			expected:  true,
+		},
+		{
+			name:      "Строка не равна",
+			predicate: MetadataPredicate{Key: "material", Operator: MetadataNe, Kind: ValueKindString, Value: Value{StringValue: "steel"}},
+			expected:  false,
+		},
+		{
+			name:      "Целое больше",
+			predicate: MetadataPredicate{Key: "stages", Operator: MetadataGt, Kind: ValueKindInt64, Value: Value{Int64Value: 1}},
+			expected:  true,
+		},
+		{
+			name:      "Дробное меньше или равно",
+			predicate: MetadataPredicate{Key: "thrust", Operator: MetadataLte, Kind: ValueKindFloat64, Value: Value{Float64Value: 1500.5}},
+			expected:  true,
+		},
+		{
+			name:      "Логическое равно false",
+			predicate: MetadataPredicate{Key: "reusable", Operator: MetadataEq, Kind: ValueKindBool},
+			expected:  false,
+		},
+		{
+			name:      "Ключ присутствует",
+			predicate: MetadataPredicate{Key: "stages", Operator: MetadataExists},
+			expected:  true,
+		},
+		{
+			name:      "Отсутствующий ключ не удовлетворяет NE",
+			predicate: MetadataPredicate{Key: "color", Operator: MetadataNe, Kind: ValueKindString, Value: Value{StringValue: "red"}},
+			expected:  false,
+		},
+		{
+			name:      "Сравнение без типа значения",
+			predicate: MetadataPredicate{Key: "stages", Operator: MetadataEq},
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.predicate.Match(metadata); result != tt.expected {
+				t.Errorf("MetadataPredicate.Match() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
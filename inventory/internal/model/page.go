@@ -0,0 +1,155 @@
+package model
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize — размер страницы, если клиент его не указал
+	DefaultPageSize = 100
+	// MaxPageSize — максимальный размер страницы
+	MaxPageSize = 1000
+)
+
+// SortField — поле сортировки списка деталей
+type SortField int
+
+const (
+	SortByCreatedAt SortField = iota
+	SortByName
+	SortByPrice
+	SortByStock
+)
+
+// Sort — порядок сортировки списка деталей. При равенстве значений детали
+// упорядочиваются по part_uuid, поэтому порядок всегда однозначен
+type Sort struct {
+	Field SortField `json:"f"`
+	Desc  bool      `json:"d,omitempty"`
+}
+
+// Compare сравнивает детали в порядке сортировки: -1, если a идёт раньше b
+func (s Sort) Compare(a, b *Part) int {
+	var result int
+	switch s.Field {
+	case SortByName:
+		result = strings.Compare(a.Name, b.Name)
+	case SortByPrice:
+		result = cmp.Compare(a.Price, b.Price)
+	case SortByStock:
+		result = cmp.Compare(a.StockQuantity, b.StockQuantity)
+	default:
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	}
+
+	if result == 0 {
+		result = strings.Compare(a.PartUuid.String(), b.PartUuid.String())
+	}
+
+	if s.Desc {
+		return -result
+	}
+
+	return result
+}
+
+// PageRequest — параметры постраничного запроса списка деталей
+type PageRequest struct {
+	Size   int
+	Token  string
+	Sort   Sort
+	Fields []string
+	// PriceAt — момент, на который возвращаются цены деталей. Нулевой — текущий момент
+	PriceAt time.Time
+}
+
+// PartsPage — страница списка деталей
+type PartsPage struct {
+	Parts         []Part
+	NextPageToken string
+}
+
+// ListQuery — параметры выборки деталей из репозитория
+type ListQuery struct {
+	// Limit — максимальное количество деталей, 0 — без ограничения
+	Limit int
+	Sort  Sort
+	// After — ключ последней детали предыдущей страницы
+	After *PageCursor
+	// Fields — поля детали, которые нужно прочитать. Пустой список — все поля
+	Fields []string
+}
+
+// PageCursor — ключ сортировки последней детали страницы
+type PageCursor struct {
+	Sort          Sort      `json:"s"`
+	PartUuid      uuid.UUID `json:"id"`
+	Name          string    `json:"n,omitempty"`
+	Price         float64   `json:"p,omitempty"`
+	StockQuantity int64     `json:"q,omitempty"`
+	CreatedAt     time.Time `json:"c,omitzero"`
+}
+
+// NewPageCursor строит курсор по последней детали страницы
+func NewPageCursor(part *Part, sort Sort) *PageCursor {
+	cursor := &PageCursor{Sort: sort, PartUuid: part.PartUuid}
+	switch sort.Field {
+	case SortByName:
+		cursor.Name = part.Name
+	case SortByPrice:
+		cursor.Price = part.Price
+	case SortByStock:
+		cursor.StockQuantity = part.StockQuantity
+	default:
+		cursor.CreatedAt = part.CreatedAt
+	}
+
+	return cursor
+}
+
+// Part возвращает деталь с ключом сортировки курсора для сравнения через Sort.Compare
+func (c *PageCursor) Part() *Part {
+	return &Part{
+		PartUuid:      c.PartUuid,
+		Name:          c.Name,
+		Price:         c.Price,
+		StockQuantity: c.StockQuantity,
+		CreatedAt:     c.CreatedAt,
+	}
+}
+
+// Encode упаковывает курсор в непрозрачный токен страницы
+func (c *PageCursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken распаковывает токен страницы, выданный для сортировки sort
+func DecodePageToken(token string, sort Sort) (*PageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	var cursor PageCursor
+	if err = json.Unmarshal(data, &cursor); err != nil || cursor.PartUuid == uuid.Nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("%w: token was issued for a different order_by", ErrInvalidPageToken)
+	}
+
+	return &cursor, nil
+}
@@ -7,16 +7,21 @@ import (
 )
 
 type Part struct {
-	OrderUuid     uuid.UUID
-	Name          string
-	Description   string
-	Price         float64
-	StockQuantity int64
-	Category      Category
-	Dimensions    Dimensions
-	Manufacturer  Manufacturer
-	Tags          []string
-	Metadata      map[string]Value
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	PartUuid         uuid.UUID
+	Name             string
+	Description      string
+	Price            float64
+	StockQuantity    int64
+	Stock            []StockLevel
+	Category         Category
+	Dimensions       Dimensions
+	ManufacturerUuid uuid.UUID
+	Manufacturer     Manufacturer // данные из справочника по ManufacturerUuid, подставляются при чтении
+	Tags             []string
+	Metadata         map[string]Value
+	ReorderThreshold *int64
+	Attachments      []Attachment // описания вложений; содержимое — в блоб-хранилище
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
 }
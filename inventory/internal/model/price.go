@@ -0,0 +1,56 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// PricePeriod — цена детали, действующая с EffectiveFrom (включительно) до EffectiveTo
+// (не включительно). EffectiveTo == nil — цена действует до следующего изменения
+type PricePeriod struct {
+	Price         float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+}
+
+// Covers сообщает, действует ли цена в момент at
+func (p PricePeriod) Covers(at time.Time) bool {
+	return !at.Before(p.EffectiveFrom) && (p.EffectiveTo == nil || at.Before(*p.EffectiveTo))
+}
+
+// SchedulePrice добавляет в историю цену, действующую с момента from, и возвращает новую историю.
+// Периоды идут подряд: цена действует до следующего изменения. Изменение с тем же
+// моментом from заменяется. Моменты округляются до миллисекунд, как в хранилище
+func SchedulePrice(history []PricePeriod, price float64, from time.Time) []PricePeriod {
+	from = from.UTC().Truncate(time.Millisecond)
+
+	result := make([]PricePeriod, 0, len(history)+1)
+	for _, period := range history {
+		if !period.EffectiveFrom.Equal(from) {
+			result = append(result, PricePeriod{Price: period.Price, EffectiveFrom: period.EffectiveFrom})
+		}
+	}
+	result = append(result, PricePeriod{Price: price, EffectiveFrom: from})
+
+	slices.SortFunc(result, func(a, b PricePeriod) int {
+		return a.EffectiveFrom.Compare(b.EffectiveFrom)
+	})
+
+	for i := 0; i < len(result)-1; i++ {
+		to := result[i+1].EffectiveFrom
+		result[i].EffectiveTo = &to
+	}
+
+	return result
+}
+
+// PriceAt возвращает цену, действующую в момент at, и признак того, что она была
+func PriceAt(history []PricePeriod, at time.Time) (float64, bool) {
+	for _, period := range history {
+		if period.Covers(at) {
+			return period.Price, true
+		}
+	}
+
+	return 0, false
+}
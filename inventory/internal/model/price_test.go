@@ -0,0 +1,71 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSchedulePrice(t *testing.T) {
+	t0 := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(24 * time.Hour)
+	t2 := t0.Add(48 * time.Hour)
+
+	history := SchedulePrice(nil, 100, t0)
+	history = SchedulePrice(history, 150, t2)
+	// Изменение между существующими периодами разбивает их
+	history = SchedulePrice(history, 120, t1)
+
+	expected := []PricePeriod{
+		{Price: 100, EffectiveFrom: t0, EffectiveTo: &t1},
+		{Price: 120, EffectiveFrom: t1, EffectiveTo: &t2},
+		{Price: 150, EffectiveFrom: t2},
+	}
+	if !reflect.DeepEqual(history, expected) {
+		t.Errorf("SchedulePrice() = %+v, expected %+v", history, expected)
+	}
+
+	// Изменение с тем же моментом заменяет прежнее
+	history = SchedulePrice(history, 130, t1)
+	if len(history) != 3 || history[1].Price != 130 {
+		t.Errorf("SchedulePrice() с тем же моментом = %+v, ожидалась замена цены на 130", history)
+	}
+}
+
+func TestSchedulePrice_TruncatesToMilliseconds(t *testing.T) {
+	from := time.Date(2025, 8, 1, 12, 0, 0, 123456789, time.FixedZone("MSK", 3*60*60))
+	expected := time.Date(2025, 8, 1, 9, 0, 0, 123000000, time.UTC)
+
+	history := SchedulePrice(nil, 100, from)
+
+	if history[0].EffectiveFrom != expected {
+		t.Errorf("EffectiveFrom = %v, expected %v", history[0].EffectiveFrom, expected)
+	}
+}
+
+func TestPriceAt(t *testing.T) {
+	t0 := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	history := SchedulePrice(SchedulePrice(nil, 100, t0), 200, t1)
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected float64
+		found    bool
+	}{
+		{name: "До первой цены", at: t0.Add(-time.Second), found: false},
+		{name: "Начало периода включительно", at: t0, expected: 100, found: true},
+		{name: "Конец периода не включительно", at: t1, expected: 200, found: true},
+		{name: "Открытый период", at: t1.Add(24 * time.Hour), expected: 200, found: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, found := PriceAt(history, tt.at)
+			if price != tt.expected || found != tt.found {
+				t.Errorf("PriceAt() = %v, %v, expected %v, %v", price, found, tt.expected, tt.found)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// SearchRequest — запрос полнотекстового поиска деталей
+type SearchRequest struct {
+	Text      string
+	Filter    *Filter
+	PageSize  int
+	PageToken string
+}
+
+// SearchQuery — параметры поиска в репозитории
+type SearchQuery struct {
+	// Terms — основы искомых слов, деталь находится по любому из них
+	Terms  []string
+	Filter *Filter
+	// Limit — максимальное количество результатов, 0 — без ограничения
+	Limit  int
+	Offset int
+}
+
+// SearchHit — найденная деталь с релевантностью
+type SearchHit struct {
+	Part       Part
+	Score      float64
+	Highlights []Highlight
+}
+
+// Highlight — фрагмент поля детали с выделенными совпадениями
+type Highlight struct {
+	Field   string
+	Snippet string
+}
+
+// SearchPage — страница результатов поиска
+type SearchPage struct {
+	Hits          []SearchHit
+	NextPageToken string
+}
+
+// SearchCursor — позиция следующей страницы поиска. Релевантность не хранится
+// в документе, поэтому поиск листается по смещению, а не по ключу
+type SearchCursor struct {
+	Text   string `json:"t"`
+	Offset int    `json:"o"`
+}
+
+// Encode упаковывает курсор в непрозрачный токен страницы
+func (c *SearchCursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeSearchToken распаковывает токен страницы поиска, выданный для запроса text
+func DecodeSearchToken(token, text string) (*SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	var cursor SearchCursor
+	if err = json.Unmarshal(data, &cursor); err != nil || cursor.Offset <= 0 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPageToken)
+	}
+
+	if cursor.Text != text {
+		return nil, fmt.Errorf("%w: token was issued for a different query", ErrInvalidPageToken)
+	}
+
+	return &cursor, nil
+}
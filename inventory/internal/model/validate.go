@@ -0,0 +1,136 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate проверяет, что деталь можно сохранить в каталог.
+// Возвращает ErrInvalidPart с перечнем всех нарушений.
+func (p *Part) Validate() error {
+	var problems []string
+
+	if strings.TrimSpace(p.Name) == "" {
+		problems = append(problems, "name must not be empty")
+	}
+
+	if ToCategory(int(p.Category)) == UNKNOWN {
+		problems = append(problems, "category must be specified")
+	}
+
+	if p.Price <= 0 {
+		problems = append(problems, "price must be greater than 0")
+	}
+
+	if p.StockQuantity < 0 {
+		problems = append(problems, "stock_quantity must not be negative")
+	}
+
+	seen := make(map[string]bool, len(p.Stock))
+	for _, level := range p.Stock {
+		switch {
+		case level.Warehouse == "":
+			problems = append(problems, "stock.warehouse must not be empty")
+		case seen[level.Warehouse]:
+			problems = append(problems, "stock.warehouse "+level.Warehouse+" is duplicated")
+		}
+		seen[level.Warehouse] = true
+
+		if level.Quantity < 0 {
+			problems = append(problems, "stock."+level.Warehouse+" quantity must not be negative")
+		}
+	}
+
+	if p.ReorderThreshold != nil && *p.ReorderThreshold < 0 {
+		problems = append(problems, "reorder_threshold must not be negative")
+	}
+
+	dimensions := []struct {
+		name  string
+		value float64
+	}{
+		{"dimensions.length", p.Dimensions.Length},
+		{"dimensions.width", p.Dimensions.Width},
+		{"dimensions.height", p.Dimensions.Height},
+		{"dimensions.weight", p.Dimensions.Weight},
+	}
+	for _, d := range dimensions {
+		if d.value <= 0 {
+			problems = append(problems, d.name+" must be greater than 0")
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrInvalidPart, strings.Join(problems, "; "))
+}
+
+// Apply переносит в деталь значения из src для полей, перечисленных в paths.
+// Пустой paths обновляет все изменяемые поля. Идентификатор и даты не изменяются.
+func (p *Part) Apply(src *Part, paths []string) error {
+	if len(paths) == 0 {
+		paths = mutableFields
+	}
+
+	var unknown []string
+	for _, path := range paths {
+		if !p.applyField(src, path) {
+			unknown = append(unknown, path)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: unknown fields %s", ErrInvalidUpdateMask, strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// mutableFields — поля детали, которые можно изменить через UpdatePart
+var mutableFields = []string{
+	"name", "description", "price", "stock_quantity", "category",
+	"dimensions", "manufacturer", "tags", "metadata", "reorder_threshold", "stock",
+}
+
+func (p *Part) applyField(src *Part, path string) bool {
+	switch path {
+	case "name":
+		p.Name = src.Name
+	case "description":
+		p.Description = src.Description
+	case "price":
+		p.Price = src.Price
+	case "stock_quantity":
+		p.StockQuantity = src.StockQuantity
+	case "category":
+		p.Category = src.Category
+	case "dimensions":
+		p.Dimensions = src.Dimensions
+	case "dimensions.length":
+		p.Dimensions.Length = src.Dimensions.Length
+	case "dimensions.width":
+		p.Dimensions.Width = src.Dimensions.Width
+	case "dimensions.height":
+		p.Dimensions.Height = src.Dimensions.Height
+	case "dimensions.weight":
+		p.Dimensions.Weight = src.Dimensions.Weight
+	// Деталь ссылается на производителя: его поля меняются через справочник, а не через деталь
+	case "manufacturer", "manufacturer_uuid":
+		p.ManufacturerUuid = src.ManufacturerUuid
+		p.Manufacturer = src.Manufacturer
+	case "tags":
+		p.Tags = src.Tags
+	case "metadata":
+		p.Metadata = src.Metadata
+	case "reorder_threshold":
+		p.ReorderThreshold = src.ReorderThreshold
+	case "stock":
+		p.Stock = src.Stock
+	default:
+		return false
+	}
+
+	return true
+}
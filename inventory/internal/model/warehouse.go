@@ -0,0 +1,107 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Warehouse — склад, на котором хранятся детали
+type Warehouse struct {
+	Code     string
+	Name     string
+	Location string
+}
+
+// StockLevel — остаток детали на одном складе
+type StockLevel struct {
+	Warehouse string
+	Quantity  int64
+}
+
+// StockTransfer — запись о перемещении остатка детали между складами
+type StockTransfer struct {
+	TransferUuid  uuid.UUID
+	PartUuid      uuid.UUID
+	FromWarehouse string
+	ToWarehouse   string
+	Quantity      int64
+	Reason        string
+	CreatedAt     time.Time
+}
+
+// Validate проверяет параметры перемещения без учёта остатков
+func (t *StockTransfer) Validate() error {
+	switch {
+	case t.FromWarehouse == "" || t.ToWarehouse == "":
+		return fmt.Errorf("%w: from_warehouse and to_warehouse must be specified", ErrInvalidTransfer)
+	case t.FromWarehouse == t.ToWarehouse:
+		return fmt.Errorf("%w: from_warehouse and to_warehouse must differ", ErrInvalidTransfer)
+	case t.Quantity <= 0:
+		return fmt.Errorf("%w: quantity must be greater than 0", ErrInvalidTransfer)
+	}
+
+	return nil
+}
+
+// SyncStockQuantity пересчитывает общий остаток по складам.
+// Деталь без остатков по складам сохраняет StockQuantity как есть
+func (p *Part) SyncStockQuantity() {
+	if len(p.Stock) == 0 {
+		return
+	}
+
+	var total int64
+	for _, level := range p.Stock {
+		total += level.Quantity
+	}
+	p.StockQuantity = total
+}
+
+// StockAt возвращает остаток детали на складе и признак того, что запись о складе есть
+func (p *Part) StockAt(warehouse string) (int64, bool) {
+	for _, level := range p.Stock {
+		if level.Warehouse == warehouse {
+			return level.Quantity, true
+		}
+	}
+
+	return 0, false
+}
+
+// Transfer перемещает quantity деталей со склада from на склад to.
+// Запись о складе-получателе создаётся, если её не было
+func (p *Part) Transfer(from, to string, quantity int64) error {
+	available, _ := p.StockAt(from)
+	if available < quantity {
+		return fmt.Errorf("%w: %s has %d, requested %d", ErrInsufficientStock, from, available, quantity)
+	}
+
+	p.addStock(from, -quantity)
+	p.addStock(to, quantity)
+	p.SyncStockQuantity()
+
+	return nil
+}
+
+func (p *Part) addStock(warehouse string, delta int64) {
+	for i := range p.Stock {
+		if p.Stock[i].Warehouse == warehouse {
+			p.Stock[i].Quantity += delta
+			return
+		}
+	}
+
+	p.Stock = append(p.Stock, StockLevel{Warehouse: warehouse, Quantity: delta})
+}
+
+// Warehouses возвращает коды складов, на которых у детали есть записи об остатке
+func (p *Part) Warehouses() []string {
+	codes := make([]string, 0, len(p.Stock))
+	for _, level := range p.Stock {
+		codes = append(codes, level.Warehouse)
+	}
+
+	return codes
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// PartEventType — вид изменения детали
+type PartEventType int
+
+const (
+	PartCreated PartEventType = iota + 1
+	PartUpdated
+	PartDeleted
+	// PartProgress — подходящих изменений не было, но поток продвинулся.
+	// В событии заполнены только ResumeToken и OccurredAt
+	PartProgress
+)
+
+// PartEvent — изменение детали в каталоге со снимком детали после изменения
+type PartEvent struct {
+	Type PartEventType
+	Part Part
+	// ResumeToken — позиция события в потоке: подписка с этим токеном
+	// продолжится со следующего события
+	ResumeToken string
+	OccurredAt  time.Time
+}
+
+// PartEventHandler обрабатывает событие подписки. Ошибка завершает подписку
+type PartEventHandler func(event PartEvent) error
+
+// WatchRequest — подписка на изменения деталей
+type WatchRequest struct {
+	// Filter проверяется по снимку детали после изменения
+	Filter *Filter
+	// ResumeToken — токен последнего полученного события; пустой — с момента подписки
+	ResumeToken string
+	// Subscribed вызывается, когда подписка зарегистрирована: изменения после
+	// этого момента гарантированно попадут в поток
+	Subscribed func()
+	// ProgressInterval — как часто подписчик без подходящих изменений получает
+	// PartProgress с актуальным resume token. Ноль в сервисе — интервал по умолчанию,
+	// в репозитории — без событий прогресса при простое потока
+	ProgressInterval time.Duration
+	Handle           PartEventHandler
+}
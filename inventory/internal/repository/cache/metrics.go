@@ -0,0 +1,42 @@
+package cache
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	layerLocal  = "local"
+	layerShared = "shared"
+
+	resultHit  = "hit"
+	resultMiss = "miss"
+)
+
+// Metrics — счётчики попаданий и промахов кэша по слоям.
+// Регистрируется в реестре Prometheus служебного сервера
+type Metrics struct {
+	requests *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "inventory_cache_requests_total",
+			Help: "Обращения к кэшу репозитория деталей по слоям (local, shared) и результату (hit, miss).",
+		}, []string{"layer", "result"}),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+}
+
+func (m *Metrics) hit(layer string) {
+	m.requests.WithLabelValues(layer, resultHit).Inc()
+}
+
+func (m *Metrics) miss(layer string) {
+	m.requests.WithLabelValues(layer, resultMiss).Inc()
+}
@@ -0,0 +1,292 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	def "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+const (
+	keyPrefix = "inventory:"
+	// generationKey — номер версии каталога в общем кэше. Каждая запись увеличивает его,
+	// и записи кэша со старым номером перестают читаться на всех экземплярах
+	generationKey = keyPrefix + "generation"
+)
+
+var _ def.InventoryRepository = (*repository)(nil)
+
+// Config — параметры кэша. Без Shared работает только локальный слой
+type Config struct {
+	Size      int
+	TTL       time.Duration
+	Shared    Shared
+	SharedTTL time.Duration
+}
+
+// repository — read-through кэш GetPart и ListParts поверх репозитория деталей.
+// Любая запись в каталог сбрасывает кэш целиком: ключи содержат номер версии каталога,
+// который запись увеличивает. Остальные методы передаются в next без изменений
+type repository struct {
+	next       def.InventoryRepository
+	local      *lru
+	shared     Shared
+	sharedTTL  time.Duration
+	metrics    *Metrics
+	generation atomic.Int64 // версия каталога, когда общего кэша нет
+	// failedInvalidations — сколько записей не смогли увеличить версию в общем кэше.
+	// Пока сброс не повторён, общий кэш содержит данные до этих записей
+	failedInvalidations atomic.Int64
+}
+
+func NewRepository(next def.InventoryRepository, cfg Config, metrics *Metrics) def.InventoryRepository {
+	return &repository{
+		next:      next,
+		local:     newLRU(cfg.Size, cfg.TTL),
+		shared:    cfg.Shared,
+		sharedTTL: cfg.SharedTTL,
+		metrics:   metrics,
+	}
+}
+
+func (r *repository) GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part, error) {
+	return read(ctx, r, "part:"+uuid.String(), func() (*model.Part, error) {
+		return r.next.GetPart(ctx, uuid)
+	})
+}
+
+func (r *repository) ListParts(ctx context.Context, filter *model.Filter, query *model.ListQuery) (*[]model.Part, error) {
+	request, err := json.Marshal(struct {
+		Filter *model.Filter
+		Query  *model.ListQuery
+	}{filter, query})
+	if err != nil {
+		return r.next.ListParts(ctx, filter, query)
+	}
+	digest := sha256.Sum256(request)
+
+	return read(ctx, r, "list:"+hex.EncodeToString(digest[:]), func() (*[]model.Part, error) {
+		return r.next.ListParts(ctx, filter, query)
+	})
+}
+
+func (r *repository) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	defer r.invalidate(ctx)
+	return r.next.CreatePart(ctx, part)
+}
+
+func (r *repository) BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error) {
+	defer r.invalidate(ctx)
+	return r.next.BatchCreateParts(ctx, parts)
+}
+
+func (r *repository) UpdatePart(ctx context.Context, part *model.Part, readUpdatedAt time.Time) (*model.Part, error) {
+	defer r.invalidate(ctx)
+	return r.next.UpdatePart(ctx, part, readUpdatedAt)
+}
+
+func (r *repository) DeletePart(ctx context.Context, uuid uuid.UUID, deletedAt time.Time) error {
+	defer r.invalidate(ctx)
+	return r.next.DeletePart(ctx, uuid, deletedAt)
+}
+
+func (r *repository) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error) {
+	defer r.invalidate(ctx)
+	return r.next.TransferStock(ctx, transfer)
+}
+
+func (r *repository) AddAttachment(ctx context.Context, partUuid uuid.UUID, attachment *model.Attachment) (*model.Part, error) {
+	defer r.invalidate(ctx)
+	return r.next.AddAttachment(ctx, partUuid, attachment)
+}
+
+func (r *repository) SearchParts(ctx context.Context, query *model.SearchQuery) (*[]model.SearchHit, error) {
+	return r.next.SearchParts(ctx, query)
+}
+
+func (r *repository) WatchParts(ctx context.Context, req *model.WatchRequest) error {
+	return r.next.WatchParts(ctx, req)
+}
+
+func (r *repository) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	return r.next.ListWarehouses(ctx)
+}
+
+// История цен не кэшируется: цена на момент запроса зависит от времени, а не только от записей
+func (r *repository) GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error) {
+	return r.next.GetPriceHistory(ctx, partUuid)
+}
+
+func (r *repository) SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error) {
+	return r.next.SchedulePrice(ctx, partUuid, price, from)
+}
+
+func (r *repository) GetPricesAt(ctx context.Context, partUuids []uuid.UUID, at time.Time) (map[uuid.UUID]float64, error) {
+	return r.next.GetPricesAt(ctx, partUuids, at)
+}
+
+// SyncCurrentPrices сбрасывает кэш, только если цены деталей действительно изменились:
+// синхронизация запускается периодически и обычно ничего не меняет
+func (r *repository) SyncCurrentPrices(ctx context.Context, now time.Time) (int64, error) {
+	updated, err := r.next.SyncCurrentPrices(ctx, now)
+	if updated > 0 {
+		r.invalidate(ctx)
+	}
+
+	return updated, err
+}
+
+// Справочник производителей не кэшируется
+func (r *repository) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	return r.next.CreateManufacturer(ctx, manufacturer)
+}
+
+func (r *repository) GetManufacturer(ctx context.Context, uuid uuid.UUID) (*model.Manufacturer, error) {
+	return r.next.GetManufacturer(ctx, uuid)
+}
+
+func (r *repository) FindManufacturerByName(ctx context.Context, name string) (*model.Manufacturer, error) {
+	return r.next.FindManufacturerByName(ctx, name)
+}
+
+func (r *repository) ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error) {
+	return r.next.ListManufacturers(ctx, filter)
+}
+
+// UpdateManufacturer сбрасывает кэш: закэшированные детали содержат данные производителя
+func (r *repository) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	defer r.invalidate(ctx)
+	return r.next.UpdateManufacturer(ctx, manufacturer)
+}
+
+// DeleteManufacturer не трогает кэш: удалить можно только производителя, на которого не ссылаются детали
+func (r *repository) DeleteManufacturer(ctx context.Context, uuid uuid.UUID) error {
+	return r.next.DeleteManufacturer(ctx, uuid)
+}
+
+func (r *repository) ListCompatibility(ctx context.Context, partUuid uuid.UUID) ([]model.Compatibility, error) {
+	return r.next.ListCompatibility(ctx, partUuid)
+}
+
+func (r *repository) ListKits(ctx context.Context) ([]model.Kit, error) {
+	return r.next.ListKits(ctx)
+}
+
+func (r *repository) GetKit(ctx context.Context, uuid uuid.UUID) (*model.Kit, error) {
+	return r.next.GetKit(ctx, uuid)
+}
+
+// read возвращает значение из локального слоя, затем из общего, а при промахе
+// загружает его через load и сохраняет в оба слоя. Ошибки load не кэшируются
+func read[T any](ctx context.Context, r *repository, key string, load func() (T, error)) (T, error) {
+	version, ok := r.version(ctx)
+	if !ok {
+		return load()
+	}
+	key = keyPrefix + version + ":" + key
+
+	var value T
+	if data, found := r.local.get(key); found && json.Unmarshal(data, &value) == nil {
+		r.metrics.hit(layerLocal)
+		return value, nil
+	}
+	r.metrics.miss(layerLocal)
+
+	if r.shared != nil {
+		data, err := r.shared.Get(ctx, key)
+		switch {
+		case err == nil && json.Unmarshal(data, &value) == nil:
+			r.metrics.hit(layerShared)
+			r.local.set(key, data)
+			return value, nil
+		case err != nil && !errors.Is(err, ErrMiss):
+			logger.Warn(ctx, "Failed to read from shared cache", zap.String("key", key), zap.Error(err))
+		}
+		r.metrics.miss(layerShared)
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value, nil
+	}
+
+	r.local.set(key, data)
+	if r.shared != nil {
+		if err = r.shared.Set(ctx, key, data, r.sharedTTL); err != nil {
+			logger.Warn(ctx, "Failed to write to shared cache", zap.String("key", key), zap.Error(err))
+		}
+	}
+
+	return value, nil
+}
+
+// version возвращает текущую версию каталога для ключей кэша. С общим кэшем это номер версии
+// из него, одинаковый на всех экземплярах, иначе ключи экземпляров расходятся после первой записи
+// и общий слой перестаёт работать. Без общего кэша версия ведётся локальным счётчиком.
+// Если общий кэш недоступен, кэш не используется: иначе можно прочитать чужие устаревшие данные.
+//
+// Версия читается из общего кэша при каждом чтении — это лишний GET к Redis на запрос.
+// Локально её не кэшируем: тогда запись на другом экземпляре была бы видна не сразу,
+// а только по истечении срока локальной копии
+func (r *repository) version(ctx context.Context) (string, bool) {
+	if r.shared == nil {
+		return strconv.FormatInt(r.generation.Load(), 10), true
+	}
+
+	// Неудавшийся сброс повторяется до чтения: без него общий кэш отдал бы данные до записи
+	if failed := r.failedInvalidations.Load(); failed > 0 {
+		generation, err := r.shared.Incr(ctx, generationKey)
+		if err != nil {
+			logger.Warn(ctx, "Failed to retry shared cache invalidation", zap.Error(err))
+			return "", false
+		}
+		// Сбросы, не удавшиеся после Load, остаются в счётчике и повторятся при следующем чтении
+		r.failedInvalidations.Add(-failed)
+
+		return strconv.FormatInt(generation, 10), true
+	}
+
+	data, err := r.shared.Get(ctx, generationKey)
+	switch {
+	case errors.Is(err, ErrMiss):
+		return "0", true
+	case err != nil:
+		logger.Warn(ctx, "Failed to read cache generation", zap.Error(err))
+		return "", false
+	}
+
+	return string(data), true
+}
+
+// invalidate сбрасывает кэш после записи. Вызывается и при ошибке записи:
+// она могла частично примениться. Если общий кэш недоступен, сброс повторяется
+// при следующем чтении, а до тех пор кэш этого экземпляра не используется
+func (r *repository) invalidate(ctx context.Context) {
+	r.local.purge()
+
+	if r.shared == nil {
+		r.generation.Add(1)
+		return
+	}
+
+	if _, err := r.shared.Incr(ctx, generationKey); err != nil {
+		r.failedInvalidations.Add(1)
+		logger.Warn(ctx, "Failed to invalidate shared cache", zap.Error(err))
+	}
+}
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrMiss — ключа нет в общем кэше
+var ErrMiss = errors.New("cache miss")
+
+// Shared — общий для экземпляров сервиса кэш. Набор операций совпадает с командами Redis
+// GET, SET EX и INCR, поэтому подходит любое Redis-совместимое хранилище
+type Shared interface {
+	// Get возвращает значение ключа или ErrMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set сохраняет значение на время ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Incr увеличивает числовое значение ключа на единицу и возвращает новое значение
+	Incr(ctx context.Context, key string) (int64, error)
+}
+
+type redisShared struct {
+	client redis.UniversalClient
+}
+
+// NewRedisShared возвращает общий кэш поверх клиента Redis
+func NewRedisShared(client redis.UniversalClient) Shared {
+	return &redisShared{client: client}
+}
+
+func (s *redisShared) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrMiss
+	}
+
+	return value, err
+}
+
+func (s *redisShared) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (s *redisShared) Incr(ctx context.Context, key string) (int64, error) {
+	return s.client.Incr(ctx, key).Result()
+}
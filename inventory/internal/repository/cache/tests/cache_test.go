@@ -0,0 +1,213 @@
+package cache_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/cache"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/mocks"
+)
+
+func newPart() *model.Part {
+	return &model.Part{
+		PartUuid:      uuid.New(),
+		Name:          "RD-180",
+		Price:         100,
+		StockQuantity: 5,
+		Category:      model.ENGINE,
+		Stock:         []model.StockLevel{{Warehouse: "BAIKONUR", Quantity: 5}},
+	}
+}
+
+// requests возвращает число обращений к слою кэша с заданным результатом
+func requests(metrics *cache.Metrics, layer, result string) int {
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(metrics)
+
+	families, err := registry.Gather()
+	if err != nil {
+		return -1
+	}
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["layer"] == layer && labels["result"] == result {
+				return int(metric.GetCounter().GetValue())
+			}
+		}
+	}
+
+	return 0
+}
+
+func (s *CacheRepositorySuite) TestGetPart_ReadThrough() {
+	ctx := context.Background()
+	part := newPart()
+	s.next.On("GetPart", ctx, part.PartUuid).Return(part, nil).Once()
+
+	first, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+	second, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+
+	assert.Equal(s.T(), "RD-180", second.Name)
+	assert.Equal(s.T(), part.Stock, second.Stock)
+	// Кэш отдаёт копию: изменения вызывающего не попадают в кэш
+	first.Name = "changed"
+	third, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "RD-180", third.Name)
+
+	assert.Equal(s.T(), 2, requests(s.metrics, "local", "hit"))
+	assert.Equal(s.T(), 1, requests(s.metrics, "local", "miss"))
+	assert.Equal(s.T(), 1, requests(s.metrics, "shared", "miss"))
+}
+
+func (s *CacheRepositorySuite) TestGetPart_ErrorsAreNotCached() {
+	ctx := context.Background()
+	id := uuid.New()
+	s.next.On("GetPart", ctx, id).Return(nil, model.ErrPartNotFound).Twice()
+
+	for range 2 {
+		_, err := s.repo.GetPart(ctx, id)
+		assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	}
+}
+
+func (s *CacheRepositorySuite) TestListParts_DifferentQueriesAreCachedSeparately() {
+	ctx := context.Background()
+	engines := &model.Filter{Categories: []model.Category{model.ENGINE}}
+	wings := &model.Filter{Categories: []model.Category{model.WING}}
+	s.next.On("ListParts", ctx, engines, mock.Anything).Return(&[]model.Part{*newPart()}, nil).Once()
+	s.next.On("ListParts", ctx, wings, mock.Anything).Return(&[]model.Part{}, nil).Once()
+
+	for range 2 {
+		parts, err := s.repo.ListParts(ctx, engines, &model.ListQuery{Limit: 10})
+		s.Require().NoError(err)
+		assert.Len(s.T(), *parts, 1)
+
+		parts, err = s.repo.ListParts(ctx, wings, &model.ListQuery{Limit: 10})
+		s.Require().NoError(err)
+		assert.Empty(s.T(), *parts)
+	}
+}
+
+func (s *CacheRepositorySuite) TestWriteInvalidates() {
+	ctx := context.Background()
+	part := newPart()
+	s.next.On("GetPart", ctx, part.PartUuid).Return(part, nil).Twice()
+	s.next.On("UpdatePart", ctx, part, part.UpdatedAt).Return(part, nil).Once()
+
+	_, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+
+	_, err = s.repo.UpdatePart(ctx, part, part.UpdatedAt)
+	s.Require().NoError(err)
+
+	_, err = s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+}
+
+func (s *CacheRepositorySuite) TestSharedLayer_AcrossInstances() {
+	ctx := context.Background()
+	part := newPart()
+
+	otherNext := mocks.NewInventoryRepository(s.T())
+	otherMetrics := cache.NewMetrics()
+	other := s.newRepository(otherNext, otherMetrics)
+
+	s.next.On("GetPart", ctx, part.PartUuid).Return(part, nil).Twice()
+
+	// Второй экземпляр читает деталь из общего кэша, не обращаясь к своему репозиторию
+	_, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+	cached, err := other.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), part.PartUuid, cached.PartUuid)
+	assert.Equal(s.T(), 1, requests(otherMetrics, "shared", "hit"))
+
+	// Запись на втором экземпляре сбрасывает локальный кэш первого
+	otherNext.On("DeletePart", ctx, part.PartUuid, mock.Anything).Return(nil).Once()
+	s.Require().NoError(other.DeletePart(ctx, part.PartUuid, time.Now()))
+
+	_, err = s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+
+	// После записи экземпляры снова используют общий кэш: ключи зависят только от общей версии
+	cached, err = other.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), part.PartUuid, cached.PartUuid)
+	assert.Equal(s.T(), 2, requests(otherMetrics, "shared", "hit"))
+}
+
+func (s *CacheRepositorySuite) TestSharedLayer_Unavailable() {
+	ctx := context.Background()
+	part := newPart()
+	s.next.On("GetPart", ctx, part.PartUuid).Return(part, nil).Twice()
+
+	// Без общего кэша нельзя узнать о записях других экземпляров, поэтому кэш не используется
+	s.redis.Close()
+
+	for range 2 {
+		result, err := s.repo.GetPart(ctx, part.PartUuid)
+		s.Require().NoError(err)
+		assert.Equal(s.T(), part.PartUuid, result.PartUuid)
+	}
+}
+
+func (s *CacheRepositorySuite) TestSharedLayer_FailedInvalidationIsRetried() {
+	ctx := context.Background()
+	part := newPart()
+	changed := *part
+	changed.Name = "Changed"
+
+	s.next.On("GetPart", ctx, part.PartUuid).Return(part, nil).Once()
+	s.next.On("UpdatePart", ctx, &changed, part.UpdatedAt).Return(&changed, nil).Once()
+	s.next.On("GetPart", ctx, part.PartUuid).Return(&changed, nil).Once()
+
+	_, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+
+	// Redis недоступен в момент записи: версия каталога не увеличилась
+	s.redis.SetError("connection lost")
+	_, err = s.repo.UpdatePart(ctx, &changed, part.UpdatedAt)
+	s.Require().NoError(err)
+	s.redis.SetError("")
+
+	// Сброс повторяется перед чтением, деталь до записи из общего кэша не читается
+	result, err := s.repo.GetPart(ctx, part.PartUuid)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Changed", result.Name)
+
+	generation, err := s.redis.Get("inventory:generation")
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "1", generation)
+}
+
+func (s *CacheRepositorySuite) TestLocalLayer_EvictsLeastRecentlyUsed() {
+	ctx := context.Background()
+	first, second, third := newPart(), newPart(), newPart()
+	s.next.On("GetPart", ctx, first.PartUuid).Return(first, nil).Twice()
+	s.next.On("GetPart", ctx, second.PartUuid).Return(second, nil).Once()
+	s.next.On("GetPart", ctx, third.PartUuid).Return(third, nil).Once()
+
+	// Общий слой сбрасывается, чтобы вытесненная запись загружалась из репозитория
+	for _, id := range []uuid.UUID{first.PartUuid, second.PartUuid, third.PartUuid} {
+		_, err := s.repo.GetPart(ctx, id)
+		s.Require().NoError(err)
+	}
+	s.redis.FlushAll()
+
+	_, err := s.repo.GetPart(ctx, first.PartUuid)
+	s.Require().NoError(err)
+}
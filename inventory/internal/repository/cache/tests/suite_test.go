@@ -0,0 +1,53 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/cache"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/mocks"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+type CacheRepositorySuite struct {
+	suite.Suite
+	redis   *miniredis.Miniredis
+	next    *mocks.InventoryRepository
+	metrics *cache.Metrics
+	repo    repository.InventoryRepository
+}
+
+func (s *CacheRepositorySuite) SetupSuite() {
+	logger.SetNopLogger()
+}
+
+func (s *CacheRepositorySuite) SetupTest() {
+	// miniredis — Redis в памяти процесса вместо общего кэша
+	s.redis = miniredis.RunT(s.T())
+	s.next = mocks.NewInventoryRepository(s.T())
+	s.metrics = cache.NewMetrics()
+	s.repo = s.newRepository(s.next, s.metrics)
+}
+
+// newRepository создаёт экземпляр кэша с общим слоем в s.redis
+func (s *CacheRepositorySuite) newRepository(next repository.InventoryRepository, metrics *cache.Metrics) repository.InventoryRepository {
+	// Без повторов: тест недоступного Redis не ждёт переподключений
+	client := redis.NewClient(&redis.Options{Addr: s.redis.Addr(), MaxRetries: -1, DialerRetries: 1})
+	s.T().Cleanup(func() { _ = client.Close() })
+
+	return cache.NewRepository(next, cache.Config{
+		Size:      2,
+		TTL:       time.Minute,
+		Shared:    cache.NewRedisShared(client),
+		SharedTTL: time.Minute,
+	}, metrics)
+}
+
+func TestCacheRepository(t *testing.T) {
+	suite.Run(t, new(CacheRepositorySuite))
+}
@@ -0,0 +1,59 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func ToRepositoryAttachment(attachment *model.Attachment) repoModel.Attachment {
+	return repoModel.Attachment{
+		AttachmentUuid: attachment.AttachmentUuid.String(),
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		Kind:           int(attachment.Kind),
+		SizeBytes:      attachment.SizeBytes,
+		ChecksumSha256: attachment.ChecksumSha256,
+		CreatedAt:      attachment.CreatedAt,
+	}
+}
+
+func toModelAttachments(attachments []repoModel.Attachment) ([]model.Attachment, error) {
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+
+	result := make([]model.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		id, err := uuid.Parse(attachment.AttachmentUuid)
+		if err != nil {
+			return nil, model.ErrConvertFromRepo
+		}
+
+		result = append(result, model.Attachment{
+			AttachmentUuid: id,
+			FileName:       attachment.FileName,
+			ContentType:    attachment.ContentType,
+			Kind:           model.AttachmentKind(attachment.Kind),
+			SizeBytes:      attachment.SizeBytes,
+			ChecksumSha256: attachment.ChecksumSha256,
+			CreatedAt:      attachment.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func toRepositoryAttachments(attachments []model.Attachment) []repoModel.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	result := make([]repoModel.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, ToRepositoryAttachment(&attachment))
+	}
+
+	return result
+}
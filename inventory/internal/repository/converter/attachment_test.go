@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (s *ConverterSuite) TestPartAttachments_RoundTrip() {
+	// Подготовка
+	part := &model.Part{
+		PartUuid: uuid.New(),
+		Attachments: []model.Attachment{{
+			AttachmentUuid: uuid.New(),
+			FileName:       "engine.dwg",
+			ContentType:    "image/vnd.dwg",
+			Kind:           model.AttachmentDrawing,
+			SizeBytes:      2048,
+			ChecksumSha256: "checksum",
+			CreatedAt:      time.Now().UTC(),
+		}},
+	}
+
+	// Выполнение
+	result, err := ToModelPart(ToRepositoryPart(part))
+
+	// Проверка
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), part.Attachments, result.Attachments)
+}
+
+func (s *ConverterSuite) TestToModelPart_InvalidAttachmentUUID() {
+	// Подготовка
+	repoPart := &repoModel.RepositoryPart{
+		PartUuid:    uuid.New().String(),
+		Attachments: []repoModel.Attachment{{AttachmentUuid: "invalid-uuid"}},
+	}
+
+	// Выполнение
+	result, err := ToModelPart(repoPart)
+
+	// Проверка
+	assert.Nil(s.T(), result)
+	assert.True(s.T(), errors.Is(err, model.ErrConvertFromRepo))
+}
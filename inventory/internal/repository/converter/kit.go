@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+// ToModelCompatibility читает ребро со стороны детали partUuid
+func ToModelCompatibility(compatibility *repoModel.Compatibility, partUuid uuid.UUID) (model.Compatibility, error) {
+	if len(compatibility.PartUuids) != 2 {
+		return model.Compatibility{}, model.ErrConvertFromRepo
+	}
+
+	first, err := uuid.Parse(compatibility.PartUuids[0])
+	if err != nil {
+		return model.Compatibility{}, model.ErrConvertFromRepo
+	}
+
+	second, err := uuid.Parse(compatibility.PartUuids[1])
+	if err != nil {
+		return model.Compatibility{}, model.ErrConvertFromRepo
+	}
+
+	edge := model.Compatibility{PartUuid: first, CompatibleUuid: second, Note: compatibility.Note}
+	if second == partUuid {
+		return edge.Reverse(), nil
+	}
+
+	return edge, nil
+}
+
+func ToRepositoryCompatibility(compatibility *model.Compatibility) *repoModel.Compatibility {
+	return &repoModel.Compatibility{
+		PartUuids: []string{compatibility.PartUuid.String(), compatibility.CompatibleUuid.String()},
+		Note:      compatibility.Note,
+	}
+}
+
+func ToModelKit(kit *repoModel.Kit) (*model.Kit, error) {
+	id, err := uuid.Parse(kit.KitUuid)
+	if err != nil {
+		return nil, model.ErrConvertFromRepo
+	}
+
+	items := make([]model.KitItem, 0, len(kit.Items))
+	for _, item := range kit.Items {
+		partUuid, err := uuid.Parse(item.PartUuid)
+		if err != nil {
+			return nil, model.ErrConvertFromRepo
+		}
+		items = append(items, model.KitItem{PartUuid: partUuid, Quantity: item.Quantity})
+	}
+
+	return &model.Kit{
+		KitUuid:     id,
+		Name:        kit.Name,
+		Description: kit.Description,
+		Items:       items,
+	}, nil
+}
+
+func ToRepositoryKit(kit *model.Kit) *repoModel.Kit {
+	items := make([]repoModel.KitItem, 0, len(kit.Items))
+	for _, item := range kit.Items {
+		items = append(items, repoModel.KitItem{PartUuid: item.PartUuid.String(), Quantity: item.Quantity})
+	}
+
+	return &repoModel.Kit{
+		KitUuid:     kit.KitUuid.String(),
+		Name:        kit.Name,
+		Description: kit.Description,
+		Items:       items,
+	}
+}
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func ToModelManufacturer(manufacturer *repoModel.Manufacturer) (*model.Manufacturer, error) {
+	id, err := uuid.Parse(manufacturer.ManufacturerUuid)
+	if err != nil {
+		return nil, model.ErrConvertFromRepo
+	}
+
+	return &model.Manufacturer{
+		ManufacturerUuid: id,
+		Name:             manufacturer.Name,
+		Country:          manufacturer.Country,
+		Website:          manufacturer.Website,
+		CreatedAt:        manufacturer.CreatedAt,
+		UpdatedAt:        manufacturer.UpdatedAt,
+	}, nil
+}
+
+func ToRepositoryManufacturer(manufacturer *model.Manufacturer) *repoModel.Manufacturer {
+	return &repoModel.Manufacturer{
+		ManufacturerUuid: manufacturer.ManufacturerUuid.String(),
+		Name:             manufacturer.Name,
+		NameKey:          model.ManufacturerKey(manufacturer.Name),
+		Country:          manufacturer.Country,
+		Website:          manufacturer.Website,
+		CreatedAt:        manufacturer.CreatedAt,
+		UpdatedAt:        manufacturer.UpdatedAt,
+	}
+}
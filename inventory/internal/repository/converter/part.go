@@ -8,15 +8,22 @@ import (
 )
 
 func ToModelPart(repoPart *repoModel.RepositoryPart) (part *model.Part, err error) {
-	id, err := uuid.Parse(repoPart.OrderUuid)
+	id, err := uuid.Parse(repoPart.PartUuid)
 	if err != nil {
 		return nil, model.ErrConvertFromRepo
 	}
 
-	manufacturer := model.Manufacturer{
-		Name:    repoPart.Manufacturer.Name,
-		Country: repoPart.Manufacturer.Country,
-		Website: repoPart.Manufacturer.Website,
+	var manufacturerUuid uuid.UUID
+	if repoPart.ManufacturerUuid != "" {
+		manufacturerUuid, err = uuid.Parse(repoPart.ManufacturerUuid)
+		if err != nil {
+			return nil, model.ErrConvertFromRepo
+		}
+	}
+
+	attachments, err := toModelAttachments(repoPart.Attachments)
+	if err != nil {
+		return nil, err
 	}
 
 	dimension := model.Dimensions{
@@ -37,26 +44,29 @@ func ToModelPart(repoPart *repoModel.RepositoryPart) (part *model.Part, err erro
 	}
 
 	return &model.Part{
-		OrderUuid:     id,
-		Name:          repoPart.Name,
-		Description:   repoPart.Description,
-		Price:         repoPart.Price,
-		StockQuantity: repoPart.StockQuantity,
-		Category:      model.ToCategory(repoPart.Category),
-		Dimensions:    dimension,
-		Manufacturer:  manufacturer,
-		Tags:          repoPart.Tags,
-		Metadata:      metadata,
-		CreatedAt:     repoPart.CreatedAt,
-		UpdatedAt:     repoPart.UpdatedAt,
+		PartUuid:         id,
+		Name:             repoPart.Name,
+		Description:      repoPart.Description,
+		Price:            repoPart.Price,
+		StockQuantity:    repoPart.StockQuantity,
+		Stock:            toModelStock(repoPart.Stock),
+		Category:         model.ToCategory(repoPart.Category),
+		Dimensions:       dimension,
+		ManufacturerUuid: manufacturerUuid,
+		Tags:             repoPart.Tags,
+		Metadata:         metadata,
+		ReorderThreshold: repoPart.ReorderThreshold,
+		Attachments:      attachments,
+		CreatedAt:        repoPart.CreatedAt,
+		UpdatedAt:        repoPart.UpdatedAt,
+		DeletedAt:        repoPart.DeletedAt,
 	}, nil
 }
 
 func ToRepositoryPart(part *model.Part) *repoModel.RepositoryPart {
-	manufacturer := repoModel.Manufacturer{
-		Name:    part.Manufacturer.Name,
-		Country: part.Manufacturer.Country,
-		Website: part.Manufacturer.Website,
+	var manufacturerUuid string
+	if part.ManufacturerUuid != uuid.Nil {
+		manufacturerUuid = part.ManufacturerUuid.String()
 	}
 
 	dimension := repoModel.Dimensions{
@@ -77,17 +87,21 @@ func ToRepositoryPart(part *model.Part) *repoModel.RepositoryPart {
 	}
 
 	return &repoModel.RepositoryPart{
-		OrderUuid:     part.OrderUuid.String(),
-		Name:          part.Name,
-		Description:   part.Description,
-		Price:         part.Price,
-		StockQuantity: part.StockQuantity,
-		Category:      int(part.Category),
-		Dimensions:    dimension,
-		Manufacturer:  manufacturer,
-		Tags:          part.Tags,
-		Metadata:      metadata,
-		CreatedAt:     part.CreatedAt,
-		UpdatedAt:     part.UpdatedAt,
+		PartUuid:         part.PartUuid.String(),
+		Name:             part.Name,
+		Description:      part.Description,
+		Price:            part.Price,
+		StockQuantity:    part.StockQuantity,
+		Stock:            toRepositoryStock(part.Stock),
+		Category:         int(part.Category),
+		Dimensions:       dimension,
+		ManufacturerUuid: manufacturerUuid,
+		Tags:             part.Tags,
+		Metadata:         metadata,
+		ReorderThreshold: part.ReorderThreshold,
+		Attachments:      toRepositoryAttachments(part.Attachments),
+		CreatedAt:        part.CreatedAt,
+		UpdatedAt:        part.UpdatedAt,
+		DeletedAt:        part.DeletedAt,
 	}
 }
@@ -14,10 +14,11 @@ import (
 func (s *ConverterSuite) TestToModelPart_Success() {
 	// Подготовка
 	partUUID := uuid.New()
+	manufacturerUUID := uuid.New()
 	now := time.Now()
 
 	repoPart := &repoModel.RepositoryPart{
-		OrderUuid:     partUUID.String(),
+		PartUuid:      partUUID.String(),
 		Name:          "Rocket Engine",
 		Description:   "Powerful rocket engine",
 		Price:         1500.75,
@@ -29,12 +30,8 @@ func (s *ConverterSuite) TestToModelPart_Success() {
 			Height: 25.3,
 			Weight: 150.8,
 		},
-		Manufacturer: repoModel.Manufacturer{
-			Name:    "SpaceX",
-			Country: "USA",
-			Website: "https://spacex.com",
-		},
-		Tags: []string{"engine", "rocket", "propulsion"},
+		ManufacturerUuid: manufacturerUUID.String(),
+		Tags:             []string{"engine", "rocket", "propulsion"},
 		Metadata: map[string]repoModel.Value{
 			"thrust": {
 				Float64Value: 1000000.5,
@@ -53,7 +50,7 @@ func (s *ConverterSuite) TestToModelPart_Success() {
 	// Проверка
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
-	assert.Equal(s.T(), partUUID, result.OrderUuid)
+	assert.Equal(s.T(), partUUID, result.PartUuid)
 	assert.Equal(s.T(), "Rocket Engine", result.Name)
 	assert.Equal(s.T(), "Powerful rocket engine", result.Description)
 	assert.Equal(s.T(), 1500.75, result.Price)
@@ -66,10 +63,9 @@ func (s *ConverterSuite) TestToModelPart_Success() {
 	assert.Equal(s.T(), 25.3, result.Dimensions.Height)
 	assert.Equal(s.T(), 150.8, result.Dimensions.Weight)
 
-	// Проверка Manufacturer
-	assert.Equal(s.T(), "SpaceX", result.Manufacturer.Name)
-	assert.Equal(s.T(), "USA", result.Manufacturer.Country)
-	assert.Equal(s.T(), "https://spacex.com", result.Manufacturer.Website)
+	// Проверка ссылки на производителя: данные из справочника подставляет репозиторий
+	assert.Equal(s.T(), manufacturerUUID, result.ManufacturerUuid)
+	assert.Empty(s.T(), result.Manufacturer.Name)
 
 	// Проверка Tags
 	assert.Equal(s.T(), []string{"engine", "rocket", "propulsion"}, result.Tags)
@@ -87,14 +83,13 @@ func (s *ConverterSuite) TestToModelPart_Success() {
 func (s *ConverterSuite) TestToModelPart_InvalidUUID() {
 	// Подготовка
 	repoPart := &repoModel.RepositoryPart{
-		OrderUuid:     "invalid-uuid",
+		PartUuid:      "invalid-uuid",
 		Name:          "Rocket Engine",
 		Description:   "Powerful rocket engine",
 		Price:         1500.75,
 		StockQuantity: 5,
 		Category:      1,
 		Dimensions:    repoModel.Dimensions{},
-		Manufacturer:  repoModel.Manufacturer{},
 		Tags:          []string{},
 		Metadata:      map[string]repoModel.Value{},
 		CreatedAt:     time.Now(),
@@ -110,17 +105,34 @@ func (s *ConverterSuite) TestToModelPart_InvalidUUID() {
 	assert.True(s.T(), errors.Is(err, model.ErrConvertFromRepo))
 }
 
+func (s *ConverterSuite) TestToModelPart_InvalidManufacturerUUID() {
+	// Подготовка
+	repoPart := &repoModel.RepositoryPart{
+		PartUuid:         uuid.New().String(),
+		Name:             "Rocket Engine",
+		ManufacturerUuid: "invalid-uuid",
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	// Выполнение
+	result, err := ToModelPart(repoPart)
+
+	// Проверка
+	assert.Nil(s.T(), result)
+	assert.True(s.T(), errors.Is(err, model.ErrConvertFromRepo))
+}
+
 func (s *ConverterSuite) TestToModelPart_EmptyUUID() {
 	// Подготовка
 	repoPart := &repoModel.RepositoryPart{
-		OrderUuid:     "",
+		PartUuid:      "",
 		Name:          "Rocket Engine",
 		Description:   "Powerful rocket engine",
 		Price:         1500.75,
 		StockQuantity: 5,
 		Category:      1,
 		Dimensions:    repoModel.Dimensions{},
-		Manufacturer:  repoModel.Manufacturer{},
 		Tags:          []string{},
 		Metadata:      map[string]repoModel.Value{},
 		CreatedAt:     time.Now(),
@@ -156,14 +168,13 @@ func (s *ConverterSuite) TestToModelPart_AllCategories() {
 
 	for _, tc := range testCases {
 		repoPart := &repoModel.RepositoryPart{
-			OrderUuid:     partUUID.String(),
+			PartUuid:      partUUID.String(),
 			Name:          "Test Part",
 			Description:   "Test Description",
 			Price:         100.0,
 			StockQuantity: 1,
 			Category:      tc.category,
 			Dimensions:    repoModel.Dimensions{},
-			Manufacturer:  repoModel.Manufacturer{},
 			Tags:          []string{},
 			Metadata:      map[string]repoModel.Value{},
 			CreatedAt:     now,
@@ -191,14 +202,13 @@ func (s *ConverterSuite) TestToModelPart_AllMetadataTypes() {
 	now := time.Now()
 
 	repoPart := &repoModel.RepositoryPart{
-		OrderUuid:     partUUID.String(),
+		PartUuid:      partUUID.String(),
 		Name:          "Test Part",
 		Description:   "Test Description",
 		Price:         100.0,
 		StockQuantity: 1,
 		Category:      1,
 		Dimensions:    repoModel.Dimensions{},
-		Manufacturer:  repoModel.Manufacturer{},
 		Tags:          []string{},
 		Metadata: map[string]repoModel.Value{
 			"string_val": {
@@ -238,14 +248,13 @@ func (s *ConverterSuite) TestToModelPart_EmptyMetadata() {
 	now := time.Now()
 
 	repoPart := &repoModel.RepositoryPart{
-		OrderUuid:     partUUID.String(),
+		PartUuid:      partUUID.String(),
 		Name:          "Test Part",
 		Description:   "Test Description",
 		Price:         100.0,
 		StockQuantity: 1,
 		Category:      1,
 		Dimensions:    repoModel.Dimensions{},
-		Manufacturer:  repoModel.Manufacturer{},
 		Tags:          []string{},
 		Metadata:      map[string]repoModel.Value{},
 		CreatedAt:     now,
@@ -267,7 +276,7 @@ func (s *ConverterSuite) TestToModelPart_ZeroValues() {
 	now := time.Now()
 
 	repoPart := &repoModel.RepositoryPart{
-		OrderUuid:     partUUID.String(),
+		PartUuid:      partUUID.String(),
 		Name:          "",
 		Description:   "",
 		Price:         0,
@@ -279,11 +288,6 @@ func (s *ConverterSuite) TestToModelPart_ZeroValues() {
 			Height: 0,
 			Weight: 0,
 		},
-		Manufacturer: repoModel.Manufacturer{
-			Name:    "",
-			Country: "",
-			Website: "",
-		},
 		Tags:      []string{},
 		Metadata:  map[string]repoModel.Value{},
 		CreatedAt: now,
@@ -296,14 +300,14 @@ func (s *ConverterSuite) TestToModelPart_ZeroValues() {
 	// Проверка
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
-	assert.Equal(s.T(), partUUID, result.OrderUuid)
+	assert.Equal(s.T(), partUUID, result.PartUuid)
 	assert.Equal(s.T(), "", result.Name)
 	assert.Equal(s.T(), "", result.Description)
 	assert.Equal(s.T(), float64(0), result.Price)
 	assert.Equal(s.T(), int64(0), result.StockQuantity)
 	assert.Equal(s.T(), model.UNKNOWN, result.Category)
 	assert.Equal(s.T(), float64(0), result.Dimensions.Length)
-	assert.Equal(s.T(), "", result.Manufacturer.Name)
+	assert.Equal(s.T(), uuid.Nil, result.ManufacturerUuid)
 	assert.Empty(s.T(), result.Tags)
 	assert.Empty(s.T(), result.Metadata)
 }
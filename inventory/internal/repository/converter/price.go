@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func ToModelPriceHistory(periods []repoModel.PricePeriod) []model.PricePeriod {
+	history := make([]model.PricePeriod, 0, len(periods))
+	for _, period := range periods {
+		history = append(history, model.PricePeriod{
+			Price:         period.Price,
+			EffectiveFrom: period.EffectiveFrom,
+			EffectiveTo:   period.EffectiveTo,
+		})
+	}
+
+	return history
+}
+
+func ToRepositoryPriceHistory(partUuid string, history []model.PricePeriod) []repoModel.PricePeriod {
+	periods := make([]repoModel.PricePeriod, 0, len(history))
+	for _, period := range history {
+		periods = append(periods, repoModel.PricePeriod{
+			PartUuid:      partUuid,
+			Price:         period.Price,
+			EffectiveFrom: period.EffectiveFrom,
+			EffectiveTo:   period.EffectiveTo,
+		})
+	}
+
+	return periods
+}
@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func ToModelWarehouse(warehouse *repoModel.Warehouse) model.Warehouse {
+	return model.Warehouse{
+		Code:     warehouse.Code,
+		Name:     warehouse.Name,
+		Location: warehouse.Location,
+	}
+}
+
+func ToRepositoryWarehouse(warehouse *model.Warehouse) repoModel.Warehouse {
+	return repoModel.Warehouse{
+		Code:     warehouse.Code,
+		Name:     warehouse.Name,
+		Location: warehouse.Location,
+	}
+}
+
+func ToRepositoryStockTransfer(transfer *model.StockTransfer) *repoModel.StockTransfer {
+	return &repoModel.StockTransfer{
+		TransferUuid:  transfer.TransferUuid.String(),
+		PartUuid:      transfer.PartUuid.String(),
+		FromWarehouse: transfer.FromWarehouse,
+		ToWarehouse:   transfer.ToWarehouse,
+		Quantity:      transfer.Quantity,
+		Reason:        transfer.Reason,
+		CreatedAt:     transfer.CreatedAt,
+	}
+}
+
+func toModelStock(stock []repoModel.StockLevel) []model.StockLevel {
+	if len(stock) == 0 {
+		return nil
+	}
+
+	levels := make([]model.StockLevel, 0, len(stock))
+	for _, level := range stock {
+		levels = append(levels, model.StockLevel{Warehouse: level.Warehouse, Quantity: level.Quantity})
+	}
+
+	return levels
+}
+
+func toRepositoryStock(stock []model.StockLevel) []repoModel.StockLevel {
+	if len(stock) == 0 {
+		return nil
+	}
+
+	levels := make([]repoModel.StockLevel, 0, len(stock))
+	for _, level := range stock {
+		levels = append(levels, repoModel.StockLevel{Warehouse: level.Warehouse, Quantity: level.Quantity})
+	}
+
+	return levels
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) AddAttachment(_ context.Context, partUuid uuid.UUID, attachment *model.Attachment) (*model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.data[partUuid.String()]
+	if !ok || existing.DeletedAt != nil {
+		return nil, model.ErrPartNotFound
+	}
+
+	// Копия документа: деталь, выданная раньше, не должна меняться
+	repoPart := *existing
+	repoPart.Attachments = append(append(repoPart.Attachments[:0:0], existing.Attachments...),
+		repoConverter.ToRepositoryAttachment(attachment))
+	repoPart.UpdatedAt = attachment.CreatedAt
+
+	r.data[repoPart.PartUuid] = &repoPart
+	r.publish(model.PartUpdated, &repoPart)
+
+	return r.toModelPart(&repoPart)
+}
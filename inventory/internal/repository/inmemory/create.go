@@ -0,0 +1,56 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	repoPart := repoConverter.ToRepositoryPart(part)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.data[repoPart.PartUuid]; ok {
+		return nil, model.ErrPartAlreadyExists
+	}
+
+	r.data[repoPart.PartUuid] = repoPart
+	r.setInitialPrice(part)
+	r.publish(model.PartCreated, repoPart)
+
+	return r.toModelPart(repoPart)
+}
+
+func (r *repository) BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Сначала проверяем все детали, чтобы не сохранить пачку частично
+	seen := make(map[string]bool, len(parts))
+	for _, part := range parts {
+		id := part.PartUuid.String()
+		if _, ok := r.data[id]; ok || seen[id] {
+			return nil, model.ErrPartAlreadyExists
+		}
+		seen[id] = true
+	}
+
+	created := make([]model.Part, 0, len(parts))
+	for _, part := range parts {
+		repoPart := repoConverter.ToRepositoryPart(&part)
+		r.data[repoPart.PartUuid] = repoPart
+		r.setInitialPrice(&part)
+		r.publish(model.PartCreated, repoPart)
+
+		modelPart, err := r.toModelPart(repoPart)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, *modelPart)
+	}
+
+	return &created, nil
+}
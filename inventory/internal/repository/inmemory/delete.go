@@ -0,0 +1,29 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (r *repository) DeletePart(ctx context.Context, uuid uuid.UUID, deletedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	repoPart, ok := r.data[uuid.String()]
+	if !ok || repoPart.DeletedAt != nil {
+		return model.ErrPartNotFound
+	}
+
+	// Soft delete: деталь остаётся в хранилище, но больше не возвращается в выборках
+	deleted := *repoPart
+	deleted.DeletedAt = &deletedAt
+	deleted.UpdatedAt = deletedAt
+	r.data[uuid.String()] = &deleted
+	r.publish(model.PartDeleted, &deleted)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) ListCompatibility(_ context.Context, partUuid uuid.UUID) ([]model.Compatibility, error) {
+	id := partUuid.String()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var edges []model.Compatibility
+	for _, repoEdge := range r.compatibility {
+		if !slices.Contains(repoEdge.PartUuids, id) {
+			continue
+		}
+
+		edge, err := repoConverter.ToModelCompatibility(&repoEdge, partUuid)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
+
+func (r *repository) ListKits(_ context.Context) ([]model.Kit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kits := make([]model.Kit, 0, len(r.kits))
+	for _, repoKit := range r.kits {
+		kit, err := repoConverter.ToModelKit(repoKit)
+		if err != nil {
+			return nil, err
+		}
+		kits = append(kits, *kit)
+	}
+
+	// Наборы отдаются в том же порядке, что и из MongoDB
+	slices.SortFunc(kits, func(a, b model.Kit) int {
+		if result := strings.Compare(a.Name, b.Name); result != 0 {
+			return result
+		}
+		return cmp.Compare(a.KitUuid.String(), b.KitUuid.String())
+	})
+
+	return kits, nil
+}
+
+func (r *repository) GetKit(_ context.Context, uuid uuid.UUID) (*model.Kit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	repoKit, ok := r.kits[uuid.String()]
+	if !ok {
+		return nil, model.ErrKitNotFound
+	}
+
+	return repoConverter.ToModelKit(repoKit)
+}
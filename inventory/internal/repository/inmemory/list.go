@@ -2,107 +2,61 @@ package inmemory
 
 import (
 	"context"
+	"slices"
 
 	"github.com/samber/lo"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
-	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
-	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
 )
 
-func (r *repository) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
-	partsFiltered := make([]*repoModel.RepositoryPart, 0)
+func (r *repository) ListParts(ctx context.Context, filter *model.Filter, query *model.ListQuery) (*[]model.Part, error) {
+	parts := make([]model.Part, 0)
 	r.mu.RLock()
-	for _, part := range r.data {
-		partsFiltered = append(partsFiltered, part)
-	}
-	r.mu.RUnlock()
-
-	if filter != nil {
-		partsFiltered = filtration(filter, partsFiltered)
-	}
+	defer r.mu.RUnlock()
+	for _, repoPart := range r.data {
+		if repoPart.DeletedAt != nil {
+			continue
+		}
 
-	parts := make([]model.Part, 0, len(partsFiltered))
-	for _, repoPart := range partsFiltered {
-		part, err := repoConverter.ToModelPart(repoPart)
+		part, err := r.toModelPart(repoPart)
 		if err != nil {
 			return nil, err
 		}
-		parts = append(parts, lo.FromPtr(part))
-	}
-
-	return &parts, nil
-}
 
-func filtration(filter *model.Filter, parts []*repoModel.RepositoryPart) (result []*repoModel.RepositoryPart) {
-	// Создаем мап для фильтрации
-	uuidSet := make(map[string]bool)
-	for _, uuid := range filter.Uuids {
-		uuidSet[uuid.String()] = true
-	}
-
-	nameSet := make(map[string]bool)
-	for _, name := range filter.Names {
-		nameSet[name] = true
-	}
-
-	categorySet := make(map[int]bool)
-	for _, category := range filter.Categories {
-		categorySet[int(category)] = true
-	}
-
-	manufacturerCountrySet := make(map[string]bool)
-	for _, manufacturerCountry := range filter.ManufacturerCountries {
-		manufacturerCountrySet[manufacturerCountry] = true
+		if filter.Match(part) {
+			parts = append(parts, lo.FromPtr(part))
+		}
 	}
 
-	tagSet := make(map[string]bool)
-	for _, tag := range filter.Tags {
-		tagSet[tag] = true
+	if query == nil {
+		query = &model.ListQuery{}
 	}
+	parts = paginate(parts, query)
 
-	// Фильтруем детали
-	for _, part := range parts {
-		if len(uuidSet) > 0 {
-			if _, ok := uuidSet[part.OrderUuid]; !ok {
-				continue
-			}
-		}
-
-		if len(nameSet) > 0 {
-			if _, ok := nameSet[part.Name]; !ok {
-				continue
-			}
-		}
-
-		if len(categorySet) > 0 {
-			if _, ok := categorySet[part.Category]; !ok {
-				continue
-			}
-		}
-
-		if len(manufacturerCountrySet) > 0 {
-			if _, ok := manufacturerCountrySet[part.Manufacturer.Country]; !ok {
-				continue
-			}
-		}
+	return &parts, nil
+}
 
-		if len(tagSet) > 0 {
-			// Проверяем, есть ли хотя бы один тег из фильтра в тегах детали
-			tagFound := false
-			for _, partTag := range part.Tags {
-				if _, ok := tagSet[partTag]; ok {
-					tagFound = true
-					break
-				}
+// paginate сортирует детали и вырезает страницу после курсора query.After.
+// Проекция query.Fields не применяется: детали и так хранятся в памяти целиком
+func paginate(parts []model.Part, query *model.ListQuery) []model.Part {
+	slices.SortFunc(parts, func(a, b model.Part) int {
+		return query.Sort.Compare(&a, &b)
+	})
+
+	if query.After != nil {
+		after := query.After.Part()
+		start, _ := slices.BinarySearchFunc(parts, after, func(part model.Part, target *model.Part) int {
+			if query.Sort.Compare(&part, target) <= 0 {
+				return -1
 			}
-			if !tagFound {
-				continue
-			}
-		}
+			return 1
+		})
+		parts = parts[start:]
+	}
 
-		result = append(result, part)
+	if query.Limit > 0 && len(parts) > query.Limit {
+		parts = parts[:query.Limit]
 	}
 
-	return result
+	return parts
 }
@@ -0,0 +1,167 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (r *repository) CreateManufacturer(_ context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	repoManufacturer := repoConverter.ToRepositoryManufacturer(manufacturer)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.manufacturers[repoManufacturer.ManufacturerUuid]; ok || r.nameTaken(repoManufacturer) {
+		return nil, model.ErrManufacturerAlreadyExists
+	}
+
+	r.manufacturers[repoManufacturer.ManufacturerUuid] = repoManufacturer
+
+	return repoConverter.ToModelManufacturer(repoManufacturer)
+}
+
+func (r *repository) GetManufacturer(_ context.Context, uuid uuid.UUID) (*model.Manufacturer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	repoManufacturer, ok := r.manufacturers[uuid.String()]
+	if !ok {
+		return nil, model.ErrManufacturerNotFound
+	}
+
+	return repoConverter.ToModelManufacturer(repoManufacturer)
+}
+
+func (r *repository) FindManufacturerByName(_ context.Context, name string) (*model.Manufacturer, error) {
+	key := model.ManufacturerKey(name)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, repoManufacturer := range r.manufacturers {
+		if repoManufacturer.NameKey == key {
+			return repoConverter.ToModelManufacturer(repoManufacturer)
+		}
+	}
+
+	return nil, model.ErrManufacturerNotFound
+}
+
+func (r *repository) ListManufacturers(_ context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	manufacturers := make([]model.Manufacturer, 0, len(r.manufacturers))
+	for _, repoManufacturer := range r.manufacturers {
+		manufacturer, err := repoConverter.ToModelManufacturer(repoManufacturer)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Match(manufacturer) {
+			manufacturers = append(manufacturers, *manufacturer)
+		}
+	}
+
+	// Производители отдаются в том же порядке, что и из MongoDB
+	slices.SortFunc(manufacturers, func(a, b model.Manufacturer) int {
+		if result := strings.Compare(a.Name, b.Name); result != 0 {
+			return result
+		}
+		return cmp.Compare(a.ManufacturerUuid.String(), b.ManufacturerUuid.String())
+	})
+
+	return manufacturers, nil
+}
+
+func (r *repository) UpdateManufacturer(_ context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	repoManufacturer := repoConverter.ToRepositoryManufacturer(manufacturer)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.manufacturers[repoManufacturer.ManufacturerUuid]
+	if !ok {
+		return nil, model.ErrManufacturerNotFound
+	}
+	if r.nameTaken(repoManufacturer) {
+		return nil, model.ErrManufacturerAlreadyExists
+	}
+
+	// Дата создания не меняется при обновлении
+	repoManufacturer.CreatedAt = existing.CreatedAt
+	r.manufacturers[repoManufacturer.ManufacturerUuid] = repoManufacturer
+
+	return repoConverter.ToModelManufacturer(repoManufacturer)
+}
+
+func (r *repository) DeleteManufacturer(_ context.Context, uuid uuid.UUID) error {
+	id := uuid.String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.manufacturers[id]; !ok {
+		return model.ErrManufacturerNotFound
+	}
+
+	// Удалённые детали (soft delete) ссылку не удерживают
+	for _, repoPart := range r.data {
+		if repoPart.ManufacturerUuid == id && repoPart.DeletedAt == nil {
+			return model.ErrManufacturerInUse
+		}
+	}
+
+	delete(r.manufacturers, id)
+
+	return nil
+}
+
+// nameTaken сообщает, занято ли название производителя другой записью справочника
+func (r *repository) nameTaken(manufacturer *repoModel.Manufacturer) bool {
+	for id, existing := range r.manufacturers {
+		if id != manufacturer.ManufacturerUuid && existing.NameKey == manufacturer.NameKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toModelPart преобразует деталь и подставляет производителя из справочника.
+// Вызывается под r.mu
+func (r *repository) toModelPart(repoPart *repoModel.RepositoryPart) (*model.Part, error) {
+	part, err := repoConverter.ToModelPart(repoPart)
+	if err != nil {
+		return nil, err
+	}
+
+	if repoManufacturer, ok := r.manufacturers[repoPart.ManufacturerUuid]; ok {
+		manufacturer, err := repoConverter.ToModelManufacturer(repoManufacturer)
+		if err != nil {
+			return nil, err
+		}
+		part.Manufacturer = *manufacturer
+	}
+
+	return part, nil
+}
+
+// publish отправляет подписчикам событие по детали вместе с данными производителя.
+// Вызывается под r.mu
+func (r *repository) publish(eventType model.PartEventType, repoPart *repoModel.RepositoryPart) {
+	part, err := r.toModelPart(repoPart)
+	if err != nil {
+		return
+	}
+
+	r.events.publish(eventType, *part)
+}
@@ -0,0 +1,76 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) GetPriceHistory(_ context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return repoConverter.ToModelPriceHistory(r.prices[partUuid.String()]), nil
+}
+
+func (r *repository) SchedulePrice(_ context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error) {
+	id := partUuid.String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := model.SchedulePrice(repoConverter.ToModelPriceHistory(r.prices[id]), price, from)
+	r.prices[id] = repoConverter.ToRepositoryPriceHistory(id, history)
+
+	return history, nil
+}
+
+func (r *repository) GetPricesAt(_ context.Context, partUuids []uuid.UUID, at time.Time) (map[uuid.UUID]float64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	prices := make(map[uuid.UUID]float64, len(partUuids))
+	for _, id := range partUuids {
+		history := repoConverter.ToModelPriceHistory(r.prices[id.String()])
+		if price, ok := model.PriceAt(history, at); ok {
+			prices[id] = price
+		}
+	}
+
+	return prices, nil
+}
+
+func (r *repository) SyncCurrentPrices(_ context.Context, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var updated int64
+	for id, periods := range r.prices {
+		repoPart, ok := r.data[id]
+		if !ok || repoPart.DeletedAt != nil {
+			continue
+		}
+
+		price, ok := model.PriceAt(repoConverter.ToModelPriceHistory(periods), now)
+		if !ok || price == repoPart.Price {
+			continue
+		}
+
+		repoPart.Price = price
+		repoPart.UpdatedAt = now
+		r.publish(model.PartUpdated, repoPart)
+		updated++
+	}
+
+	return updated, nil
+}
+
+// setInitialPrice заводит новой детали историю цен: цена действует с даты создания детали
+func (r *repository) setInitialPrice(part *model.Part) {
+	id := part.PartUuid.String()
+	r.prices[id] = repoConverter.ToRepositoryPriceHistory(id, model.SchedulePrice(nil, part.Price, part.CreatedAt))
+}
@@ -10,13 +10,24 @@ import (
 var _ def.InventoryRepository = (*repository)(nil)
 
 type repository struct {
-	mu   sync.RWMutex
-	data map[string]*repoModel.RepositoryPart
+	mu            sync.RWMutex
+	data          map[string]*repoModel.RepositoryPart
+	warehouses    []repoModel.Warehouse
+	transfers     []*repoModel.StockTransfer
+	prices        map[string][]repoModel.PricePeriod
+	manufacturers map[string]*repoModel.Manufacturer
+	compatibility []repoModel.Compatibility
+	kits          map[string]*repoModel.Kit
+	events        *broker
 }
 
 func NewRepository() def.InventoryRepository {
 	repo := &repository{
-		data: make(map[string]*repoModel.RepositoryPart),
+		data:          make(map[string]*repoModel.RepositoryPart),
+		prices:        make(map[string][]repoModel.PricePeriod),
+		manufacturers: make(map[string]*repoModel.Manufacturer),
+		kits:          make(map[string]*repoModel.Kit),
+		events:        newBroker(),
 	}
 
 	repo.addTestData()
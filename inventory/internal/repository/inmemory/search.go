@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+)
+
+// SearchParts — упрощённый поиск без текстового индекса: детали оцениваются
+// по совпадению основ слов в полях с теми же весами, что и в MongoDB
+func (r *repository) SearchParts(ctx context.Context, query *model.SearchQuery) (*[]model.SearchHit, error) {
+	parts, err := r.ListParts(ctx, query.Filter, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]model.SearchHit, 0)
+	for _, part := range *parts {
+		if score := search.Score(&part, query.Terms); score > 0 {
+			hits = append(hits, model.SearchHit{Part: part, Score: score})
+		}
+	}
+
+	slices.SortFunc(hits, func(a, b model.SearchHit) int {
+		if result := cmp.Compare(b.Score, a.Score); result != 0 {
+			return result
+		}
+		return cmp.Compare(a.Part.PartUuid.String(), b.Part.PartUuid.String())
+	})
+
+	hits = hits[min(query.Offset, len(hits)):]
+	if query.Limit > 0 && len(hits) > query.Limit {
+		hits = hits[:query.Limit]
+	}
+
+	return &hits, nil
+}
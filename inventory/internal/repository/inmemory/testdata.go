@@ -2,60 +2,74 @@ package inmemory
 
 import (
 	"log"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/fixtures"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/catalog"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
 	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
 )
 
-// TestData Добавление тестовых данных
+// TestData Добавление тестовых данных из fixtures/inmemory.yaml, справочника складов, графа совместимости и наборов
 func (r *repository) addTestData() {
 	log.Printf("Add Test Data for inventory service")
 
+	parts, err := catalog.LoadFixture(fixtures.InMemoryParts)
+	if err != nil {
+		// Фикстура встроена в бинарник, ошибка в ней — ошибка сборки
+		panic(err)
+	}
+
+	warehouses, err := catalog.LoadWarehouses(fixtures.Warehouses)
+	if err != nil {
+		panic(err)
+	}
+
+	compatibility, err := catalog.LoadCompatibility(fixtures.Compatibility)
+	if err != nil {
+		panic(err)
+	}
+
+	kits, err := catalog.LoadKits(fixtures.Kits)
+	if err != nil {
+		panic(err)
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.data = map[string]*repoModel.RepositoryPart{
-		"d973e963-b7e6-4323-8f4e-4bfd5ab8e834": {
-			OrderUuid:     "d973e963-b7e6-4323-8f4e-4bfd5ab8e834",
-			Name:          "Detail 1",
-			Description:   "Detail 1 description",
-			Price:         100,
-			StockQuantity: 10,
-			Category:      1, // ENGINE
-			Dimensions: repoModel.Dimensions{
-				Length: 100,
-				Width:  100,
-				Height: 100,
-				Weight: 100,
-			},
-			Manufacturer: repoModel.Manufacturer{
-				Country: "China",
-				Name:    "Details Fabric",
-			},
-			Tags:      []string{"tag1", "tag2"},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		"d973e963-b7e6-4323-8f4e-4bfd5ab8e835": {
-			OrderUuid:     "d973e963-b7e6-4323-8f4e-4bfd5ab8e835",
-			Name:          "Detail 2",
-			Description:   "Detail 2 description",
-			Price:         200,
-			StockQuantity: 20,
-			Category:      1, // ENGINE
-			Dimensions: repoModel.Dimensions{
-				Length: 100,
-				Width:  100,
-				Height: 100,
-				Weight: 100,
-			},
-			Manufacturer: repoModel.Manufacturer{
-				Country: "USA",
-				Name:    "Details Fabric",
-			},
-			Tags:      []string{"tag1", "tag2"},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
+	for i := range warehouses {
+		r.warehouses = append(r.warehouses, repoConverter.ToRepositoryWarehouse(&warehouses[i]))
+	}
+	// Склады отдаются в том же порядке, что и из MongoDB
+	slices.SortFunc(r.warehouses, func(a, b repoModel.Warehouse) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+
+	// Рёбра и наборы могут ссылаться на детали, которых нет в in-memory каталоге:
+	// такие детали считаются удалёнными
+	for i := range compatibility {
+		r.compatibility = append(r.compatibility, *repoConverter.ToRepositoryCompatibility(&compatibility[i]))
+	}
+	for i := range kits {
+		repoKit := repoConverter.ToRepositoryKit(&kits[i])
+		r.kits[repoKit.KitUuid] = repoKit
+	}
+
+	now := time.Now()
+	for _, manufacturer := range catalog.LinkManufacturers(parts, now) {
+		repoManufacturer := repoConverter.ToRepositoryManufacturer(&manufacturer)
+		r.manufacturers[repoManufacturer.ManufacturerUuid] = repoManufacturer
+	}
+
+	for i := range parts {
+		parts[i].CreatedAt = now
+		parts[i].UpdatedAt = now
+
+		repoPart := repoConverter.ToRepositoryPart(&parts[i])
+		r.data[repoPart.PartUuid] = repoPart
+		r.setInitialPrice(&parts[i])
 	}
 }
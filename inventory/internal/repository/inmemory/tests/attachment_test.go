@@ -0,0 +1,56 @@
+package inmemory_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *InMemoryRepositorySuite) TestAddAttachment() {
+	attachment := &model.Attachment{
+		AttachmentUuid: uuid.New(),
+		FileName:       "engine.pdf",
+		ContentType:    "application/pdf",
+		Kind:           model.AttachmentDatasheet,
+		SizeBytes:      4,
+		ChecksumSha256: "checksum",
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	part, err := s.repository.AddAttachment(context.Background(), detail1, attachment)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []model.Attachment{*attachment}, part.Attachments)
+	assert.Equal(s.T(), attachment.CreatedAt, part.UpdatedAt)
+
+	stored, err := s.repository.GetPart(context.Background(), detail1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []model.Attachment{*attachment}, stored.Attachments)
+}
+
+func (s *InMemoryRepositorySuite) TestAddAttachment_PartNotFound() {
+	part, err := s.repository.AddAttachment(context.Background(), uuid.New(), &model.Attachment{AttachmentUuid: uuid.New()})
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), part)
+}
+
+func (s *InMemoryRepositorySuite) TestUpdatePart_KeepsAttachments() {
+	attachment := &model.Attachment{AttachmentUuid: uuid.New(), FileName: "engine.dwg", CreatedAt: time.Now().UTC()}
+	_, err := s.repository.AddAttachment(context.Background(), detail1, attachment)
+	s.Require().NoError(err)
+
+	part, err := s.repository.GetPart(context.Background(), detail1)
+	s.Require().NoError(err)
+	part.Name = "Renamed"
+	part.Attachments = nil
+
+	updated, err := s.repository.UpdatePart(context.Background(), part, part.UpdatedAt)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Renamed", updated.Name)
+	assert.Equal(s.T(), []model.Attachment{*attachment}, updated.Attachments)
+}
@@ -0,0 +1,108 @@
+package inmemory_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func newPart() *model.Part {
+	now := time.Now().UTC()
+	return &model.Part{
+		PartUuid:      uuid.New(),
+		Name:          "New Part",
+		Price:         10,
+		StockQuantity: 1,
+		Category:      model.WING,
+		Dimensions:    model.Dimensions{Length: 1, Width: 1, Height: 1, Weight: 1},
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (s *InMemoryRepositorySuite) TestCreatePart_Success() {
+	part := newPart()
+
+	created, err := s.repository.CreatePart(context.Background(), part)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), part.PartUuid, created.PartUuid)
+
+	stored, err := s.repository.GetPart(context.Background(), part.PartUuid)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "New Part", stored.Name)
+}
+
+func (s *InMemoryRepositorySuite) TestCreatePart_AlreadyExists() {
+	part := newPart()
+	part.PartUuid = uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834")
+
+	created, err := s.repository.CreatePart(context.Background(), part)
+	assert.ErrorIs(s.T(), err, model.ErrPartAlreadyExists)
+	assert.Nil(s.T(), created)
+}
+
+func (s *InMemoryRepositorySuite) TestBatchCreateParts_AllOrNothing() {
+	duplicate := newPart()
+	duplicate.PartUuid = uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e835")
+	fresh := newPart()
+
+	created, err := s.repository.BatchCreateParts(context.Background(), []model.Part{*fresh, *duplicate})
+	assert.ErrorIs(s.T(), err, model.ErrPartAlreadyExists)
+	assert.Nil(s.T(), created)
+
+	// Ни одна деталь из пачки не должна сохраниться
+	_, err = s.repository.GetPart(context.Background(), fresh.PartUuid)
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+
+	created, err = s.repository.BatchCreateParts(context.Background(), []model.Part{*fresh, *newPart()})
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), *created, 2)
+}
+
+func (s *InMemoryRepositorySuite) TestUpdatePart_KeepsCreatedAt() {
+	part := newPart()
+	_, err := s.repository.CreatePart(context.Background(), part)
+	assert.NoError(s.T(), err)
+
+	changed := *part
+	changed.Price = 99
+	changed.CreatedAt = time.Time{}
+	changed.UpdatedAt = part.UpdatedAt.Add(time.Minute)
+
+	updated, err := s.repository.UpdatePart(context.Background(), &changed, part.UpdatedAt)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 99.0, updated.Price)
+	assert.Equal(s.T(), part.CreatedAt, updated.CreatedAt)
+	assert.Equal(s.T(), changed.UpdatedAt, updated.UpdatedAt)
+}
+
+func (s *InMemoryRepositorySuite) TestUpdatePart_NotFound() {
+	updated, err := s.repository.UpdatePart(context.Background(), newPart(), time.Time{})
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), updated)
+}
+
+func (s *InMemoryRepositorySuite) TestDeletePart_SoftDelete() {
+	id := uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834")
+
+	err := s.repository.DeletePart(context.Background(), id, time.Now())
+	assert.NoError(s.T(), err)
+
+	_, err = s.repository.GetPart(context.Background(), id)
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+
+	parts, err := s.repository.ListParts(context.Background(), nil, nil)
+	assert.NoError(s.T(), err)
+	for _, part := range *parts {
+		assert.NotEqual(s.T(), id, part.PartUuid)
+	}
+
+	// Повторное удаление и обновление удалённой детали невозможны
+	assert.ErrorIs(s.T(), s.repository.DeletePart(context.Background(), id, time.Now()), model.ErrPartNotFound)
+	_, err = s.repository.UpdatePart(context.Background(), &model.Part{PartUuid: id}, time.Time{})
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+}
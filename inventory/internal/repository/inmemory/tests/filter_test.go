@@ -0,0 +1,88 @@
+package inmemory_test
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *InMemoryRepositorySuite) listNames(filter *model.Filter) []string {
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
+	s.Require().NoError(err)
+
+	return lo.Map(*result, func(p model.Part, _ int) string { return p.Name })
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_PriceRange() {
+	assert.ElementsMatch(s.T(), []string{"Detail 2"}, s.listNames(&model.Filter{PriceMin: lo.ToPtr(150.0)}))
+	assert.ElementsMatch(s.T(), []string{"Detail 1"}, s.listNames(&model.Filter{PriceMax: lo.ToPtr(100.0)}))
+	assert.ElementsMatch(s.T(), []string{"Detail 1", "Detail 2"}, s.listNames(&model.Filter{PriceMin: lo.ToPtr(100.0), PriceMax: lo.ToPtr(200.0)}))
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_Stock() {
+	empty := newPart()
+	empty.Name = "Empty"
+	empty.StockQuantity = 0
+	_, err := s.repository.CreatePart(context.Background(), empty)
+	s.Require().NoError(err)
+
+	assert.ElementsMatch(s.T(), []string{"Detail 2"}, s.listNames(&model.Filter{MinStock: lo.ToPtr(int64(15))}))
+	assert.ElementsMatch(s.T(), []string{"Detail 1", "Detail 2"}, s.listNames(&model.Filter{InStockOnly: true}))
+	assert.Contains(s.T(), s.listNames(&model.Filter{}), "Empty")
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_ManufacturerName() {
+	assert.ElementsMatch(s.T(), []string{"Detail 1"}, s.listNames(&model.Filter{ManufacturerNames: []string{"Details Fabric"}}))
+	assert.ElementsMatch(s.T(), []string{"Detail 1", "Detail 2"}, s.listNames(&model.Filter{ManufacturerNames: []string{"Details Fabric", "Details Fabric America"}}))
+	assert.Empty(s.T(), s.listNames(&model.Filter{ManufacturerNames: []string{"Unknown"}}))
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_TagMatchAll() {
+	tagged := newPart()
+	tagged.Name = "Only tag1"
+	tagged.Tags = []string{"tag1"}
+	_, err := s.repository.CreatePart(context.Background(), tagged)
+	s.Require().NoError(err)
+
+	tags := []string{"tag1", "tag2"}
+	assert.ElementsMatch(s.T(), []string{"Detail 1", "Detail 2", "Only tag1"}, s.listNames(&model.Filter{Tags: tags}))
+	assert.ElementsMatch(s.T(), []string{"Detail 1", "Detail 2"}, s.listNames(&model.Filter{Tags: tags, TagMatch: model.TagMatchAll}))
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_Metadata() {
+	heavy := newPart()
+	heavy.Name = "Heavy"
+	heavy.Metadata = map[string]model.Value{
+		"thrust":   {Float64Value: 1500.5},
+		"material": {StringValue: "titanium"},
+		"reusable": {BoolValue: true},
+	}
+	light := newPart()
+	light.Name = "Light"
+	light.Metadata = map[string]model.Value{
+		"thrust":   {Float64Value: 300},
+		"reusable": {BoolValue: false},
+	}
+	_, err := s.repository.BatchCreateParts(context.Background(), []model.Part{*heavy, *light})
+	s.Require().NoError(err)
+
+	thrustAbove := func(v float64) model.MetadataPredicate {
+		return model.MetadataPredicate{Key: "thrust", Operator: model.MetadataGt, Kind: model.ValueKindFloat64, Value: model.Value{Float64Value: v}}
+	}
+
+	assert.ElementsMatch(s.T(), []string{"Heavy"}, s.listNames(&model.Filter{Metadata: []model.MetadataPredicate{thrustAbove(1000)}}))
+	assert.ElementsMatch(s.T(), []string{"Heavy", "Light"}, s.listNames(&model.Filter{Metadata: []model.MetadataPredicate{thrustAbove(0)}}))
+
+	// Незаполненное значение считается нулевым значением своего типа
+	notReusable := model.MetadataPredicate{Key: "reusable", Operator: model.MetadataEq, Kind: model.ValueKindBool}
+	assert.ElementsMatch(s.T(), []string{"Light"}, s.listNames(&model.Filter{Metadata: []model.MetadataPredicate{notReusable}}))
+
+	hasMaterial := model.MetadataPredicate{Key: "material", Operator: model.MetadataExists}
+	assert.ElementsMatch(s.T(), []string{"Heavy"}, s.listNames(&model.Filter{Metadata: []model.MetadataPredicate{hasMaterial}}))
+
+	// Все условия должны выполняться одновременно
+	assert.Empty(s.T(), s.listNames(&model.Filter{Metadata: []model.MetadataPredicate{thrustAbove(1000), notReusable}}))
+}
@@ -19,7 +19,7 @@ func (s *InMemoryRepositorySuite) TestGetPart_Success() {
 	// Проверяем результат
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
-	assert.Equal(s.T(), testUUID, result.OrderUuid)
+	assert.Equal(s.T(), testUUID, result.PartUuid)
 	assert.Equal(s.T(), "Detail 1", result.Name)
 	assert.Equal(s.T(), "Detail 1 description", result.Description)
 	assert.Equal(s.T(), 100.0, result.Price)
@@ -66,7 +66,7 @@ func (s *InMemoryRepositorySuite) TestGetPart_ValidateTestData() {
 
 		assert.NoError(s.T(), err)
 		assert.NotNil(s.T(), result)
-		assert.Equal(s.T(), testUUID, result.OrderUuid)
+		assert.Equal(s.T(), testUUID, result.PartUuid)
 		assert.Equal(s.T(), expectedNames[i], result.Name)
 		assert.Equal(s.T(), expectedPrices[i], result.Price)
 		assert.Equal(s.T(), expectedQuantities[i], result.StockQuantity)
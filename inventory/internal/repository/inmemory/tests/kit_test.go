@@ -0,0 +1,49 @@
+package inmemory_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *InMemoryRepositorySuite) TestListCompatibility_ReadsEdgeFromBothSides() {
+	engine := uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834")
+	control := uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e835")
+
+	edges, err := s.repository.ListCompatibility(context.Background(), control)
+	s.Require().NoError(err)
+	s.Require().Len(edges, 2)
+
+	for _, edge := range edges {
+		assert.Equal(s.T(), control, edge.PartUuid)
+	}
+	assert.Equal(s.T(), engine, edges[0].CompatibleUuid)
+	assert.Equal(s.T(), "Штатная система управления двигателя", edges[0].Note)
+
+	edges, err = s.repository.ListCompatibility(context.Background(), uuid.New())
+	s.Require().NoError(err)
+	assert.Empty(s.T(), edges)
+}
+
+func (s *InMemoryRepositorySuite) TestListKits_SortedByName() {
+	kits, err := s.repository.ListKits(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(kits, 2)
+
+	assert.Equal(s.T(), "Двигательная установка", kits[0].Name)
+	assert.Equal(s.T(), "Первая ступень", kits[1].Name)
+	assert.Equal(s.T(), int64(9), kits[1].Items[0].Quantity)
+}
+
+func (s *InMemoryRepositorySuite) TestGetKit() {
+	kit, err := s.repository.GetKit(context.Background(), uuid.MustParse("5b0e6a84-2f4c-4d8e-9a51-7c3f1e9d2a10"))
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Двигательная установка", kit.Name)
+	assert.Len(s.T(), kit.Items, 2)
+
+	_, err = s.repository.GetKit(context.Background(), uuid.New())
+	assert.ErrorIs(s.T(), err, model.ErrKitNotFound)
+}
@@ -11,7 +11,7 @@ import (
 
 func (s *InMemoryRepositorySuite) TestListParts_Success() {
 	// Вызываем метод без фильтра
-	result, err := s.repository.ListParts(context.Background(), nil)
+	result, err := s.repository.ListParts(context.Background(), nil, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -34,14 +34,14 @@ func (s *InMemoryRepositorySuite) TestListParts_WithUUIDFilter() {
 		Uuids: []uuid.UUID{testUUID},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
 	assert.Len(s.T(), *result, 1)
 	assert.Equal(s.T(), "Detail 1", (*result)[0].Name)
-	assert.Equal(s.T(), testUUID, (*result)[0].OrderUuid)
+	assert.Equal(s.T(), testUUID, (*result)[0].PartUuid)
 }
 
 func (s *InMemoryRepositorySuite) TestListParts_WithNameFilter() {
@@ -50,7 +50,7 @@ func (s *InMemoryRepositorySuite) TestListParts_WithNameFilter() {
 		Names: []string{"Detail 2"},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -67,7 +67,7 @@ func (s *InMemoryRepositorySuite) TestListParts_WithCategoryFilter() {
 		Categories: []model.Category{model.ENGINE},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -85,7 +85,7 @@ func (s *InMemoryRepositorySuite) TestListParts_WithManufacturerCountryFilter()
 		ManufacturerCountries: []string{"China"},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -101,7 +101,7 @@ func (s *InMemoryRepositorySuite) TestListParts_WithTagsFilter() {
 		Tags: []string{"tag1"},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -120,7 +120,7 @@ func (s *InMemoryRepositorySuite) TestListParts_MultipleFilters() {
 		Tags:                  []string{"tag2"},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -137,7 +137,7 @@ func (s *InMemoryRepositorySuite) TestListParts_NoMatches() {
 		ManufacturerCountries: []string{"NonExistentCountry"},
 	}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
@@ -149,7 +149,7 @@ func (s *InMemoryRepositorySuite) TestListParts_EmptyFilter() {
 	// Тестируем пустой фильтр
 	filter := &model.Filter{}
 
-	result, err := s.repository.ListParts(context.Background(), filter)
+	result, err := s.repository.ListParts(context.Background(), filter, nil)
 
 	// Проверяем результат
 	assert.NoError(s.T(), err)
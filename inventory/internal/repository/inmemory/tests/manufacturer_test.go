@@ -0,0 +1,112 @@
+package inmemory_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func newManufacturer(name string) *model.Manufacturer {
+	now := time.Now().UTC()
+	return &model.Manufacturer{
+		ManufacturerUuid: uuid.New(),
+		Name:             name,
+		Country:          "USA",
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+func (s *InMemoryRepositorySuite) TestManufacturers_FromTestData() {
+	manufacturers, err := s.repository.ListManufacturers(context.Background(), nil)
+	s.Require().NoError(err)
+
+	names := make([]string, 0, len(manufacturers))
+	for _, manufacturer := range manufacturers {
+		names = append(names, manufacturer.Name)
+	}
+	assert.Equal(s.T(), []string{"Details Fabric", "Details Fabric America"}, names)
+
+	part, err := s.repository.GetPart(context.Background(), uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834"))
+	s.Require().NoError(err)
+	assert.Equal(s.T(), manufacturers[0].ManufacturerUuid, part.ManufacturerUuid)
+	assert.Equal(s.T(), manufacturers[0], part.Manufacturer)
+}
+
+func (s *InMemoryRepositorySuite) TestCreateManufacturer_NameIsUnique() {
+	_, err := s.repository.CreateManufacturer(context.Background(), newManufacturer("SpaceX"))
+	s.Require().NoError(err)
+
+	created, err := s.repository.CreateManufacturer(context.Background(), newManufacturer(" SPACEX "))
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerAlreadyExists)
+	assert.Nil(s.T(), created)
+
+	found, err := s.repository.FindManufacturerByName(context.Background(), "spacex")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "SpaceX", found.Name)
+}
+
+func (s *InMemoryRepositorySuite) TestListManufacturers_ByCountry() {
+	manufacturers, err := s.repository.ListManufacturers(context.Background(), &model.ManufacturerFilter{Countries: []string{"China"}})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), manufacturers, 1)
+	assert.Equal(s.T(), "Details Fabric", manufacturers[0].Name)
+}
+
+func (s *InMemoryRepositorySuite) TestUpdateManufacturer_VisibleInParts() {
+	manufacturer, err := s.repository.CreateManufacturer(context.Background(), newManufacturer("SpaceX"))
+	s.Require().NoError(err)
+
+	part := newPart()
+	part.ManufacturerUuid = manufacturer.ManufacturerUuid
+	_, err = s.repository.CreatePart(context.Background(), part)
+	s.Require().NoError(err)
+
+	manufacturer.Country = "Mars"
+	_, err = s.repository.UpdateManufacturer(context.Background(), manufacturer)
+	s.Require().NoError(err)
+
+	stored, err := s.repository.GetPart(context.Background(), part.PartUuid)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Mars", stored.Manufacturer.Country)
+
+	result, err := s.repository.ListParts(context.Background(), &model.Filter{ManufacturerCountries: []string{"Mars"}}, nil)
+	s.Require().NoError(err)
+	assert.Len(s.T(), *result, 1)
+}
+
+func (s *InMemoryRepositorySuite) TestUpdateManufacturer_NameTaken() {
+	manufacturer, err := s.repository.CreateManufacturer(context.Background(), newManufacturer("SpaceX"))
+	s.Require().NoError(err)
+
+	manufacturer.Name = "details fabric"
+	updated, err := s.repository.UpdateManufacturer(context.Background(), manufacturer)
+
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerAlreadyExists)
+	assert.Nil(s.T(), updated)
+}
+
+func (s *InMemoryRepositorySuite) TestDeleteManufacturer_InUse() {
+	manufacturer, err := s.repository.CreateManufacturer(context.Background(), newManufacturer("SpaceX"))
+	s.Require().NoError(err)
+
+	part := newPart()
+	part.ManufacturerUuid = manufacturer.ManufacturerUuid
+	_, err = s.repository.CreatePart(context.Background(), part)
+	s.Require().NoError(err)
+
+	err = s.repository.DeleteManufacturer(context.Background(), manufacturer.ManufacturerUuid)
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerInUse)
+
+	// Удалённая деталь ссылку не удерживает
+	s.Require().NoError(s.repository.DeletePart(context.Background(), part.PartUuid, time.Now()))
+	assert.NoError(s.T(), s.repository.DeleteManufacturer(context.Background(), manufacturer.ManufacturerUuid))
+
+	_, err = s.repository.GetManufacturer(context.Background(), manufacturer.ManufacturerUuid)
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerNotFound)
+}
@@ -0,0 +1,47 @@
+package inmemory_test
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *InMemoryRepositorySuite) TestListParts_SortAndKeyset() {
+	for i, name := range []string{"Gamma", "Alpha", "Beta"} {
+		part := newPart()
+		part.Name = name
+		part.Price = float64(1000 + i)
+		_, err := s.repository.CreatePart(context.Background(), part)
+		s.Require().NoError(err)
+	}
+
+	filter := &model.Filter{Names: []string{"Alpha", "Beta", "Gamma"}}
+	sort := model.Sort{Field: model.SortByName, Desc: true}
+
+	var names []string
+	query := &model.ListQuery{Limit: 2, Sort: sort}
+	for {
+		page, err := s.repository.ListParts(context.Background(), filter, query)
+		s.Require().NoError(err)
+		if len(*page) == 0 {
+			break
+		}
+
+		names = append(names, lo.Map(*page, func(p model.Part, _ int) string { return p.Name })...)
+		query.After = model.NewPageCursor(&(*page)[len(*page)-1], sort)
+	}
+
+	assert.Equal(s.T(), []string{"Gamma", "Beta", "Alpha"}, names)
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_SortByPrice() {
+	result, err := s.repository.ListParts(context.Background(), nil, &model.ListQuery{
+		Sort: model.Sort{Field: model.SortByPrice},
+	})
+	s.Require().NoError(err)
+
+	assert.IsNonDecreasing(s.T(), lo.Map(*result, func(p model.Part, _ int) float64 { return p.Price }))
+}
@@ -0,0 +1,89 @@
+package inmemory_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *InMemoryRepositorySuite) TestPriceHistory_InitialPrice() {
+	part, err := s.repository.GetPart(context.Background(), detail1)
+	assert.NoError(s.T(), err)
+
+	history, err := s.repository.GetPriceHistory(context.Background(), detail1)
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), history, 1) {
+		assert.Equal(s.T(), part.Price, history[0].Price)
+		assert.Nil(s.T(), history[0].EffectiveTo)
+	}
+}
+
+func (s *InMemoryRepositorySuite) TestSchedulePrice_PricesAt() {
+	ctx := context.Background()
+	part, err := s.repository.GetPart(ctx, detail1)
+	assert.NoError(s.T(), err)
+
+	from := time.Now().UTC().Add(time.Hour).Truncate(time.Millisecond)
+	history, err := s.repository.SchedulePrice(ctx, detail1, 999, from)
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), history, 2) {
+		assert.Equal(s.T(), from, *history[0].EffectiveTo)
+		assert.Equal(s.T(), from, history[1].EffectiveFrom)
+	}
+
+	unknown := uuid.New()
+	prices, err := s.repository.GetPricesAt(ctx, []uuid.UUID{detail1, unknown}, from.Add(-time.Millisecond))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), map[uuid.UUID]float64{detail1: part.Price}, prices)
+
+	prices, err = s.repository.GetPricesAt(ctx, []uuid.UUID{detail1}, from)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), map[uuid.UUID]float64{detail1: 999}, prices)
+}
+
+func (s *InMemoryRepositorySuite) TestSyncCurrentPrices() {
+	ctx := context.Background()
+	from := time.Now().UTC().Add(time.Hour)
+
+	_, err := s.repository.SchedulePrice(ctx, detail1, 999, from)
+	assert.NoError(s.T(), err)
+
+	// До наступления запланированного момента цена детали не меняется
+	updated, err := s.repository.SyncCurrentPrices(ctx, from.Add(-time.Second))
+	assert.NoError(s.T(), err)
+	assert.Zero(s.T(), updated)
+
+	updated, err = s.repository.SyncCurrentPrices(ctx, from.Add(time.Second))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), updated)
+
+	part, err := s.repository.GetPart(ctx, detail1)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 999.0, part.Price)
+
+	// Повторная синхронизация ничего не меняет
+	updated, err = s.repository.SyncCurrentPrices(ctx, from.Add(2*time.Second))
+	assert.NoError(s.T(), err)
+	assert.Zero(s.T(), updated)
+}
+
+func (s *InMemoryRepositorySuite) TestCreatePart_InitialPrice() {
+	part := &model.Part{
+		PartUuid:  uuid.New(),
+		Name:      "New Part",
+		Price:     42,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	_, err := s.repository.CreatePart(context.Background(), part)
+	assert.NoError(s.T(), err)
+
+	prices, err := s.repository.GetPricesAt(context.Background(), []uuid.UUID{part.PartUuid}, part.CreatedAt)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 42.0, prices[part.PartUuid])
+}
@@ -0,0 +1,64 @@
+package inmemory_test
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+)
+
+func (s *InMemoryRepositorySuite) TestSearchParts_Relevance() {
+	byName := newPart()
+	byName.Name = "Кислородный насос"
+	byDescription := newPart()
+	byDescription.Name = "Насос"
+	byDescription.Description = "Подаёт окислитель из кислородного бака"
+	for _, part := range []*model.Part{byName, byDescription} {
+		_, err := s.repository.CreatePart(context.Background(), part)
+		s.Require().NoError(err)
+	}
+
+	hits, err := s.repository.SearchParts(context.Background(), &model.SearchQuery{
+		Terms: search.Terms("кислородные"),
+	})
+	s.Require().NoError(err)
+
+	// Совпадение в названии весит больше, чем в описании
+	s.Require().Len(*hits, 2)
+	assert.Equal(s.T(), byName.PartUuid, (*hits)[0].Part.PartUuid)
+	assert.Equal(s.T(), byDescription.PartUuid, (*hits)[1].Part.PartUuid)
+	assert.Greater(s.T(), (*hits)[0].Score, (*hits)[1].Score)
+}
+
+func (s *InMemoryRepositorySuite) TestSearchParts_FilterAndPage() {
+	for range 3 {
+		part := newPart()
+		part.Name = "Wing panel"
+		_, err := s.repository.CreatePart(context.Background(), part)
+		s.Require().NoError(err)
+	}
+
+	query := &model.SearchQuery{
+		Terms:  search.Terms("wings"),
+		Filter: &model.Filter{Categories: []model.Category{model.WING}},
+		Limit:  2,
+	}
+	first, err := s.repository.SearchParts(context.Background(), query)
+	s.Require().NoError(err)
+
+	query.Offset = 2
+	second, err := s.repository.SearchParts(context.Background(), query)
+	s.Require().NoError(err)
+
+	ids := lo.Map(append(*first, *second...), func(hit model.SearchHit, _ int) string { return hit.Part.PartUuid.String() })
+	assert.Len(s.T(), lo.Uniq(ids), 3)
+
+	query.Filter = &model.Filter{Categories: []model.Category{model.ENGINE}}
+	query.Offset = 0
+	none, err := s.repository.SearchParts(context.Background(), query)
+	s.Require().NoError(err)
+	assert.Empty(s.T(), *none)
+}
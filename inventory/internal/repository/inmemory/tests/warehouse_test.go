@@ -0,0 +1,126 @@
+package inmemory_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// detail1 — деталь фикстуры с остатками на складах BAIKONUR (6) и VOSTOCHNY (4)
+var detail1 = uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834")
+
+func (s *InMemoryRepositorySuite) TestListWarehouses() {
+	warehouses, err := s.repository.ListWarehouses(context.Background())
+
+	assert.NoError(s.T(), err)
+	codes := make([]string, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		codes = append(codes, warehouse.Code)
+	}
+	assert.Equal(s.T(), []string{"BAIKONUR", "PLESETSK", "VOSTOCHNY"}, codes)
+}
+
+func (s *InMemoryRepositorySuite) TestTransferStock_Success() {
+	transfer := &model.StockTransfer{
+		TransferUuid:  uuid.New(),
+		PartUuid:      detail1,
+		FromWarehouse: "BAIKONUR",
+		ToWarehouse:   "PLESETSK",
+		Quantity:      2,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	part, err := s.repository.TransferStock(context.Background(), transfer)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(10), part.StockQuantity)
+
+	stored, err := s.repository.GetPart(context.Background(), detail1)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []model.StockLevel{
+		{Warehouse: "BAIKONUR", Quantity: 4},
+		{Warehouse: "VOSTOCHNY", Quantity: 4},
+		{Warehouse: "PLESETSK", Quantity: 2},
+	}, stored.Stock)
+	assert.Equal(s.T(), transfer.CreatedAt, stored.UpdatedAt)
+}
+
+func (s *InMemoryRepositorySuite) TestTransferStock_Insufficient() {
+	part, err := s.repository.TransferStock(context.Background(), &model.StockTransfer{
+		PartUuid:      detail1,
+		FromWarehouse: "VOSTOCHNY",
+		ToWarehouse:   "BAIKONUR",
+		Quantity:      5,
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInsufficientStock)
+	assert.Nil(s.T(), part)
+
+	stored, err := s.repository.GetPart(context.Background(), detail1)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(10), stored.StockQuantity)
+}
+
+func (s *InMemoryRepositorySuite) TestListParts_FilterByWarehouse() {
+	minStock := int64(5)
+
+	tests := []struct {
+		name   string
+		filter *model.Filter
+		want   int
+	}{
+		{"stock at warehouse", &model.Filter{Warehouses: []string{"VOSTOCHNY"}}, 1},
+		{"no stock at warehouse", &model.Filter{Warehouses: []string{"PLESETSK"}}, 0},
+		{"min stock per warehouse", &model.Filter{Warehouses: []string{"VOSTOCHNY"}, MinStock: &minStock}, 0},
+		{"any of warehouses", &model.Filter{Warehouses: []string{"VOSTOCHNY", "BAIKONUR"}, MinStock: &minStock}, 1},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			parts, err := s.repository.ListParts(context.Background(), tt.filter, nil)
+			assert.NoError(s.T(), err)
+			assert.Len(s.T(), *parts, tt.want)
+		})
+	}
+}
+
+func (s *InMemoryRepositorySuite) TestUpdatePart_ConflictsWithTransferStock() {
+	ctx := context.Background()
+
+	read, err := s.repository.GetPart(ctx, detail1)
+	s.Require().NoError(err)
+
+	_, err = s.repository.TransferStock(ctx, &model.StockTransfer{
+		TransferUuid:  uuid.New(),
+		PartUuid:      detail1,
+		FromWarehouse: "BAIKONUR",
+		ToWarehouse:   "PLESETSK",
+		Quantity:      2,
+		CreatedAt:     time.Now().UTC(),
+	})
+	s.Require().NoError(err)
+
+	// Обновление по снимку до перемещения вернуло бы остатки назад
+	stale := *read
+	stale.Name = "Renamed"
+	stale.UpdatedAt = time.Now().UTC()
+	_, err = s.repository.UpdatePart(ctx, &stale, read.UpdatedAt)
+	s.Require().ErrorIs(err, model.ErrPartConflict)
+
+	stored, err := s.repository.GetPart(ctx, detail1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), read.Name, stored.Name)
+	assert.Contains(s.T(), stored.Stock, model.StockLevel{Warehouse: "PLESETSK", Quantity: 2})
+
+	// После повторного чтения обновление проходит и сохраняет перемещение
+	fresh := *stored
+	fresh.Name = "Renamed"
+	fresh.UpdatedAt = time.Now().UTC()
+	updated, err := s.repository.UpdatePart(ctx, &fresh, stored.UpdatedAt)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Renamed", updated.Name)
+	assert.Equal(s.T(), stored.Stock, updated.Stock)
+}
@@ -0,0 +1,95 @@
+package inmemory_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+var errStopWatch = errors.New("stop watch")
+
+// watch подписывается на изменения, выполняет action и собирает count событий
+func (s *InMemoryRepositorySuite) watch(resumeToken string, count int, action func()) ([]model.PartEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var events []model.PartEvent
+	err := s.repository.WatchParts(ctx, &model.WatchRequest{
+		ResumeToken: resumeToken,
+		Subscribed: func() {
+			go action()
+		},
+		Handle: func(event model.PartEvent) error {
+			events = append(events, event)
+			if len(events) == count {
+				return errStopWatch
+			}
+			return nil
+		},
+	})
+	if errors.Is(err, errStopWatch) {
+		err = nil
+	}
+
+	return events, err
+}
+
+func (s *InMemoryRepositorySuite) TestWatchParts_Events() {
+	part := newPart()
+
+	events, err := s.watch("", 3, func() {
+		ctx := context.Background()
+		_, _ = s.repository.CreatePart(ctx, part)
+		updated := *part
+		updated.Price = 42
+		_, _ = s.repository.UpdatePart(ctx, &updated, part.UpdatedAt)
+		_ = s.repository.DeletePart(ctx, part.PartUuid, time.Now())
+	})
+	s.Require().NoError(err)
+	s.Require().Len(events, 3)
+
+	assert.Equal(s.T(), model.PartCreated, events[0].Type)
+	assert.Equal(s.T(), model.PartUpdated, events[1].Type)
+	assert.Equal(s.T(), 42.0, events[1].Part.Price)
+	assert.Equal(s.T(), model.PartDeleted, events[2].Type)
+	assert.NotNil(s.T(), events[2].Part.DeletedAt)
+
+	// Подписка с токеном первого события получает только последующие
+	resumed, err := s.watch(events[0].ResumeToken, 2, func() {})
+	s.Require().NoError(err)
+	s.Require().Len(resumed, 2)
+	assert.Equal(s.T(), events[1:], resumed)
+}
+
+func (s *InMemoryRepositorySuite) TestWatchParts_InvalidResumeToken() {
+	for _, token := range []string{"not a number", "100500"} {
+		_, err := s.watch(token, 1, func() {})
+		assert.ErrorIs(s.T(), err, model.ErrInvalidResumeToken)
+	}
+}
+
+func (s *InMemoryRepositorySuite) TestWatchParts_Progress() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.repository.CreatePart(ctx, newPart())
+	s.Require().NoError(err)
+
+	// Без новых изменений подписчик получает токен текущей позиции
+	var progress model.PartEvent
+	err = s.repository.WatchParts(ctx, &model.WatchRequest{
+		ProgressInterval: 10 * time.Millisecond,
+		Handle: func(event model.PartEvent) error {
+			progress = event
+			return errStopWatch
+		},
+	})
+	s.Require().ErrorIs(err, errStopWatch)
+
+	assert.Equal(s.T(), model.PartProgress, progress.Type)
+	assert.Equal(s.T(), "1", progress.ResumeToken)
+}
@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) UpdatePart(ctx context.Context, part *model.Part, readUpdatedAt time.Time) (*model.Part, error) {
+	repoPart := repoConverter.ToRepositoryPart(part)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.data[repoPart.PartUuid]
+	if !ok || existing.DeletedAt != nil {
+		return nil, model.ErrPartNotFound
+	}
+	if !existing.UpdatedAt.Equal(readUpdatedAt) {
+		return nil, model.ErrPartConflict
+	}
+
+	// Дата создания и вложения не меняются при обновлении
+	repoPart.CreatedAt = existing.CreatedAt
+	repoPart.Attachments = existing.Attachments
+	r.data[repoPart.PartUuid] = repoPart
+	r.publish(model.PartUpdated, repoPart)
+
+	return r.toModelPart(repoPart)
+}
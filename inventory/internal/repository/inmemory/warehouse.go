@@ -0,0 +1,47 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+)
+
+func (r *repository) ListWarehouses(_ context.Context) ([]model.Warehouse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	warehouses := make([]model.Warehouse, 0, len(r.warehouses))
+	for _, warehouse := range r.warehouses {
+		warehouses = append(warehouses, repoConverter.ToModelWarehouse(&warehouse))
+	}
+
+	return warehouses, nil
+}
+
+func (r *repository) TransferStock(_ context.Context, transfer *model.StockTransfer) (*model.Part, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.data[transfer.PartUuid.String()]
+	if !ok || existing.DeletedAt != nil {
+		return nil, model.ErrPartNotFound
+	}
+
+	part, err := r.toModelPart(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = part.Transfer(transfer.FromWarehouse, transfer.ToWarehouse, transfer.Quantity); err != nil {
+		return nil, err
+	}
+	part.UpdatedAt = transfer.CreatedAt
+
+	repoPart := repoConverter.ToRepositoryPart(part)
+	r.data[repoPart.PartUuid] = repoPart
+	r.transfers = append(r.transfers, repoConverter.ToRepositoryStockTransfer(transfer))
+	r.publish(model.PartUpdated, repoPart)
+
+	return part, nil
+}
@@ -0,0 +1,140 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// eventHistorySize — сколько последних событий хранится для возобновления подписки
+const eventHistorySize = 1000
+
+// broker — pub/sub изменений деталей. Последние события хранятся в истории,
+// поэтому подписчик с resume token получает всё, что пропустил
+type broker struct {
+	mu          sync.Mutex
+	seq         uint64
+	history     []model.PartEvent
+	subscribers map[chan struct{}]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{subscribers: make(map[chan struct{}]struct{})}
+}
+
+// publish сохраняет событие в истории и будит подписчиков
+func (b *broker) publish(eventType model.PartEventType, part model.Part) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	b.history = append(b.history, model.PartEvent{
+		Type:        eventType,
+		Part:        part,
+		ResumeToken: strconv.FormatUint(b.seq, 10),
+		OccurredAt:  time.Now().UTC(),
+	})
+	if len(b.history) > eventHistorySize {
+		b.history = b.history[len(b.history)-eventHistorySize:]
+	}
+
+	for notify := range b.subscribers {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// since возвращает события после seq. Если часть из них уже вытеснена из истории,
+// продолжить подписку без пропусков нельзя
+func (b *broker) since(seq uint64) ([]model.PartEvent, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if seq >= b.seq {
+		return nil, nil
+	}
+
+	missed := int(b.seq - seq)
+	if missed > len(b.history) {
+		return nil, model.ErrResumeTokenExpired
+	}
+
+	return append([]model.PartEvent(nil), b.history[len(b.history)-missed:]...), nil
+}
+
+func (b *broker) subscribe() (chan struct{}, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	notify := make(chan struct{}, 1)
+	b.subscribers[notify] = struct{}{}
+
+	return notify, b.seq
+}
+
+func (b *broker) unsubscribe(notify chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers, notify)
+}
+
+func (r *repository) WatchParts(ctx context.Context, req *model.WatchRequest) error {
+	notify, seq := r.events.subscribe()
+	defer r.events.unsubscribe(notify)
+
+	if req.ResumeToken != "" {
+		resumeSeq, err := strconv.ParseUint(req.ResumeToken, 10, 64)
+		if err != nil || resumeSeq > seq {
+			return fmt.Errorf("%w: %q", model.ErrInvalidResumeToken, req.ResumeToken)
+		}
+		seq = resumeSeq
+	}
+
+	if req.Subscribed != nil {
+		req.Subscribed()
+	}
+
+	var progress <-chan time.Time
+	if req.ProgressInterval > 0 {
+		ticker := time.NewTicker(req.ProgressInterval)
+		defer ticker.Stop()
+		progress = ticker.C
+	}
+
+	for {
+		events, err := r.events.since(seq)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err = req.Handle(event); err != nil {
+				return err
+			}
+		}
+		seq += uint64(len(events))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		case <-progress:
+			// Новых событий нет: подписчик получает токен текущей позиции
+			err = req.Handle(model.PartEvent{
+				Type:        model.PartProgress,
+				ResumeToken: strconv.FormatUint(seq, 10),
+				OccurredAt:  time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -10,6 +10,8 @@ import (
 	model "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -26,29 +28,29 @@ func (_m *InventoryRepository) EXPECT() *InventoryRepository_Expecter {
 	return &InventoryRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetPart provides a mock function with given fields: ctx, _a1
-func (_m *InventoryRepository) GetPart(ctx context.Context, _a1 uuid.UUID) (*model.Part, error) {
-	ret := _m.Called(ctx, _a1)
+// AddAttachment provides a mock function with given fields: ctx, partUuid, attachment
+func (_m *InventoryRepository) AddAttachment(ctx context.Context, partUuid uuid.UUID, attachment *model.Attachment) (*model.Part, error) {
+	ret := _m.Called(ctx, partUuid, attachment)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPart")
+		panic("no return value specified for AddAttachment")
 	}
 
 	var r0 *model.Part
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Part, error)); ok {
-		return rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.Attachment) (*model.Part, error)); ok {
+		return rf(ctx, partUuid, attachment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Part); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *model.Attachment) *model.Part); ok {
+		r0 = rf(ctx, partUuid, attachment)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Part)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *model.Attachment) error); ok {
+		r1 = rf(ctx, partUuid, attachment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,58 +58,59 @@ func (_m *InventoryRepository) GetPart(ctx context.Context, _a1 uuid.UUID) (*mod
 	return r0, r1
 }
 
-// InventoryRepository_GetPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPart'
-type InventoryRepository_GetPart_Call struct {
+// InventoryRepository_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type InventoryRepository_AddAttachment_Call struct {
 	*mock.Call
 }
 
-// GetPart is a helper method to define mock.On call
+// AddAttachment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 uuid.UUID
-func (_e *InventoryRepository_Expecter) GetPart(ctx interface{}, _a1 interface{}) *InventoryRepository_GetPart_Call {
-	return &InventoryRepository_GetPart_Call{Call: _e.mock.On("GetPart", ctx, _a1)}
+//   - partUuid uuid.UUID
+//   - attachment *model.Attachment
+func (_e *InventoryRepository_Expecter) AddAttachment(ctx interface{}, partUuid interface{}, attachment interface{}) *InventoryRepository_AddAttachment_Call {
+	return &InventoryRepository_AddAttachment_Call{Call: _e.mock.On("AddAttachment", ctx, partUuid, attachment)}
 }
 
-func (_c *InventoryRepository_GetPart_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryRepository_GetPart_Call {
+func (_c *InventoryRepository_AddAttachment_Call) Run(run func(ctx context.Context, partUuid uuid.UUID, attachment *model.Attachment)) *InventoryRepository_AddAttachment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*model.Attachment))
 	})
 	return _c
 }
 
-func (_c *InventoryRepository_GetPart_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_GetPart_Call {
+func (_c *InventoryRepository_AddAttachment_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_AddAttachment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryRepository_GetPart_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Part, error)) *InventoryRepository_GetPart_Call {
+func (_c *InventoryRepository_AddAttachment_Call) RunAndReturn(run func(context.Context, uuid.UUID, *model.Attachment) (*model.Part, error)) *InventoryRepository_AddAttachment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *InventoryRepository) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// BatchCreateParts provides a mock function with given fields: ctx, parts
+func (_m *InventoryRepository) BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error) {
+	ret := _m.Called(ctx, parts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParts")
+		panic("no return value specified for BatchCreateParts")
 	}
 
 	var r0 *[]model.Part
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter) (*[]model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Part) (*[]model.Part, error)); ok {
+		return rf(ctx, parts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter) *[]model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Part) *[]model.Part); ok {
+		r0 = rf(ctx, parts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]model.Part)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Filter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, []model.Part) error); ok {
+		r1 = rf(ctx, parts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -115,31 +118,1295 @@ func (_m *InventoryRepository) ListParts(ctx context.Context, filter *model.Filt
 	return r0, r1
 }
 
-// InventoryRepository_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
-type InventoryRepository_ListParts_Call struct {
+// InventoryRepository_BatchCreateParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateParts'
+type InventoryRepository_BatchCreateParts_Call struct {
 	*mock.Call
 }
 
-// ListParts is a helper method to define mock.On call
+// BatchCreateParts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter *model.Filter
-func (_e *InventoryRepository_Expecter) ListParts(ctx interface{}, filter interface{}) *InventoryRepository_ListParts_Call {
-	return &InventoryRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - parts []model.Part
+func (_e *InventoryRepository_Expecter) BatchCreateParts(ctx interface{}, parts interface{}) *InventoryRepository_BatchCreateParts_Call {
+	return &InventoryRepository_BatchCreateParts_Call{Call: _e.mock.On("BatchCreateParts", ctx, parts)}
 }
 
-func (_c *InventoryRepository_ListParts_Call) Run(run func(ctx context.Context, filter *model.Filter)) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_BatchCreateParts_Call) Run(run func(ctx context.Context, parts []model.Part)) *InventoryRepository_BatchCreateParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Filter))
+		run(args[0].(context.Context), args[1].([]model.Part))
 	})
 	return _c
 }
 
-func (_c *InventoryRepository_ListParts_Call) Return(_a0 *[]model.Part, _a1 error) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_BatchCreateParts_Call) Return(_a0 *[]model.Part, _a1 error) *InventoryRepository_BatchCreateParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_BatchCreateParts_Call) RunAndReturn(run func(context.Context, []model.Part) (*[]model.Part, error)) *InventoryRepository_BatchCreateParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateManufacturer provides a mock function with given fields: ctx, manufacturer
+func (_m *InventoryRepository) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, manufacturer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManufacturer")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) (*model.Manufacturer, error)); ok {
+		return rf(ctx, manufacturer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) *model.Manufacturer); ok {
+		r0 = rf(ctx, manufacturer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Manufacturer) error); ok {
+		r1 = rf(ctx, manufacturer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CreateManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManufacturer'
+type InventoryRepository_CreateManufacturer_Call struct {
+	*mock.Call
+}
+
+// CreateManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manufacturer *model.Manufacturer
+func (_e *InventoryRepository_Expecter) CreateManufacturer(ctx interface{}, manufacturer interface{}) *InventoryRepository_CreateManufacturer_Call {
+	return &InventoryRepository_CreateManufacturer_Call{Call: _e.mock.On("CreateManufacturer", ctx, manufacturer)}
+}
+
+func (_c *InventoryRepository_CreateManufacturer_Call) Run(run func(ctx context.Context, manufacturer *model.Manufacturer)) *InventoryRepository_CreateManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Manufacturer))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CreateManufacturer_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryRepository_CreateManufacturer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CreateManufacturer_Call) RunAndReturn(run func(context.Context, *model.Manufacturer) (*model.Manufacturer, error)) *InventoryRepository_CreateManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *InventoryRepository) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type InventoryRepository_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *InventoryRepository_Expecter) CreatePart(ctx interface{}, part interface{}) *InventoryRepository_CreatePart_Call {
+	return &InventoryRepository_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *InventoryRepository_CreatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *InventoryRepository_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CreatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CreatePart_Call) RunAndReturn(run func(context.Context, *model.Part) (*model.Part, error)) *InventoryRepository_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteManufacturer provides a mock function with given fields: ctx, _a1
+func (_m *InventoryRepository) DeleteManufacturer(ctx context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteManufacturer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_DeleteManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteManufacturer'
+type InventoryRepository_DeleteManufacturer_Call struct {
+	*mock.Call
+}
+
+// DeleteManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryRepository_Expecter) DeleteManufacturer(ctx interface{}, _a1 interface{}) *InventoryRepository_DeleteManufacturer_Call {
+	return &InventoryRepository_DeleteManufacturer_Call{Call: _e.mock.On("DeleteManufacturer", ctx, _a1)}
+}
+
+func (_c *InventoryRepository_DeleteManufacturer_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryRepository_DeleteManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_DeleteManufacturer_Call) Return(_a0 error) *InventoryRepository_DeleteManufacturer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_DeleteManufacturer_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InventoryRepository_DeleteManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, _a1, deletedAt
+func (_m *InventoryRepository) DeletePart(ctx context.Context, _a1 uuid.UUID, deletedAt time.Time) error {
+	ret := _m.Called(ctx, _a1, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, _a1, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type InventoryRepository_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+//   - deletedAt time.Time
+func (_e *InventoryRepository_Expecter) DeletePart(ctx interface{}, _a1 interface{}, deletedAt interface{}) *InventoryRepository_DeletePart_Call {
+	return &InventoryRepository_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, _a1, deletedAt)}
+}
+
+func (_c *InventoryRepository_DeletePart_Call) Run(run func(ctx context.Context, _a1 uuid.UUID, deletedAt time.Time)) *InventoryRepository_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_DeletePart_Call) Return(_a0 error) *InventoryRepository_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_DeletePart_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *InventoryRepository_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindManufacturerByName provides a mock function with given fields: ctx, name
+func (_m *InventoryRepository) FindManufacturerByName(ctx context.Context, name string) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindManufacturerByName")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Manufacturer, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Manufacturer); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_FindManufacturerByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindManufacturerByName'
+type InventoryRepository_FindManufacturerByName_Call struct {
+	*mock.Call
+}
+
+// FindManufacturerByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *InventoryRepository_Expecter) FindManufacturerByName(ctx interface{}, name interface{}) *InventoryRepository_FindManufacturerByName_Call {
+	return &InventoryRepository_FindManufacturerByName_Call{Call: _e.mock.On("FindManufacturerByName", ctx, name)}
+}
+
+func (_c *InventoryRepository_FindManufacturerByName_Call) Run(run func(ctx context.Context, name string)) *InventoryRepository_FindManufacturerByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_FindManufacturerByName_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryRepository_FindManufacturerByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_FindManufacturerByName_Call) RunAndReturn(run func(context.Context, string) (*model.Manufacturer, error)) *InventoryRepository_FindManufacturerByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKit provides a mock function with given fields: ctx, _a1
+func (_m *InventoryRepository) GetKit(ctx context.Context, _a1 uuid.UUID) (*model.Kit, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKit")
+	}
+
+	var r0 *model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Kit, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Kit); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_GetKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKit'
+type InventoryRepository_GetKit_Call struct {
+	*mock.Call
+}
+
+// GetKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryRepository_Expecter) GetKit(ctx interface{}, _a1 interface{}) *InventoryRepository_GetKit_Call {
+	return &InventoryRepository_GetKit_Call{Call: _e.mock.On("GetKit", ctx, _a1)}
+}
+
+func (_c *InventoryRepository_GetKit_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryRepository_GetKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_GetKit_Call) Return(_a0 *model.Kit, _a1 error) *InventoryRepository_GetKit_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.Filter) (*[]model.Part, error)) *InventoryRepository_ListParts_Call {
+func (_c *InventoryRepository_GetKit_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Kit, error)) *InventoryRepository_GetKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetManufacturer provides a mock function with given fields: ctx, _a1
+func (_m *InventoryRepository) GetManufacturer(ctx context.Context, _a1 uuid.UUID) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetManufacturer")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Manufacturer, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Manufacturer); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_GetManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetManufacturer'
+type InventoryRepository_GetManufacturer_Call struct {
+	*mock.Call
+}
+
+// GetManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryRepository_Expecter) GetManufacturer(ctx interface{}, _a1 interface{}) *InventoryRepository_GetManufacturer_Call {
+	return &InventoryRepository_GetManufacturer_Call{Call: _e.mock.On("GetManufacturer", ctx, _a1)}
+}
+
+func (_c *InventoryRepository_GetManufacturer_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryRepository_GetManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_GetManufacturer_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryRepository_GetManufacturer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_GetManufacturer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Manufacturer, error)) *InventoryRepository_GetManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPart provides a mock function with given fields: ctx, _a1
+func (_m *InventoryRepository) GetPart(ctx context.Context, _a1 uuid.UUID) (*model.Part, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Part, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Part); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_GetPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPart'
+type InventoryRepository_GetPart_Call struct {
+	*mock.Call
+}
+
+// GetPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryRepository_Expecter) GetPart(ctx interface{}, _a1 interface{}) *InventoryRepository_GetPart_Call {
+	return &InventoryRepository_GetPart_Call{Call: _e.mock.On("GetPart", ctx, _a1)}
+}
+
+func (_c *InventoryRepository_GetPart_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryRepository_GetPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_GetPart_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_GetPart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_GetPart_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Part, error)) *InventoryRepository_GetPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, partUuid
+func (_m *InventoryRepository) GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error) {
+	ret := _m.Called(ctx, partUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []model.PricePeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.PricePeriod, error)); ok {
+		return rf(ctx, partUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.PricePeriod); ok {
+		r0 = rf(ctx, partUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PricePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, partUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type InventoryRepository_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid uuid.UUID
+func (_e *InventoryRepository_Expecter) GetPriceHistory(ctx interface{}, partUuid interface{}) *InventoryRepository_GetPriceHistory_Call {
+	return &InventoryRepository_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", ctx, partUuid)}
+}
+
+func (_c *InventoryRepository_GetPriceHistory_Call) Run(run func(ctx context.Context, partUuid uuid.UUID)) *InventoryRepository_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_GetPriceHistory_Call) Return(_a0 []model.PricePeriod, _a1 error) *InventoryRepository_GetPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_GetPriceHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.PricePeriod, error)) *InventoryRepository_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPricesAt provides a mock function with given fields: ctx, partUuids, at
+func (_m *InventoryRepository) GetPricesAt(ctx context.Context, partUuids []uuid.UUID, at time.Time) (map[uuid.UUID]float64, error) {
+	ret := _m.Called(ctx, partUuids, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPricesAt")
+	}
+
+	var r0 map[uuid.UUID]float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, time.Time) (map[uuid.UUID]float64, error)); ok {
+		return rf(ctx, partUuids, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, time.Time) map[uuid.UUID]float64); ok {
+		r0 = rf(ctx, partUuids, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, partUuids, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_GetPricesAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPricesAt'
+type InventoryRepository_GetPricesAt_Call struct {
+	*mock.Call
+}
+
+// GetPricesAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuids []uuid.UUID
+//   - at time.Time
+func (_e *InventoryRepository_Expecter) GetPricesAt(ctx interface{}, partUuids interface{}, at interface{}) *InventoryRepository_GetPricesAt_Call {
+	return &InventoryRepository_GetPricesAt_Call{Call: _e.mock.On("GetPricesAt", ctx, partUuids, at)}
+}
+
+func (_c *InventoryRepository_GetPricesAt_Call) Run(run func(ctx context.Context, partUuids []uuid.UUID, at time.Time)) *InventoryRepository_GetPricesAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_GetPricesAt_Call) Return(_a0 map[uuid.UUID]float64, _a1 error) *InventoryRepository_GetPricesAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_GetPricesAt_Call) RunAndReturn(run func(context.Context, []uuid.UUID, time.Time) (map[uuid.UUID]float64, error)) *InventoryRepository_GetPricesAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCompatibility provides a mock function with given fields: ctx, partUuid
+func (_m *InventoryRepository) ListCompatibility(ctx context.Context, partUuid uuid.UUID) ([]model.Compatibility, error) {
+	ret := _m.Called(ctx, partUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCompatibility")
+	}
+
+	var r0 []model.Compatibility
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.Compatibility, error)); ok {
+		return rf(ctx, partUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.Compatibility); ok {
+		r0 = rf(ctx, partUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Compatibility)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, partUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListCompatibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompatibility'
+type InventoryRepository_ListCompatibility_Call struct {
+	*mock.Call
+}
+
+// ListCompatibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid uuid.UUID
+func (_e *InventoryRepository_Expecter) ListCompatibility(ctx interface{}, partUuid interface{}) *InventoryRepository_ListCompatibility_Call {
+	return &InventoryRepository_ListCompatibility_Call{Call: _e.mock.On("ListCompatibility", ctx, partUuid)}
+}
+
+func (_c *InventoryRepository_ListCompatibility_Call) Run(run func(ctx context.Context, partUuid uuid.UUID)) *InventoryRepository_ListCompatibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListCompatibility_Call) Return(_a0 []model.Compatibility, _a1 error) *InventoryRepository_ListCompatibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListCompatibility_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.Compatibility, error)) *InventoryRepository_ListCompatibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKits provides a mock function with given fields: ctx
+func (_m *InventoryRepository) ListKits(ctx context.Context) ([]model.Kit, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKits")
+	}
+
+	var r0 []model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Kit, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Kit); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListKits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKits'
+type InventoryRepository_ListKits_Call struct {
+	*mock.Call
+}
+
+// ListKits is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryRepository_Expecter) ListKits(ctx interface{}) *InventoryRepository_ListKits_Call {
+	return &InventoryRepository_ListKits_Call{Call: _e.mock.On("ListKits", ctx)}
+}
+
+func (_c *InventoryRepository_ListKits_Call) Run(run func(ctx context.Context)) *InventoryRepository_ListKits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListKits_Call) Return(_a0 []model.Kit, _a1 error) *InventoryRepository_ListKits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListKits_Call) RunAndReturn(run func(context.Context) ([]model.Kit, error)) *InventoryRepository_ListKits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListManufacturers provides a mock function with given fields: ctx, filter
+func (_m *InventoryRepository) ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListManufacturers")
+	}
+
+	var r0 []model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ManufacturerFilter) ([]model.Manufacturer, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ManufacturerFilter) []model.Manufacturer); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ManufacturerFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListManufacturers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListManufacturers'
+type InventoryRepository_ListManufacturers_Call struct {
+	*mock.Call
+}
+
+// ListManufacturers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.ManufacturerFilter
+func (_e *InventoryRepository_Expecter) ListManufacturers(ctx interface{}, filter interface{}) *InventoryRepository_ListManufacturers_Call {
+	return &InventoryRepository_ListManufacturers_Call{Call: _e.mock.On("ListManufacturers", ctx, filter)}
+}
+
+func (_c *InventoryRepository_ListManufacturers_Call) Run(run func(ctx context.Context, filter *model.ManufacturerFilter)) *InventoryRepository_ListManufacturers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ManufacturerFilter))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListManufacturers_Call) Return(_a0 []model.Manufacturer, _a1 error) *InventoryRepository_ListManufacturers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListManufacturers_Call) RunAndReturn(run func(context.Context, *model.ManufacturerFilter) ([]model.Manufacturer, error)) *InventoryRepository_ListManufacturers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParts provides a mock function with given fields: ctx, filter, query
+func (_m *InventoryRepository) ListParts(ctx context.Context, filter *model.Filter, query *model.ListQuery) (*[]model.Part, error) {
+	ret := _m.Called(ctx, filter, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParts")
+	}
+
+	var r0 *[]model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter, *model.ListQuery) (*[]model.Part, error)); ok {
+		return rf(ctx, filter, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter, *model.ListQuery) *[]model.Part); ok {
+		r0 = rf(ctx, filter, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Filter, *model.ListQuery) error); ok {
+		r1 = rf(ctx, filter, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
+type InventoryRepository_ListParts_Call struct {
+	*mock.Call
+}
+
+// ListParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.Filter
+//   - query *model.ListQuery
+func (_e *InventoryRepository_Expecter) ListParts(ctx interface{}, filter interface{}, query interface{}) *InventoryRepository_ListParts_Call {
+	return &InventoryRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, query)}
+}
+
+func (_c *InventoryRepository_ListParts_Call) Run(run func(ctx context.Context, filter *model.Filter, query *model.ListQuery)) *InventoryRepository_ListParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Filter), args[2].(*model.ListQuery))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListParts_Call) Return(_a0 *[]model.Part, _a1 error) *InventoryRepository_ListParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.Filter, *model.ListQuery) (*[]model.Part, error)) *InventoryRepository_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWarehouses provides a mock function with given fields: ctx
+func (_m *InventoryRepository) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWarehouses")
+	}
+
+	var r0 []model.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWarehouses'
+type InventoryRepository_ListWarehouses_Call struct {
+	*mock.Call
+}
+
+// ListWarehouses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryRepository_Expecter) ListWarehouses(ctx interface{}) *InventoryRepository_ListWarehouses_Call {
+	return &InventoryRepository_ListWarehouses_Call{Call: _e.mock.On("ListWarehouses", ctx)}
+}
+
+func (_c *InventoryRepository_ListWarehouses_Call) Run(run func(ctx context.Context)) *InventoryRepository_ListWarehouses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListWarehouses_Call) Return(_a0 []model.Warehouse, _a1 error) *InventoryRepository_ListWarehouses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListWarehouses_Call) RunAndReturn(run func(context.Context) ([]model.Warehouse, error)) *InventoryRepository_ListWarehouses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchedulePrice provides a mock function with given fields: ctx, partUuid, price, from
+func (_m *InventoryRepository) SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error) {
+	ret := _m.Called(ctx, partUuid, price, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulePrice")
+	}
+
+	var r0 []model.PricePeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64, time.Time) ([]model.PricePeriod, error)); ok {
+		return rf(ctx, partUuid, price, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64, time.Time) []model.PricePeriod); ok {
+		r0 = rf(ctx, partUuid, price, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PricePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, float64, time.Time) error); ok {
+		r1 = rf(ctx, partUuid, price, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_SchedulePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePrice'
+type InventoryRepository_SchedulePrice_Call struct {
+	*mock.Call
+}
+
+// SchedulePrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid uuid.UUID
+//   - price float64
+//   - from time.Time
+func (_e *InventoryRepository_Expecter) SchedulePrice(ctx interface{}, partUuid interface{}, price interface{}, from interface{}) *InventoryRepository_SchedulePrice_Call {
+	return &InventoryRepository_SchedulePrice_Call{Call: _e.mock.On("SchedulePrice", ctx, partUuid, price, from)}
+}
+
+func (_c *InventoryRepository_SchedulePrice_Call) Run(run func(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time)) *InventoryRepository_SchedulePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(float64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_SchedulePrice_Call) Return(_a0 []model.PricePeriod, _a1 error) *InventoryRepository_SchedulePrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_SchedulePrice_Call) RunAndReturn(run func(context.Context, uuid.UUID, float64, time.Time) ([]model.PricePeriod, error)) *InventoryRepository_SchedulePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, query
+func (_m *InventoryRepository) SearchParts(ctx context.Context, query *model.SearchQuery) (*[]model.SearchHit, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 *[]model.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SearchQuery) (*[]model.SearchHit, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SearchQuery) *[]model.SearchHit); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SearchQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type InventoryRepository_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *model.SearchQuery
+func (_e *InventoryRepository_Expecter) SearchParts(ctx interface{}, query interface{}) *InventoryRepository_SearchParts_Call {
+	return &InventoryRepository_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, query)}
+}
+
+func (_c *InventoryRepository_SearchParts_Call) Run(run func(ctx context.Context, query *model.SearchQuery)) *InventoryRepository_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SearchQuery))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_SearchParts_Call) Return(_a0 *[]model.SearchHit, _a1 error) *InventoryRepository_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_SearchParts_Call) RunAndReturn(run func(context.Context, *model.SearchQuery) (*[]model.SearchHit, error)) *InventoryRepository_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncCurrentPrices provides a mock function with given fields: ctx, now
+func (_m *InventoryRepository) SyncCurrentPrices(ctx context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncCurrentPrices")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_SyncCurrentPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncCurrentPrices'
+type InventoryRepository_SyncCurrentPrices_Call struct {
+	*mock.Call
+}
+
+// SyncCurrentPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *InventoryRepository_Expecter) SyncCurrentPrices(ctx interface{}, now interface{}) *InventoryRepository_SyncCurrentPrices_Call {
+	return &InventoryRepository_SyncCurrentPrices_Call{Call: _e.mock.On("SyncCurrentPrices", ctx, now)}
+}
+
+func (_c *InventoryRepository_SyncCurrentPrices_Call) Run(run func(ctx context.Context, now time.Time)) *InventoryRepository_SyncCurrentPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_SyncCurrentPrices_Call) Return(_a0 int64, _a1 error) *InventoryRepository_SyncCurrentPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_SyncCurrentPrices_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *InventoryRepository_SyncCurrentPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferStock provides a mock function with given fields: ctx, transfer
+func (_m *InventoryRepository) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error) {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferStock")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) (*model.Part, error)); ok {
+		return rf(ctx, transfer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) *model.Part); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockTransfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_TransferStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferStock'
+type InventoryRepository_TransferStock_Call struct {
+	*mock.Call
+}
+
+// TransferStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer *model.StockTransfer
+func (_e *InventoryRepository_Expecter) TransferStock(ctx interface{}, transfer interface{}) *InventoryRepository_TransferStock_Call {
+	return &InventoryRepository_TransferStock_Call{Call: _e.mock.On("TransferStock", ctx, transfer)}
+}
+
+func (_c *InventoryRepository_TransferStock_Call) Run(run func(ctx context.Context, transfer *model.StockTransfer)) *InventoryRepository_TransferStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockTransfer))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_TransferStock_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_TransferStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_TransferStock_Call) RunAndReturn(run func(context.Context, *model.StockTransfer) (*model.Part, error)) *InventoryRepository_TransferStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateManufacturer provides a mock function with given fields: ctx, manufacturer
+func (_m *InventoryRepository) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, manufacturer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManufacturer")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) (*model.Manufacturer, error)); ok {
+		return rf(ctx, manufacturer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) *model.Manufacturer); ok {
+		r0 = rf(ctx, manufacturer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Manufacturer) error); ok {
+		r1 = rf(ctx, manufacturer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_UpdateManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManufacturer'
+type InventoryRepository_UpdateManufacturer_Call struct {
+	*mock.Call
+}
+
+// UpdateManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manufacturer *model.Manufacturer
+func (_e *InventoryRepository_Expecter) UpdateManufacturer(ctx interface{}, manufacturer interface{}) *InventoryRepository_UpdateManufacturer_Call {
+	return &InventoryRepository_UpdateManufacturer_Call{Call: _e.mock.On("UpdateManufacturer", ctx, manufacturer)}
+}
+
+func (_c *InventoryRepository_UpdateManufacturer_Call) Run(run func(ctx context.Context, manufacturer *model.Manufacturer)) *InventoryRepository_UpdateManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Manufacturer))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_UpdateManufacturer_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryRepository_UpdateManufacturer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_UpdateManufacturer_Call) RunAndReturn(run func(context.Context, *model.Manufacturer) (*model.Manufacturer, error)) *InventoryRepository_UpdateManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, part, readUpdatedAt
+func (_m *InventoryRepository) UpdatePart(ctx context.Context, part *model.Part, readUpdatedAt time.Time) (*model.Part, error) {
+	ret := _m.Called(ctx, part, readUpdatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part, time.Time) (*model.Part, error)); ok {
+		return rf(ctx, part, readUpdatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part, time.Time) *model.Part); ok {
+		r0 = rf(ctx, part, readUpdatedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part, time.Time) error); ok {
+		r1 = rf(ctx, part, readUpdatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type InventoryRepository_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+//   - readUpdatedAt time.Time
+func (_e *InventoryRepository_Expecter) UpdatePart(ctx interface{}, part interface{}, readUpdatedAt interface{}) *InventoryRepository_UpdatePart_Call {
+	return &InventoryRepository_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, part, readUpdatedAt)}
+}
+
+func (_c *InventoryRepository_UpdatePart_Call) Run(run func(ctx context.Context, part *model.Part, readUpdatedAt time.Time)) *InventoryRepository_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_UpdatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryRepository_UpdatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_UpdatePart_Call) RunAndReturn(run func(context.Context, *model.Part, time.Time) (*model.Part, error)) *InventoryRepository_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchParts provides a mock function with given fields: ctx, req
+func (_m *InventoryRepository) WatchParts(ctx context.Context, req *model.WatchRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WatchRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_WatchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchParts'
+type InventoryRepository_WatchParts_Call struct {
+	*mock.Call
+}
+
+// WatchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.WatchRequest
+func (_e *InventoryRepository_Expecter) WatchParts(ctx interface{}, req interface{}) *InventoryRepository_WatchParts_Call {
+	return &InventoryRepository_WatchParts_Call{Call: _e.mock.On("WatchParts", ctx, req)}
+}
+
+func (_c *InventoryRepository_WatchParts_Call) Run(run func(ctx context.Context, req *model.WatchRequest)) *InventoryRepository_WatchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.WatchRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_WatchParts_Call) Return(_a0 error) *InventoryRepository_WatchParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_WatchParts_Call) RunAndReturn(run func(context.Context, *model.WatchRequest) error) *InventoryRepository_WatchParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
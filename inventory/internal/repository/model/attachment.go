@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type Attachment struct {
+	AttachmentUuid string    `bson:"attachment_uuid"`
+	FileName       string    `bson:"file_name"`
+	ContentType    string    `bson:"content_type"`
+	Kind           int       `bson:"kind"`
+	SizeBytes      int64     `bson:"size_bytes"`
+	ChecksumSha256 string    `bson:"checksum_sha256"`
+	CreatedAt      time.Time `bson:"created_at"`
+}
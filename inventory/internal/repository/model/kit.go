@@ -0,0 +1,20 @@
+package model
+
+// Compatibility — ребро графа совместимости. Пара деталей хранится одним документом,
+// поэтому ребро находится по любой из них через multikey-индекс
+type Compatibility struct {
+	PartUuids []string `bson:"part_uuids"`
+	Note      string   `bson:"note"`
+}
+
+type Kit struct {
+	KitUuid     string    `bson:"kit_uuid"`
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	Items       []KitItem `bson:"items"`
+}
+
+type KitItem struct {
+	PartUuid string `bson:"part_uuid"`
+	Quantity int64  `bson:"quantity"`
+}
@@ -1,7 +1,13 @@
 package model
 
+import "time"
+
 type Manufacturer struct {
-	Name    string `bson:"name"`
-	Country string `bson:"country"`
-	Website string `bson:"website"`
+	ManufacturerUuid string    `bson:"manufacturer_uuid"`
+	Name             string    `bson:"name"`
+	NameKey          string    `bson:"name_key"` // название без учёта регистра для уникального индекса
+	Country          string    `bson:"country"`
+	Website          string    `bson:"website"`
+	CreatedAt        time.Time `bson:"created_at"`
+	UpdatedAt        time.Time `bson:"updated_at"`
 }
@@ -5,16 +5,21 @@ import (
 )
 
 type RepositoryPart struct {
-	OrderUuid     string           `bson:"order_uuid"`
-	Name          string           `bson:"name"`
-	Description   string           `bson:"description"`
-	Price         float64          `bson:"price"`
-	StockQuantity int64            `bson:"stock_quantity"`
-	Category      int              `bson:"category"`
-	Dimensions    Dimensions       `bson:"dimensions"`
-	Manufacturer  Manufacturer     `bson:"manufacturer"`
-	Tags          []string         `bson:"tags"`
-	Metadata      map[string]Value `bson:"metadata"`
-	CreatedAt     time.Time        `bson:"created_at"`
-	UpdatedAt     time.Time        `bson:"updated_at"`
+	PartUuid         string           `bson:"part_uuid"`
+	Name             string           `bson:"name"`
+	Description      string           `bson:"description"`
+	Price            float64          `bson:"price"`
+	StockQuantity    int64            `bson:"stock_quantity"`
+	Stock            []StockLevel     `bson:"stock,omitempty"`
+	Category         int              `bson:"category"`
+	Dimensions       Dimensions       `bson:"dimensions"`
+	ManufacturerUuid string           `bson:"manufacturer_uuid,omitempty"`
+	Tags             []string         `bson:"tags"`
+	Metadata         map[string]Value `bson:"metadata"`
+	ReorderThreshold *int64           `bson:"reorder_threshold,omitempty"`
+	Attachments      []Attachment     `bson:"attachments,omitempty"`
+	CreatedAt        time.Time        `bson:"created_at"`
+	UpdatedAt        time.Time        `bson:"updated_at"`
+	DeletedAt        *time.Time       `bson:"deleted_at,omitempty"`
+	Search           *SearchText      `bson:"search,omitempty"`
 }
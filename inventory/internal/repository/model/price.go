@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type PricePeriod struct {
+	PartUuid      string     `bson:"part_uuid"`
+	Price         float64    `bson:"price"`
+	EffectiveFrom time.Time  `bson:"effective_from"`
+	EffectiveTo   *time.Time `bson:"effective_to"`
+}
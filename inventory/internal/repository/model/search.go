@@ -0,0 +1,10 @@
+package model
+
+// SearchText — основы слов полей детали для текстового индекса MongoDB.
+// Стемминг выполняется в приложении, поэтому индекс строится без языка
+type SearchText struct {
+	Name         string `bson:"name"`
+	Description  string `bson:"description"`
+	Tags         string `bson:"tags"`
+	Manufacturer string `bson:"manufacturer"`
+}
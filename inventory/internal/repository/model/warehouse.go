@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Warehouse struct {
+	Code     string `bson:"code"`
+	Name     string `bson:"name"`
+	Location string `bson:"location"`
+}
+
+type StockLevel struct {
+	Warehouse string `bson:"warehouse"`
+	Quantity  int64  `bson:"quantity"`
+}
+
+type StockTransfer struct {
+	TransferUuid  string    `bson:"transfer_uuid"`
+	PartUuid      string    `bson:"part_uuid"`
+	FromWarehouse string    `bson:"from_warehouse"`
+	ToWarehouse   string    `bson:"to_warehouse"`
+	Quantity      int64     `bson:"quantity"`
+	Reason        string    `bson:"reason"`
+	CreatedAt     time.Time `bson:"created_at"`
+}
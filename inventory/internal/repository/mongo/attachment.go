@@ -0,0 +1,49 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+// AddAttachment дописывает описание вложения в деталь. $push не трогает остальные поля,
+// поэтому параллельное обновление детали не теряет вложения и наоборот
+func (r *repository) AddAttachment(ctx context.Context, partUuid uuid.UUID, attachment *model.Attachment) (*model.Part, error) {
+	collection := r.db.Collection(partsCollection)
+
+	filter := bson.M{"part_uuid": partUuid.String(), "deleted_at": nil}
+	update := bson.M{
+		"$push": bson.M{"attachments": repoConverter.ToRepositoryAttachment(attachment)},
+		"$set":  bson.M{"updated_at": attachment.CreatedAt},
+	}
+
+	var updated repoModel.RepositoryPart
+	err := collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, err
+	}
+
+	part, err := repoConverter.ToModelPart(&updated)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.joinManufacturer(ctx, part); err != nil {
+		return nil, err
+	}
+
+	return part, nil
+}
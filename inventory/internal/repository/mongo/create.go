@@ -0,0 +1,95 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (r *repository) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	repoPart := repoConverter.ToRepositoryPart(part)
+	repoPart.Search = newSearchText(repoPart, part.Manufacturer.Name)
+
+	if err := r.insertParts(ctx, []model.Part{*part}, []*repoModel.RepositoryPart{repoPart}); err != nil {
+		return nil, err
+	}
+
+	created, err := repoConverter.ToModelPart(repoPart)
+	if err != nil {
+		return nil, err
+	}
+	// Производителя сервис уже прочитал из справочника
+	created.Manufacturer = part.Manufacturer
+
+	return created, nil
+}
+
+func (r *repository) BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error) {
+	repoParts := make([]*repoModel.RepositoryPart, 0, len(parts))
+	for _, part := range parts {
+		repoPart := repoConverter.ToRepositoryPart(&part)
+		repoPart.Search = newSearchText(repoPart, part.Manufacturer.Name)
+		repoParts = append(repoParts, repoPart)
+	}
+
+	if err := r.insertParts(ctx, parts, repoParts); err != nil {
+		return nil, err
+	}
+
+	created := make([]model.Part, 0, len(repoParts))
+	for i, repoPart := range repoParts {
+		part, err := repoConverter.ToModelPart(repoPart)
+		if err != nil {
+			return nil, err
+		}
+		part.Manufacturer = parts[i].Manufacturer
+		created = append(created, *part)
+	}
+
+	return &created, nil
+}
+
+// insertParts сохраняет детали вместе с их историей цен в одной транзакции: при конфликте,
+// в том числе с параллельной записью, не сохраняется ни одна деталь и ни одна цена
+func (r *repository) insertParts(ctx context.Context, parts []model.Part, repoParts []*repoModel.RepositoryPart) error {
+	uuids := make([]string, 0, len(repoParts))
+	documents := make([]interface{}, 0, len(repoParts))
+	for _, repoPart := range repoParts {
+		uuids = append(uuids, repoPart.PartUuid)
+		documents = append(documents, repoPart)
+	}
+
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		collection := r.db.Collection(partsCollection)
+
+		count, err := collection.CountDocuments(sessCtx, bson.M{"part_uuid": bson.M{"$in": uuids}})
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, model.ErrPartAlreadyExists
+		}
+
+		if _, err = collection.InsertMany(sessCtx, documents); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, model.ErrPartAlreadyExists
+			}
+			return nil, err
+		}
+
+		return nil, r.insertInitialPrices(sessCtx, parts)
+	})
+
+	return err
+}
@@ -0,0 +1,33 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (r *repository) DeletePart(ctx context.Context, uuid uuid.UUID, deletedAt time.Time) error {
+	collection := r.db.Collection(partsCollection)
+
+	// Soft delete: документ остаётся в коллекции, но больше не возвращается в выборках
+	filter := bson.M{"part_uuid": uuid.String(), "deleted_at": nil}
+	update := bson.M{"$set": bson.M{
+		"deleted_at": deletedAt,
+		"updated_at": deletedAt,
+	}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return nil
+}
@@ -16,7 +16,7 @@ import (
 func (r *repository) GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part, error) {
 	collection := r.db.Collection(partsCollection)
 
-	filter := bson.M{"order_uuid": uuid.String()}
+	filter := bson.M{"part_uuid": uuid.String(), "deleted_at": nil}
 
 	var repoPart repoModel.RepositoryPart
 	err := collection.FindOne(ctx, filter).Decode(&repoPart)
@@ -33,5 +33,9 @@ func (r *repository) GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part,
 		return nil, err
 	}
 
+	if err = r.joinManufacturer(ctx, part); err != nil {
+		return nil, err
+	}
+
 	return part, nil
 }
@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListCompatibility(ctx context.Context, partUuid uuid.UUID) ([]model.Compatibility, error) {
+	cursor, err := r.db.Collection(compatibilityCollection).Find(ctx, bson.M{"part_uuids": partUuid.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var repoEdges []repoModel.Compatibility
+	if err = cursor.All(ctx, &repoEdges); err != nil {
+		return nil, err
+	}
+
+	edges := make([]model.Compatibility, 0, len(repoEdges))
+	for _, repoEdge := range repoEdges {
+		edge, err := repoConverter.ToModelCompatibility(&repoEdge, partUuid)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
+
+func (r *repository) ListKits(ctx context.Context) ([]model.Kit, error) {
+	cursor, err := r.db.Collection(kitsCollection).Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "kit_uuid", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var repoKits []repoModel.Kit
+	if err = cursor.All(ctx, &repoKits); err != nil {
+		return nil, err
+	}
+
+	kits := make([]model.Kit, 0, len(repoKits))
+	for _, repoKit := range repoKits {
+		kit, err := repoConverter.ToModelKit(&repoKit)
+		if err != nil {
+			return nil, err
+		}
+		kits = append(kits, *kit)
+	}
+
+	return kits, nil
+}
+
+func (r *repository) GetKit(ctx context.Context, uuid uuid.UUID) (*model.Kit, error) {
+	var repoKit repoModel.Kit
+	err := r.db.Collection(kitsCollection).FindOne(ctx, bson.M{"kit_uuid": uuid.String()}).Decode(&repoKit)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrKitNotFound
+		}
+		return nil, err
+	}
+
+	return repoConverter.ToModelKit(&repoKit)
+}
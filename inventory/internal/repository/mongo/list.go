@@ -6,20 +6,31 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
 	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
 )
 
-func (r *repository) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
+func (r *repository) ListParts(ctx context.Context, filter *model.Filter, query *model.ListQuery) (*[]model.Part, error) {
 	collection := r.db.Collection(partsCollection)
 
+	if query == nil {
+		query = &model.ListQuery{}
+	}
+
 	// Создаем фильтр для MongoDB
-	mongoFilter := buildMongoFilter(filter)
+	mongoFilter, err := r.partsFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if query.After != nil {
+		addCondition(mongoFilter, buildKeysetCondition(query.Sort, query.After))
+	}
 
 	// Выполняем запрос
-	cursor, err := collection.Find(ctx, mongoFilter)
+	cursor, err := collection.Find(ctx, mongoFilter, buildFindOptions(query))
 	if err != nil {
 		return nil, err
 	}
@@ -46,24 +57,120 @@ func (r *repository) ListParts(ctx context.Context, filter *model.Filter) (*[]mo
 		parts = append(parts, *part)
 	}
 
+	if err = r.joinManufacturers(ctx, parts); err != nil {
+		return nil, err
+	}
+
 	return &parts, nil
 }
 
-// buildMongoFilter создание фильтра для MongoDB на основе модели фильтра
+// sortFields поля документа, по которым сортируется список деталей
+var sortFields = map[model.SortField]string{
+	model.SortByCreatedAt: "created_at",
+	model.SortByName:      "name",
+	model.SortByPrice:     "price",
+	model.SortByStock:     "stock_quantity",
+}
+
+// buildFindOptions задаёт сортировку, лимит и проекцию выборки
+func buildFindOptions(query *model.ListQuery) *options.FindOptions {
+	field := sortFields[query.Sort.Field]
+	direction := 1
+	if query.Sort.Desc {
+		direction = -1
+	}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: field, Value: direction},
+		{Key: "part_uuid", Value: direction},
+	})
+
+	if query.Limit > 0 {
+		opts.SetLimit(int64(query.Limit))
+	}
+
+	// part_uuid и поле сортировки нужны всегда: по ним строится курсор следующей страницы
+	if len(query.Fields) > 0 {
+		projection := bson.M{"part_uuid": 1, field: 1}
+		for _, name := range query.Fields {
+			// Производитель подставляется из справочника по ссылке
+			if name == "manufacturer" {
+				name = "manufacturer_uuid"
+			}
+			projection[name] = 1
+		}
+		opts.SetProjection(projection)
+	}
+
+	return opts
+}
+
+// buildKeysetCondition отбирает детали, идущие в порядке сортировки после курсора
+func buildKeysetCondition(sort model.Sort, after *model.PageCursor) bson.M {
+	field := sortFields[sort.Field]
+	operator := "$gt"
+	if sort.Desc {
+		operator = "$lt"
+	}
+
+	var value any
+	switch sort.Field {
+	case model.SortByName:
+		value = after.Name
+	case model.SortByPrice:
+		value = after.Price
+	case model.SortByStock:
+		value = after.StockQuantity
+	default:
+		value = after.CreatedAt
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{field: bson.M{operator: value}},
+		bson.M{field: value, "part_uuid": bson.M{operator: after.PartUuid.String()}},
+	}}
+}
+
+// addCondition добавляет условие к фильтру через $and, не затирая уже заданные условия
+func addCondition(mongoFilter, condition bson.M) {
+	conditions, _ := mongoFilter["$and"].([]bson.M)
+	mongoFilter["$and"] = append(conditions, condition)
+}
+
+// partsFilter дополняет фильтр условием по производителям: страна и название
+// хранятся в справочнике, поэтому сначала находим подходящих производителей
+func (r *repository) partsFilter(ctx context.Context, filter *model.Filter) (bson.M, error) {
+	mongoFilter := buildMongoFilter(filter)
+	if filter == nil || (len(filter.ManufacturerCountries) == 0 && len(filter.ManufacturerNames) == 0) {
+		return mongoFilter, nil
+	}
+
+	uuids, err := r.manufacturerUuids(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	mongoFilter["manufacturer_uuid"] = bson.M{"$in": uuids}
+
+	return mongoFilter, nil
+}
+
+// buildMongoFilter создание фильтра для MongoDB на основе модели фильтра.
+// Условия по производителям добавляет partsFilter
 func buildMongoFilter(filter *model.Filter) bson.M {
+	// Удалённые детали (soft delete) не попадают в выборку
+	mongoFilter := bson.M{"deleted_at": nil}
+
 	if filter == nil {
-		return bson.M{}
+		return mongoFilter
 	}
 
-	mongoFilter := bson.M{}
-
 	// Фильтр по UUID
 	if len(filter.Uuids) > 0 {
 		uuidStrings := make([]string, len(filter.Uuids))
 		for i, uuid := range filter.Uuids {
 			uuidStrings[i] = uuid.String()
 		}
-		mongoFilter["order_uuid"] = bson.M{"$in": uuidStrings}
+		mongoFilter["part_uuid"] = bson.M{"$in": uuidStrings}
 	}
 
 	// Фильтр по именам
@@ -80,15 +187,105 @@ func buildMongoFilter(filter *model.Filter) bson.M {
 		mongoFilter["category"] = bson.M{"$in": categoryInts}
 	}
 
-	// Фильтр по странам производителей
-	if len(filter.ManufacturerCountries) > 0 {
-		mongoFilter["manufacturer.country"] = bson.M{"$in": filter.ManufacturerCountries}
+	// Фильтр по тегам: хотя бы один из указанных тегов или все сразу
+	if len(filter.Tags) > 0 {
+		if filter.TagMatch == model.TagMatchAll {
+			mongoFilter["tags"] = bson.M{"$all": filter.Tags}
+		} else {
+			mongoFilter["tags"] = bson.M{"$in": filter.Tags}
+		}
 	}
 
-	// Фильтр по тегам (должен содержать хотя бы один из указанных тегов)
-	if len(filter.Tags) > 0 {
-		mongoFilter["tags"] = bson.M{"$in": filter.Tags}
+	// Фильтр по диапазону цен
+	price := bson.M{}
+	if filter.PriceMin != nil {
+		price["$gte"] = *filter.PriceMin
+	}
+	if filter.PriceMax != nil {
+		price["$lte"] = *filter.PriceMax
+	}
+	if len(price) > 0 {
+		mongoFilter["price"] = price
+	}
+
+	// Фильтр по остатку на складе
+	stock := bson.M{}
+	if filter.MinStock != nil {
+		stock["$gte"] = *filter.MinStock
+	}
+	if filter.InStockOnly {
+		stock["$gt"] = 0
+	}
+	if len(filter.Warehouses) > 0 {
+		// Остаток проверяется на каждом складе отдельно: хватит одного подходящего
+		match := bson.M{"warehouse": bson.M{"$in": filter.Warehouses}}
+		if len(stock) > 0 {
+			match["quantity"] = stock
+		}
+		mongoFilter["stock"] = bson.M{"$elemMatch": match}
+	} else if len(stock) > 0 {
+		mongoFilter["stock_quantity"] = stock
+	}
+
+	// Условия на метаданные
+	if len(filter.Metadata) > 0 {
+		conditions := make([]bson.M, 0, len(filter.Metadata))
+		for _, predicate := range filter.Metadata {
+			conditions = append(conditions, buildMetadataCondition(predicate)...)
+		}
+		mongoFilter["$and"] = conditions
 	}
 
 	return mongoFilter
 }
+
+var metadataOperators = map[model.MetadataOperator]string{
+	model.MetadataEq:  "$eq",
+	model.MetadataNe:  "$ne",
+	model.MetadataGt:  "$gt",
+	model.MetadataGte: "$gte",
+	model.MetadataLt:  "$lt",
+	model.MetadataLte: "$lte",
+}
+
+// buildMetadataCondition строит условие на значение метаданных.
+// Нулевые значения не сохраняются (omitempty), поэтому отсутствующее поле
+// заменяется нулём нужного типа — так же, как в inmemory-репозитории.
+func buildMetadataCondition(predicate model.MetadataPredicate) []bson.M {
+	path := "metadata." + predicate.Key
+	conditions := []bson.M{{path: bson.M{"$exists": true}}}
+
+	if predicate.Operator == model.MetadataExists {
+		return conditions
+	}
+
+	// Неизвестный оператор или тип значения: условие не выполняется ни для одной детали
+	never := bson.M{path: bson.M{"$exists": false}}
+
+	operator, ok := metadataOperators[predicate.Operator]
+	if !ok {
+		return append(conditions, never)
+	}
+
+	var (
+		field string
+		zero  any
+		value any
+	)
+	switch predicate.Kind {
+	case model.ValueKindString:
+		field, zero, value = "string_value", "", predicate.Value.StringValue
+	case model.ValueKindInt64:
+		field, zero, value = "int64_value", int64(0), predicate.Value.Int64Value
+	case model.ValueKindFloat64:
+		field, zero, value = "float64_value", float64(0), predicate.Value.Float64Value
+	case model.ValueKindBool:
+		field, zero, value = "bool_value", false, predicate.Value.BoolValue
+	default:
+		return append(conditions, never)
+	}
+
+	return append(conditions, bson.M{"$expr": bson.M{
+		operator: bson.A{bson.M{"$ifNull": bson.A{"$" + path + "." + field, zero}}, value},
+	}})
+}
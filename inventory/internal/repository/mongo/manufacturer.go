@@ -0,0 +1,236 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+)
+
+func (r *repository) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	repoManufacturer := repoConverter.ToRepositoryManufacturer(manufacturer)
+
+	_, err := r.db.Collection(manufacturersCollection).InsertOne(ctx, repoManufacturer)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, model.ErrManufacturerAlreadyExists
+		}
+		return nil, err
+	}
+
+	return repoConverter.ToModelManufacturer(repoManufacturer)
+}
+
+func (r *repository) GetManufacturer(ctx context.Context, uuid uuid.UUID) (*model.Manufacturer, error) {
+	return r.findManufacturer(ctx, bson.M{"manufacturer_uuid": uuid.String()})
+}
+
+func (r *repository) FindManufacturerByName(ctx context.Context, name string) (*model.Manufacturer, error) {
+	return r.findManufacturer(ctx, bson.M{"name_key": model.ManufacturerKey(name)})
+}
+
+func (r *repository) findManufacturer(ctx context.Context, filter bson.M) (*model.Manufacturer, error) {
+	var repoManufacturer repoModel.Manufacturer
+	err := r.db.Collection(manufacturersCollection).FindOne(ctx, filter).Decode(&repoManufacturer)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrManufacturerNotFound
+		}
+		return nil, err
+	}
+
+	return repoConverter.ToModelManufacturer(&repoManufacturer)
+}
+
+func (r *repository) ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error) {
+	mongoFilter := bson.M{}
+	if filter != nil && len(filter.Countries) > 0 {
+		mongoFilter["country"] = bson.M{"$in": filter.Countries}
+	}
+
+	return r.listManufacturers(ctx, mongoFilter)
+}
+
+func (r *repository) listManufacturers(ctx context.Context, filter bson.M) ([]model.Manufacturer, error) {
+	cursor, err := r.db.Collection(manufacturersCollection).Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "manufacturer_uuid", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var repoManufacturers []repoModel.Manufacturer
+	if err = cursor.All(ctx, &repoManufacturers); err != nil {
+		return nil, err
+	}
+
+	manufacturers := make([]model.Manufacturer, 0, len(repoManufacturers))
+	for _, repoManufacturer := range repoManufacturers {
+		manufacturer, err := repoConverter.ToModelManufacturer(&repoManufacturer)
+		if err != nil {
+			return nil, err
+		}
+		manufacturers = append(manufacturers, *manufacturer)
+	}
+
+	return manufacturers, nil
+}
+
+// UpdateManufacturer сохраняет производителя и в той же транзакции обновляет
+// поисковый текст его деталей: название производителя участвует в полнотекстовом поиске
+func (r *repository) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	result, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return r.updateManufacturer(sessCtx, manufacturer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Manufacturer), nil
+}
+
+func (r *repository) updateManufacturer(ctx mongo.SessionContext, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	repoManufacturer := repoConverter.ToRepositoryManufacturer(manufacturer)
+
+	var updated repoModel.Manufacturer
+	err := r.db.Collection(manufacturersCollection).FindOneAndUpdate(ctx,
+		bson.M{"manufacturer_uuid": repoManufacturer.ManufacturerUuid},
+		bson.M{"$set": bson.M{
+			"name":       repoManufacturer.Name,
+			"name_key":   repoManufacturer.NameKey,
+			"country":    repoManufacturer.Country,
+			"website":    repoManufacturer.Website,
+			"updated_at": repoManufacturer.UpdatedAt,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrManufacturerNotFound
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, model.ErrManufacturerAlreadyExists
+		}
+		return nil, err
+	}
+
+	_, err = r.db.Collection(partsCollection).UpdateMany(ctx,
+		bson.M{"manufacturer_uuid": updated.ManufacturerUuid},
+		bson.M{"$set": bson.M{"search.manufacturer": search.Index(updated.Name)}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConverter.ToModelManufacturer(&updated)
+}
+
+// DeleteManufacturer удаляет производителя, если на него не ссылается ни одна деталь.
+// Удалённые детали (soft delete) ссылку не удерживают
+func (r *repository) DeleteManufacturer(ctx context.Context, uuid uuid.UUID) error {
+	count, err := r.db.Collection(partsCollection).CountDocuments(ctx,
+		bson.M{"manufacturer_uuid": uuid.String(), "deleted_at": nil},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return model.ErrManufacturerInUse
+	}
+
+	result, err := r.db.Collection(manufacturersCollection).DeleteOne(ctx, bson.M{"manufacturer_uuid": uuid.String()})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return model.ErrManufacturerNotFound
+	}
+
+	return nil
+}
+
+// manufacturerUuids возвращает идентификаторы производителей, подходящих под фильтр
+// деталей по стране и названию
+func (r *repository) manufacturerUuids(ctx context.Context, filter *model.Filter) ([]string, error) {
+	mongoFilter := bson.M{}
+	if len(filter.ManufacturerCountries) > 0 {
+		mongoFilter["country"] = bson.M{"$in": filter.ManufacturerCountries}
+	}
+	if len(filter.ManufacturerNames) > 0 {
+		mongoFilter["name"] = bson.M{"$in": filter.ManufacturerNames}
+	}
+
+	manufacturers, err := r.listManufacturers(ctx, mongoFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]string, 0, len(manufacturers))
+	for _, manufacturer := range manufacturers {
+		uuids = append(uuids, manufacturer.ManufacturerUuid.String())
+	}
+
+	return uuids, nil
+}
+
+// joinManufacturers подставляет в детали данные производителей из справочника
+func (r *repository) joinManufacturers(ctx context.Context, parts []model.Part) error {
+	seen := make(map[uuid.UUID]struct{})
+	uuids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part.ManufacturerUuid == uuid.Nil {
+			continue
+		}
+		if _, ok := seen[part.ManufacturerUuid]; ok {
+			continue
+		}
+		seen[part.ManufacturerUuid] = struct{}{}
+		uuids = append(uuids, part.ManufacturerUuid.String())
+	}
+
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	manufacturers, err := r.listManufacturers(ctx, bson.M{"manufacturer_uuid": bson.M{"$in": uuids}})
+	if err != nil {
+		return err
+	}
+
+	byUuid := make(map[uuid.UUID]model.Manufacturer, len(manufacturers))
+	for _, manufacturer := range manufacturers {
+		byUuid[manufacturer.ManufacturerUuid] = manufacturer
+	}
+
+	for i := range parts {
+		parts[i].Manufacturer = byUuid[parts[i].ManufacturerUuid]
+	}
+
+	return nil
+}
+
+// joinManufacturer подставляет в деталь данные производителя из справочника
+func (r *repository) joinManufacturer(ctx context.Context, part *model.Part) error {
+	parts := []model.Part{*part}
+	if err := r.joinManufacturers(ctx, parts); err != nil {
+		return err
+	}
+	part.Manufacturer = parts[0].Manufacturer
+
+	return nil
+}
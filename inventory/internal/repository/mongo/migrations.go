@@ -0,0 +1,507 @@
+package mongo
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/migrator"
+)
+
+const (
+	// legacyPartUUIDField — поле, в котором идентификатор детали хранился до появления part_uuid
+	legacyPartUUIDField = "order_uuid"
+	// legacyManufacturerField — поле, в котором производитель хранился внутри детали до появления справочника
+	legacyManufacturerField = "manufacturer"
+	// legacyWarehouse — склад, на котором числились все детали до учёта остатков по складам
+	legacyWarehouse = "BAIKONUR"
+)
+
+// legacyPart — документ детали вместе с производителем, встроенным до появления справочника
+type legacyPart struct {
+	repoModel.RepositoryPart `bson:",inline"`
+	Manufacturer             struct {
+		Name string `bson:"name"`
+	} `bson:"manufacturer"`
+}
+
+// NewMigrator возвращает мигратор схемы коллекции деталей.
+// Новые миграции добавляются в конец списка со следующей версией; применённые не меняются.
+// Поэтому миграции не используют текущие описания индексов и документов репозитория:
+// всё, что они создают, зафиксировано в самой миграции.
+func NewMigrator(database *mongo.Database) (*migrator.Migrator, error) {
+	return migrator.New(database, []migrator.Migration{
+		{Version: 20250820100000, Name: "rename_order_uuid_to_part_uuid", Up: renamePartUUID},
+		{Version: 20250820100100, Name: "create_part_indexes", Up: createPartIndexes},
+		{Version: 20250820100200, Name: "create_list_sort_indexes", Up: createSortIndexes},
+		{Version: 20250822100000, Name: "create_search_index", Up: createSearchIndex},
+		{Version: 20250825100000, Name: "create_warehouse_indexes", Up: createWarehouseIndexes},
+		{Version: 20250825100100, Name: "move_stock_to_warehouses", Up: moveStockToWarehouses},
+		{Version: 20250827100000, Name: "create_price_history", Up: createPriceHistory},
+		{Version: 20250829100000, Name: "dedupe_manufacturers", Up: dedupeManufacturers},
+		{Version: 20250901100000, Name: "create_kit_indexes", Up: createKitIndexes},
+	})
+}
+
+// renamePartUUID переносит идентификатор детали из order_uuid в part_uuid
+// и удаляет индексы, построенные по старому полю
+func renamePartUUID(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection(partsCollection)
+
+	result, err := collection.UpdateMany(ctx,
+		bson.M{legacyPartUUIDField: bson.M{"$exists": true}},
+		bson.M{"$rename": bson.M{legacyPartUUIDField: "part_uuid"}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount > 0 {
+		log.Printf("Миграция: поле %s переименовано в part_uuid у %d деталей", legacyPartUUIDField, result.ModifiedCount)
+	}
+
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var indexes []struct {
+		Name string `bson:"name"`
+		Key  bson.D `bson:"key"`
+	}
+	if err = cursor.All(ctx, &indexes); err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		for _, key := range index.Key {
+			if key.Key != legacyPartUUIDField {
+				continue
+			}
+
+			if _, err = collection.Indexes().DropOne(ctx, index.Name); err != nil {
+				return err
+			}
+			log.Printf("Миграция: удалён индекс %s по полю %s", index.Name, legacyPartUUIDField)
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// createPartIndexes создаёт индексы под GetPart и фильтры ListParts
+func createPartIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection(partsCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Уникальный индекс по идентификатору детали защищает от дублей при CreatePart
+		{
+			Keys:    bson.D{{Key: "part_uuid", Value: 1}},
+			Options: options.Index().SetName("part_uuid_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "category", Value: 1}},
+			Options: options.Index().SetName("category"),
+		},
+		{
+			Keys:    bson.D{{Key: "manufacturer.country", Value: 1}},
+			Options: options.Index().SetName("manufacturer_country"),
+		},
+		// Multikey-индекс: каждый тег детали попадает в индекс отдельно
+		{
+			Keys:    bson.D{{Key: "tags", Value: 1}},
+			Options: options.Index().SetName("tags"),
+		},
+	})
+
+	return err
+}
+
+// createSortIndexes создаёт индексы под keyset-пагинацию ListParts: поле сортировки + part_uuid
+func createSortIndexes(ctx context.Context, db *mongo.Database) error {
+	fields := []string{"created_at", "name", "price", "stock_quantity"}
+
+	indexes := make([]mongo.IndexModel, 0, len(fields))
+	for _, field := range fields {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: field, Value: 1}, {Key: "part_uuid", Value: 1}},
+			Options: options.Index().SetName(field + "_part_uuid"),
+		})
+	}
+
+	_, err := db.Collection(partsCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// createSearchIndex заполняет основы слов у деталей, сохранённых до появления поиска,
+// и строит по ним текстовый индекс
+func createSearchIndex(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection(partsCollection)
+
+	cursor, err := collection.Find(ctx, bson.M{"search": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+
+	var parts []legacyPart
+	if err = cursor.All(ctx, &parts); err != nil {
+		return err
+	}
+
+	// Состав полей зафиксирован здесь, а основы слов строит текущий search.Index:
+	// иначе они разошлись бы с основами слов поисковых запросов
+	for _, part := range parts {
+		_, err = collection.UpdateOne(ctx,
+			bson.M{"part_uuid": part.PartUuid},
+			bson.M{"$set": bson.M{"search": bson.M{
+				"name":         search.Index(part.Name),
+				"description":  search.Index(part.Description),
+				"tags":         search.Index(part.Tags...),
+				"manufacturer": search.Index(part.Manufacturer.Name),
+			}}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(parts) > 0 {
+		log.Printf("Миграция: заполнен поисковый текст у %d деталей", len(parts))
+	}
+
+	// Веса полей совпадают с весами inmemory-поиска, язык отключён — стемминг уже выполнен приложением
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "search.name", Value: "text"},
+			{Key: "search.description", Value: "text"},
+			{Key: "search.tags", Value: "text"},
+			{Key: "search.manufacturer", Value: "text"},
+		},
+		Options: options.Index().
+			SetName("parts_text_search").
+			SetDefaultLanguage("none").
+			SetWeights(bson.D{
+				{Key: "search.name", Value: 10},
+				{Key: "search.description", Value: 1},
+				{Key: "search.tags", Value: 5},
+				{Key: "search.manufacturer", Value: 3},
+			}),
+	})
+
+	return err
+}
+
+// createWarehouseIndexes создаёт индексы справочника складов, журнала перемещений
+// и остатков деталей по складам
+func createWarehouseIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection(warehousesCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "code", Value: 1}},
+		Options: options.Index().SetName("code_unique").SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection(stockTransfersCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "part_uuid", Value: 1}, {Key: "created_at", Value: 1}},
+		Options: options.Index().SetName("part_uuid_created_at"),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Multikey-индекс под фильтр ListParts по складам
+	_, err = db.Collection(partsCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "stock.warehouse", Value: 1}, {Key: "stock.quantity", Value: 1}},
+		Options: options.Index().SetName("stock_warehouse_quantity"),
+	})
+
+	return err
+}
+
+// moveStockToWarehouses переносит остаток деталей, сохранённых до учёта по складам, на legacyWarehouse
+func moveStockToWarehouses(ctx context.Context, db *mongo.Database) error {
+	result, err := db.Collection(partsCollection).UpdateMany(ctx,
+		bson.M{"stock": bson.M{"$exists": false}, "stock_quantity": bson.M{"$gt": 0}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"stock": bson.A{bson.M{"warehouse": legacyWarehouse, "quantity": "$stock_quantity"}},
+		}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount > 0 {
+		log.Printf("Миграция: остаток %d деталей перенесён на склад %s", result.ModifiedCount, legacyWarehouse)
+	}
+
+	return nil
+}
+
+// createPriceHistory создаёт индексы истории цен и заводит по периоду на каждую деталь:
+// цена, сохранённая до ведения истории, считается действующей с даты создания детали
+func createPriceHistory(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection(partPricesCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "part_uuid", Value: 1}, {Key: "effective_from", Value: 1}},
+			Options: options.Index().SetName("part_uuid_effective_from_unique").SetUnique(true),
+		},
+		{
+			// Под поиск действующих цен в SyncCurrentPrices
+			Keys:    bson.D{{Key: "effective_from", Value: 1}, {Key: "effective_to", Value: 1}},
+			Options: options.Index().SetName("effective_from_effective_to"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cursor, err := db.Collection(partsCollection).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$project", Value: bson.M{
+			"_id":            0,
+			"part_uuid":      1,
+			"price":          1,
+			"effective_from": "$created_at",
+			"effective_to":   bson.M{"$literal": nil},
+		}}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           partPricesCollection,
+			"on":             bson.A{"part_uuid", "effective_from"},
+			"whenMatched":    "keepExisting",
+			"whenNotMatched": "insert",
+		}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	return cursor.Close(ctx)
+}
+
+// dedupeManufacturers выносит производителей из деталей в справочник manufacturers.
+// Производители с одинаковым названием без учёта регистра и пробелов объединяются;
+// страна и сайт берутся из последней изменённой детали, где они заполнены.
+func dedupeManufacturers(ctx context.Context, db *mongo.Database) error {
+	manufacturers := db.Collection(manufacturersCollection)
+	_, err := manufacturers.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "manufacturer_uuid", Value: 1}},
+			Options: options.Index().SetName("manufacturer_uuid_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "name_key", Value: 1}},
+			Options: options.Index().SetName("name_key_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "country", Value: 1}},
+			Options: options.Index().SetName("country"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// В конвейере детали группируются по названию как есть: $toLower меняет регистр только
+	// латиницы, поэтому ключ считается в Go тем же model.ManufacturerKey, что и в справочнике
+	parts := db.Collection(partsCollection)
+	cursor, err := parts.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{legacyManufacturerField + ".name": bson.M{"$nin": bson.A{"", nil}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "updated_at", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$manufacturer.name",
+			"created_at": bson.M{"$min": "$created_at"},
+			"parts": bson.M{"$push": bson.M{
+				"part_uuid":  "$part_uuid",
+				"country":    "$manufacturer.country",
+				"website":    "$manufacturer.website",
+				"updated_at": "$updated_at",
+			}},
+		}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	var named []legacyManufacturerGroup
+	if err = cursor.All(ctx, &named); err != nil {
+		return err
+	}
+
+	groups := mergeManufacturerGroups(named)
+
+	now := time.Now().UTC()
+	for _, group := range groups {
+		countries := make([]string, 0, len(group.Parts))
+		websites := make([]string, 0, len(group.Parts))
+		partUuids := make([]string, 0, len(group.Parts))
+		for _, part := range group.Parts {
+			countries = append(countries, part.Country)
+			websites = append(websites, part.Website)
+			partUuids = append(partUuids, part.PartUuid)
+		}
+
+		// Повторный запуск после сбоя не заводит второго производителя с тем же ключом
+		var manufacturer repoModel.Manufacturer
+		err = manufacturers.FindOneAndUpdate(ctx,
+			bson.M{"name_key": group.NameKey},
+			bson.M{"$setOnInsert": repoModel.Manufacturer{
+				ManufacturerUuid: uuid.NewString(),
+				Name:             group.Name,
+				NameKey:          group.NameKey,
+				Country:          firstNonEmpty(countries),
+				Website:          firstNonEmpty(websites),
+				CreatedAt:        group.CreatedAt,
+				UpdatedAt:        now,
+			}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&manufacturer)
+		if err != nil {
+			return err
+		}
+
+		_, err = parts.UpdateMany(ctx,
+			bson.M{"part_uuid": bson.M{"$in": partUuids}},
+			bson.M{"$set": bson.M{"manufacturer_uuid": manufacturer.ManufacturerUuid}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if len(groups) > 0 {
+		log.Printf("Миграция: в справочник вынесено %d производителей", len(groups))
+	}
+
+	_, err = parts.UpdateMany(ctx,
+		bson.M{legacyManufacturerField: bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{legacyManufacturerField: ""}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = dropIndexIfExists(ctx, parts, "manufacturer_country"); err != nil {
+		return err
+	}
+
+	_, err = parts.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "manufacturer_uuid", Value: 1}},
+		Options: options.Index().SetName("manufacturer_uuid"),
+	})
+
+	return err
+}
+
+// legacyManufacturerRef — производитель в одной детали
+type legacyManufacturerRef struct {
+	PartUuid  string    `bson:"part_uuid"`
+	Country   string    `bson:"country"`
+	Website   string    `bson:"website"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// legacyManufacturerGroup — детали с одинаковым названием производителя,
+// от последней изменённой к первой
+type legacyManufacturerGroup struct {
+	Name      string                  `bson:"_id"`
+	NameKey   string                  `bson:"-"`
+	CreatedAt time.Time               `bson:"created_at"`
+	Parts     []legacyManufacturerRef `bson:"parts"`
+}
+
+// mergeManufacturerGroups объединяет группы, названия которых совпадают по model.ManufacturerKey.
+// Название берётся из последней изменённой детали, детали упорядочены от последней изменённой
+func mergeManufacturerGroups(named []legacyManufacturerGroup) []legacyManufacturerGroup {
+	var merged []legacyManufacturerGroup
+	index := make(map[string]int)
+	latest := make(map[string]time.Time)
+
+	for _, group := range named {
+		key := model.ManufacturerKey(group.Name)
+		i, ok := index[key]
+		if !ok {
+			i = len(merged)
+			index[key] = i
+			merged = append(merged, legacyManufacturerGroup{NameKey: key, CreatedAt: group.CreatedAt})
+		}
+
+		target := &merged[i]
+		if len(group.Parts) > 0 && (target.Name == "" || group.Parts[0].UpdatedAt.After(latest[key])) {
+			target.Name = strings.TrimSpace(group.Name)
+			latest[key] = group.Parts[0].UpdatedAt
+		}
+		if group.CreatedAt.Before(target.CreatedAt) {
+			target.CreatedAt = group.CreatedAt
+		}
+		target.Parts = append(target.Parts, group.Parts...)
+	}
+
+	for i := range merged {
+		slices.SortStableFunc(merged[i].Parts, func(a, b legacyManufacturerRef) int {
+			return b.UpdatedAt.Compare(a.UpdatedAt)
+		})
+	}
+
+	return merged
+}
+
+// firstNonEmpty возвращает первое непустое значение
+func firstNonEmpty(values []string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// dropIndexIfExists удаляет индекс коллекции, если он есть
+func dropIndexIfExists(ctx context.Context, collection *mongo.Collection, name string) error {
+	names, err := collection.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range names {
+		if index.Name == name {
+			_, err = collection.Indexes().DropOne(ctx, name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createKitIndexes создаёт индексы графа совместимости и справочника наборов
+func createKitIndexes(ctx context.Context, db *mongo.Database) error {
+	// Multikey-индекс: ребро находится по любой из двух деталей
+	_, err := db.Collection(compatibilityCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "part_uuids", Value: 1}},
+		Options: options.Index().SetName("part_uuids"),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Collection(kitsCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "kit_uuid", Value: 1}},
+			Options: options.Index().SetName("kit_uuid_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}, {Key: "kit_uuid", Value: 1}},
+			Options: options.Index().SetName("name_kit_uuid"),
+		},
+	})
+
+	return err
+}
@@ -0,0 +1,185 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (r *repository) GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error) {
+	return r.priceHistory(ctx, partUuid.String())
+}
+
+// SchedulePrice перестраивает историю цен детали в одной транзакции:
+// параллельные изменения не оставляют пересекающихся периодов
+func (r *repository) SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error) {
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	result, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return r.schedulePrice(sessCtx, partUuid.String(), price, from)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]model.PricePeriod), nil
+}
+
+func (r *repository) schedulePrice(ctx mongo.SessionContext, partUuid string, price float64, from time.Time) ([]model.PricePeriod, error) {
+	history, err := r.priceHistory(ctx, partUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	history = model.SchedulePrice(history, price, from)
+
+	collection := r.db.Collection(partPricesCollection)
+	if _, err = collection.DeleteMany(ctx, bson.M{"part_uuid": partUuid}); err != nil {
+		return nil, err
+	}
+
+	periods := repoConverter.ToRepositoryPriceHistory(partUuid, history)
+	docs := make([]interface{}, 0, len(periods))
+	for _, period := range periods {
+		docs = append(docs, period)
+	}
+
+	if _, err = collection.InsertMany(ctx, docs); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (r *repository) GetPricesAt(ctx context.Context, partUuids []uuid.UUID, at time.Time) (map[uuid.UUID]float64, error) {
+	ids := make([]string, 0, len(partUuids))
+	for _, id := range partUuids {
+		ids = append(ids, id.String())
+	}
+
+	filter := coversFilter(at)
+	filter["part_uuid"] = bson.M{"$in": ids}
+
+	cursor, err := r.db.Collection(partPricesCollection).Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []repoModel.PricePeriod
+	if err = cursor.All(ctx, &periods); err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uuid.UUID]float64, len(periods))
+	for _, period := range periods {
+		id, err := uuid.Parse(period.PartUuid)
+		if err != nil {
+			return nil, model.ErrConvertFromRepo
+		}
+		prices[id] = period.Price
+	}
+
+	return prices, nil
+}
+
+// SyncCurrentPrices записывает в детали цены, действующие в момент now.
+// Сравнение с ценой детали выполняется на стороне MongoDB, поэтому обновляются
+// только детали, у которых с прошлой синхронизации наступило запланированное изменение
+func (r *repository) SyncCurrentPrices(ctx context.Context, now time.Time) (int64, error) {
+	cursor, err := r.db.Collection(partPricesCollection).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: coversFilter(now)}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         partsCollection,
+			"localField":   "part_uuid",
+			"foreignField": "part_uuid",
+			"as":           "part",
+		}}},
+		{{Key: "$unwind", Value: "$part"}},
+		{{Key: "$match", Value: bson.M{
+			"part.deleted_at": nil,
+			"$expr":           bson.M{"$ne": bson.A{"$part.price", "$price"}},
+		}}},
+		{{Key: "$project", Value: bson.M{"part_uuid": 1, "price": 1}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var periods []repoModel.PricePeriod
+	if err = cursor.All(ctx, &periods); err != nil {
+		return 0, err
+	}
+
+	var updated int64
+	parts := r.db.Collection(partsCollection)
+	for _, period := range periods {
+		result, err := parts.UpdateOne(ctx,
+			bson.M{"part_uuid": period.PartUuid, "deleted_at": nil},
+			bson.M{"$set": bson.M{"price": period.Price, "updated_at": now}},
+		)
+		if err != nil {
+			return updated, err
+		}
+		updated += result.ModifiedCount
+	}
+
+	return updated, nil
+}
+
+func (r *repository) priceHistory(ctx context.Context, partUuid string) ([]model.PricePeriod, error) {
+	cursor, err := r.db.Collection(partPricesCollection).Find(ctx,
+		bson.M{"part_uuid": partUuid},
+		options.Find().SetSort(bson.D{{Key: "effective_from", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []repoModel.PricePeriod
+	if err = cursor.All(ctx, &periods); err != nil {
+		return nil, err
+	}
+
+	return repoConverter.ToModelPriceHistory(periods), nil
+}
+
+// coversFilter отбирает периоды, действующие в момент at
+func coversFilter(at time.Time) bson.M {
+	return bson.M{
+		"effective_from": bson.M{"$lte": at},
+		"$or": bson.A{
+			bson.M{"effective_to": nil},
+			bson.M{"effective_to": bson.M{"$gt": at}},
+		},
+	}
+}
+
+// insertInitialPrices заводит новым деталям историю цен: цена действует с даты создания детали
+func (r *repository) insertInitialPrices(ctx context.Context, parts []model.Part) error {
+	docs := make([]interface{}, 0, len(parts))
+	for i := range parts {
+		history := model.SchedulePrice(nil, parts[i].Price, parts[i].CreatedAt)
+		for _, period := range repoConverter.ToRepositoryPriceHistory(parts[i].PartUuid.String(), history) {
+			docs = append(docs, period)
+		}
+	}
+
+	if len(docs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.Collection(partPricesCollection).InsertMany(ctx, docs)
+	return err
+}
@@ -11,12 +11,21 @@ import (
 
 var _ def.InventoryRepository = (*repository)(nil)
 
-const partsCollection = "parts"
+const (
+	partsCollection          = "parts"
+	warehousesCollection     = "warehouses"
+	stockTransfersCollection = "stock_transfers"
+	partPricesCollection     = "part_prices"
+	manufacturersCollection  = "manufacturers"
+	compatibilityCollection  = "part_compatibility"
+	kitsCollection           = "kits"
+)
 
 type repository struct {
 	db *mongo.Database
 }
 
+// NewRepository ожидает, что схема коллекции уже подготовлена миграциями из NewMigrator
 func NewRepository(ctx context.Context, database *mongo.Database) def.InventoryRepository {
 	repo := &repository{
 		db: database,
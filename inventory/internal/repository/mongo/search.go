@@ -0,0 +1,94 @@
+package mongo
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+)
+
+// searchHit — документ детали вместе с релевантностью из текстового индекса
+type searchHit struct {
+	repoModel.RepositoryPart `bson:",inline"`
+	Score                    float64 `bson:"score"`
+}
+
+func (r *repository) SearchParts(ctx context.Context, query *model.SearchQuery) (*[]model.SearchHit, error) {
+	collection := r.db.Collection(partsCollection)
+
+	// Основы слов уже посчитаны приложением, поэтому языковой анализ MongoDB отключён
+	mongoFilter, err := r.partsFilter(ctx, query.Filter)
+	if err != nil {
+		return nil, err
+	}
+	mongoFilter["$text"] = bson.M{
+		"$search":   strings.Join(query.Terms, " "),
+		"$language": "none",
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "part_uuid", Value: 1}}).
+		SetSkip(int64(query.Offset))
+	if query.Limit > 0 {
+		opts.SetLimit(int64(query.Limit))
+	}
+
+	cursor, err := collection.Find(ctx, mongoFilter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func(cursor *mongo.Cursor, ctx context.Context) {
+		err := cursor.Close(ctx)
+		if err != nil {
+			log.Printf("failed to close cursor: %v", err)
+		}
+	}(cursor, ctx)
+
+	var found []searchHit
+	if err = cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+
+	hits := make([]model.SearchHit, 0, len(found))
+	for _, hit := range found {
+		part, err := repoConverter.ToModelPart(&hit.RepositoryPart)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, model.SearchHit{Part: *part, Score: hit.Score})
+	}
+
+	parts := make([]model.Part, len(hits))
+	for i := range hits {
+		parts[i] = hits[i].Part
+	}
+	if err = r.joinManufacturers(ctx, parts); err != nil {
+		return nil, err
+	}
+	for i := range hits {
+		hits[i].Part = parts[i]
+	}
+
+	return &hits, nil
+}
+
+// newSearchText строит основы слов для текстового индекса. Название производителя
+// передаётся отдельно: в документе детали хранится только ссылка на справочник
+func newSearchText(part *repoModel.RepositoryPart, manufacturerName string) *repoModel.SearchText {
+	return &repoModel.SearchText{
+		Name:         search.Index(part.Name),
+		Description:  search.Index(part.Description),
+		Tags:         search.Index(part.Tags...),
+		Manufacturer: search.Index(manufacturerName),
+	}
+}
@@ -2,20 +2,36 @@ package mongo
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/fixtures"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/catalog"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
 	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
 )
 
-// AddTestData Добавление тестовых данных в MongoDB коллекцию
+// AddTestData Добавление стартового каталога из fixtures/parts.yaml в MongoDB коллекцию
 func (r *repository) AddTestData(ctx context.Context) error {
 	log.Printf("Добавление тестовых данных для инвентаря в MongoDB")
 
+	if err := r.addWarehouses(ctx); err != nil {
+		return err
+	}
+
+	if err := r.addKits(ctx); err != nil {
+		return err
+	}
+
 	collection := r.db.Collection(partsCollection)
 
 	// Проверяем, есть ли уже данные в коллекции
-	count, err := collection.CountDocuments(ctx, map[string]interface{}{})
+	count, err := collection.CountDocuments(ctx, bson.M{})
 	if err != nil {
 		return err
 	}
@@ -25,131 +41,147 @@ func (r *repository) AddTestData(ctx context.Context) error {
 		return nil
 	}
 
-	// Создаем тестовые данные
-	testParts := []repoModel.RepositoryPart{
-		{
-			OrderUuid:     "d973e963-b7e6-4323-8f4e-4bfd5ab8e834",
-			Name:          "Ракетный двигатель RD-180",
-			Description:   "Мощный ракетный двигатель для тяжелых носителей",
-			Price:         15000000.50,
-			StockQuantity: 5,
-			Category:      1, // ENGINE
-			Dimensions: repoModel.Dimensions{
-				Length: 355.6,
-				Width:  297.2,
-				Height: 297.2,
-				Weight: 5480.0,
-			},
-			Manufacturer: repoModel.Manufacturer{
-				Name:    "Energomash",
-				Country: "Russia",
-				Website: "https://www.energomash.ru",
-			},
-			Tags: []string{"rocket", "engine", "rd-180", "heavy-lift"},
-			Metadata: map[string]repoModel.Value{
-				"thrust": {
-					Float64Value: 3830000.0,
-				},
-				"fuel_type": {
-					StringValue: "RP-1/LOX",
-				},
-				"reusable": {
-					BoolValue: false,
-				},
-				"test_fires": {
-					Int64Value: 150,
-				},
-			},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		{
-			OrderUuid:     "d973e963-b7e6-4323-8f4e-4bfd5ab8e835",
-			Name:          "Система управления Navigation-1",
-			Description:   "Навигационная система для космических аппаратов",
-			Price:         750000.00,
-			StockQuantity: 12,
-			Category:      2, // ELECTRONICS
-			Dimensions: repoModel.Dimensions{
-				Length: 50.0,
-				Width:  40.0,
-				Height: 15.0,
-				Weight: 8.5,
-			},
-			Manufacturer: repoModel.Manufacturer{
-				Name:    "SpaceX",
-				Country: "USA",
-				Website: "https://www.spacex.com",
-			},
-			Tags: []string{"navigation", "electronics", "gps", "space"},
-			Metadata: map[string]repoModel.Value{
-				"accuracy": {
-					StringValue: "sub-meter",
-				},
-				"frequency": {
-					Float64Value: 1575.42,
-				},
-				"channels": {
-					Int64Value: 32,
-				},
-				"space_qualified": {
-					BoolValue: true,
-				},
-			},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		{
-			OrderUuid:     "d973e963-b7e6-4323-8f4e-4bfd5ab8e836",
-			Name:          "Топливный бак Falcon-Tank-9",
-			Description:   "Алюминиевый топливный бак для среднего класса ракет",
-			Price:         2500000.75,
-			StockQuantity: 8,
-			Category:      3, // STRUCTURE
-			Dimensions: repoModel.Dimensions{
-				Length: 1200.0,
-				Width:  366.0,
-				Height: 366.0,
-				Weight: 25000.0,
-			},
-			Manufacturer: repoModel.Manufacturer{
-				Name:    "Boeing",
-				Country: "USA",
-				Website: "https://www.boeing.com/space",
-			},
-			Tags: []string{"fuel", "tank", "structure", "aluminum"},
-			Metadata: map[string]repoModel.Value{
-				"capacity": {
-					Float64Value: 400000.0,
-				},
-				"material": {
-					StringValue: "Al-Li 2195",
-				},
-				"pressure_rating": {
-					Float64Value: 3.5,
-				},
-				"insulated": {
-					BoolValue: true,
-				},
-			},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-	}
-
-	// Конвертируем в interface{} для вставки
-	docs := make([]interface{}, len(testParts))
-	for i, part := range testParts {
-		docs[i] = part
-	}
-
-	// Вставляем тестовые данные
-	result, err := collection.InsertMany(ctx, docs)
+	parts, err := catalog.LoadFixture(fixtures.Parts)
 	if err != nil {
+		return fmt.Errorf("failed to load parts fixture: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if err = r.addManufacturers(ctx, parts, now); err != nil {
+		return err
+	}
+
+	repoParts := make([]*repoModel.RepositoryPart, 0, len(parts))
+	for i := range parts {
+		parts[i].CreatedAt = now
+		parts[i].UpdatedAt = now
+
+		repoPart := repoConverter.ToRepositoryPart(&parts[i])
+		repoPart.Search = newSearchText(repoPart, parts[i].Manufacturer.Name)
+		repoParts = append(repoParts, repoPart)
+	}
+
+	// Детали и их история цен сохраняются одной транзакцией: после сбоя на полпути
+	// каталог не останется без цен, а повторный запуск не пропустит его как заполненный
+	err = r.insertParts(ctx, parts, repoParts)
+	switch {
+	case errors.Is(err, model.ErrPartAlreadyExists):
+		// Каталог одновременно заполнил другой экземпляр
+		log.Printf("Тестовые данные уже существуют в коллекции, пропускаем добавление")
+		return nil
+	case err != nil:
 		log.Printf("Ошибка при добавлении тестовых данных: %v", err)
 		return err
 	}
 
-	log.Printf("Успешно добавлено %d тестовых записей в коллекцию %s", len(result.InsertedIDs), partsCollection)
+	log.Printf("Успешно добавлено %d тестовых записей в коллекцию %s", len(repoParts), partsCollection)
+
+	return nil
+}
+
+// addManufacturers заносит производителей деталей фикстуры в справочник.
+// Уже известные справочнику производители не дублируются: детали ссылаются на существующую запись
+func (r *repository) addManufacturers(ctx context.Context, parts []model.Part, now time.Time) error {
+	for _, manufacturer := range catalog.LinkManufacturers(parts, now) {
+		existing, err := r.FindManufacturerByName(ctx, manufacturer.Name)
+		switch {
+		case errors.Is(err, model.ErrManufacturerNotFound):
+			existing, err = r.CreateManufacturer(ctx, &manufacturer)
+			if err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		}
+
+		for i := range parts {
+			if parts[i].ManufacturerUuid == manufacturer.ManufacturerUuid {
+				parts[i].ManufacturerUuid = existing.ManufacturerUuid
+				parts[i].Manufacturer = *existing
+			}
+		}
+	}
+
+	return nil
+}
+
+// addWarehouses заполняет пустой справочник складов из fixtures/warehouses.yaml
+func (r *repository) addWarehouses(ctx context.Context) error {
+	collection := r.db.Collection(warehousesCollection)
+
+	count, err := collection.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	warehouses, err := catalog.LoadWarehouses(fixtures.Warehouses)
+	if err != nil {
+		return fmt.Errorf("failed to load warehouses fixture: %w", err)
+	}
+
+	docs := make([]interface{}, 0, len(warehouses))
+	for i := range warehouses {
+		docs = append(docs, repoConverter.ToRepositoryWarehouse(&warehouses[i]))
+	}
+
+	if _, err = collection.InsertMany(ctx, docs); err != nil {
+		return err
+	}
+
+	log.Printf("Успешно добавлено %d складов в коллекцию %s", len(docs), warehousesCollection)
+	return nil
+}
+
+// addKits заполняет пустые граф совместимости и справочник наборов
+// из fixtures/compatibility.yaml и fixtures/kits.yaml
+func (r *repository) addKits(ctx context.Context) error {
+	edges, err := catalog.LoadCompatibility(fixtures.Compatibility)
+	if err != nil {
+		return fmt.Errorf("failed to load compatibility fixture: %w", err)
+	}
+
+	edgeDocs := make([]interface{}, 0, len(edges))
+	for i := range edges {
+		edgeDocs = append(edgeDocs, repoConverter.ToRepositoryCompatibility(&edges[i]))
+	}
+
+	if err = r.insertIfEmpty(ctx, compatibilityCollection, edgeDocs); err != nil {
+		return err
+	}
+
+	kits, err := catalog.LoadKits(fixtures.Kits)
+	if err != nil {
+		return fmt.Errorf("failed to load kits fixture: %w", err)
+	}
+
+	kitDocs := make([]interface{}, 0, len(kits))
+	for i := range kits {
+		kitDocs = append(kitDocs, repoConverter.ToRepositoryKit(&kits[i]))
+	}
+
+	return r.insertIfEmpty(ctx, kitsCollection, kitDocs)
+}
+
+func (r *repository) insertIfEmpty(ctx context.Context, name string, docs []interface{}) error {
+	collection := r.db.Collection(name)
+
+	count, err := collection.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+
+	if count > 0 || len(docs) == 0 {
+		return nil
+	}
+
+	if _, err = collection.InsertMany(ctx, docs); err != nil {
+		return err
+	}
+
+	log.Printf("Успешно добавлено %d записей в коллекцию %s", len(docs), name)
 	return nil
 }
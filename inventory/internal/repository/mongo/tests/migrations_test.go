@@ -0,0 +1,76 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+	mongoRepo "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/mongo"
+)
+
+// legacyPartDocument — деталь в схеме до справочника производителей
+func legacyPartDocument(manufacturer, country string, updatedAt time.Time) bson.M {
+	return bson.M{
+		"part_uuid":  uuid.NewString(),
+		"name":       "Двигатель",
+		"price":      100.0,
+		"category":   1,
+		"created_at": updatedAt.Add(-time.Hour),
+		"updated_at": updatedAt,
+		"manufacturer": bson.M{
+			"name":    manufacturer,
+			"country": country,
+		},
+	}
+}
+
+func (s *MongoRepositorySuite) TestMigrations_DedupeNonASCIIManufacturers() {
+	ctx := context.Background()
+
+	db := s.container.Client().Database(databaseName + "-migrations")
+	s.Require().NoError(db.Drop(ctx))
+	defer func() { _ = db.Drop(ctx) }()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	_, err := db.Collection("parts").InsertMany(ctx, []interface{}{
+		legacyPartDocument("Энергомаш", "", now.Add(-2*time.Hour)),
+		legacyPartDocument(" ЭНЕРГОМАШ", "Россия", now.Add(-time.Hour)),
+		legacyPartDocument("энергомаш ", "", now),
+		legacyPartDocument("SpaceX", "USA", now),
+	})
+	s.Require().NoError(err)
+
+	migrator, err := mongoRepo.NewMigrator(db)
+	s.Require().NoError(err)
+	_, err = migrator.Up(ctx)
+	s.Require().NoError(err)
+
+	cursor, err := db.Collection("manufacturers").Find(ctx, bson.M{})
+	s.Require().NoError(err)
+	var manufacturers []repoModel.Manufacturer
+	s.Require().NoError(cursor.All(ctx, &manufacturers))
+
+	// Кириллические названия в разном регистре — один производитель
+	byKey := make(map[string]repoModel.Manufacturer)
+	for _, manufacturer := range manufacturers {
+		byKey[manufacturer.NameKey] = manufacturer
+	}
+	s.Require().Len(byKey, 2)
+	s.Require().Len(manufacturers, 2)
+
+	energomash, ok := byKey["энергомаш"]
+	s.Require().True(ok, "manufacturers: %+v", manufacturers)
+	// Название — из последней изменённой детали, страна — из последней, где она заполнена
+	assert.Equal(s.T(), "энергомаш", energomash.Name)
+	assert.Equal(s.T(), "Россия", energomash.Country)
+
+	count, err := db.Collection("parts").CountDocuments(ctx, bson.M{"manufacturer_uuid": energomash.ManufacturerUuid})
+	s.Require().NoError(err)
+	assert.Equal(s.T(), int64(3), count)
+}
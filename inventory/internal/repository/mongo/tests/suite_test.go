@@ -0,0 +1,66 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository"
+	mongoRepo "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/mongo"
+	tcmongo "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/testcontainers/mongo"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/testcontainers/network"
+)
+
+const databaseName = "inventory-repository"
+
+type MongoRepositorySuite struct {
+	suite.Suite
+	network    *network.Network
+	container  *tcmongo.Container
+	db         *mongo.Database
+	repository repository.InventoryRepository
+}
+
+func (s *MongoRepositorySuite) SetupSuite() {
+	ctx := context.Background()
+
+	var err error
+	s.network, err = network.NewNetwork(ctx, databaseName)
+	s.Require().NoError(err)
+
+	// Replica set нужен для транзакций TransferStock и создания деталей
+	s.container, err = tcmongo.NewContainer(ctx,
+		tcmongo.WithNetworkName(s.network.Name()),
+		tcmongo.WithContainerName(databaseName+"-mongo"),
+		tcmongo.WithReplicaSet("rs0"),
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MongoRepositorySuite) TearDownSuite() {
+	ctx := context.Background()
+
+	if s.container != nil {
+		_ = s.container.Terminate(ctx)
+	}
+	if s.network != nil {
+		_ = s.network.Remove(ctx)
+	}
+}
+
+func (s *MongoRepositorySuite) SetupTest() {
+	ctx := context.Background()
+
+	// Каждый тест начинает с чистой базы и стартового каталога
+	s.db = s.container.Client().Database(databaseName)
+	s.Require().NoError(s.db.Drop(ctx))
+	s.repository = mongoRepo.NewRepository(ctx, s.db)
+}
+
+func TestMongoRepository(t *testing.T) {
+	suite.Run(t, new(MongoRepositorySuite))
+}
@@ -0,0 +1,25 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *MongoRepositorySuite) TestAddTestData_SeedsPricesWithParts() {
+	ctx := context.Background()
+
+	parts, err := s.repository.ListParts(ctx, nil, nil)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(*parts)
+
+	// Стартовый каталог сохраняется вместе с историей цен: у каждой детали есть действующая цена
+	for _, part := range *parts {
+		history, err := s.repository.GetPriceHistory(ctx, part.PartUuid)
+		s.Require().NoError(err)
+		s.Require().Len(history, 1, "part %s", part.PartUuid)
+		assert.Equal(s.T(), part.Price, history[0].Price)
+	}
+}
@@ -0,0 +1,65 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// detail1 — деталь стартового каталога с остатками на складах BAIKONUR (3) и VOSTOCHNY (2)
+var detail1 = uuid.MustParse("d973e963-b7e6-4323-8f4e-4bfd5ab8e834")
+
+func (s *MongoRepositorySuite) TestUpdatePart_ConflictsWithTransferStock() {
+	ctx := context.Background()
+
+	read, err := s.repository.GetPart(ctx, detail1)
+	s.Require().NoError(err)
+
+	_, err = s.repository.TransferStock(ctx, &model.StockTransfer{
+		TransferUuid:  uuid.New(),
+		PartUuid:      detail1,
+		FromWarehouse: "BAIKONUR",
+		ToWarehouse:   "PLESETSK",
+		Quantity:      2,
+		CreatedAt:     read.UpdatedAt.Add(time.Second),
+	})
+	s.Require().NoError(err)
+
+	// Обновление по снимку до перемещения вернуло бы остатки назад
+	stale := *read
+	stale.Name = "Renamed"
+	stale.UpdatedAt = read.UpdatedAt.Add(2 * time.Second)
+	_, err = s.repository.UpdatePart(ctx, &stale, read.UpdatedAt)
+	s.Require().ErrorIs(err, model.ErrPartConflict)
+
+	stored, err := s.repository.GetPart(ctx, detail1)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), read.Name, stored.Name)
+	assert.Equal(s.T(), []model.StockLevel{
+		{Warehouse: "BAIKONUR", Quantity: 1},
+		{Warehouse: "VOSTOCHNY", Quantity: 2},
+		{Warehouse: "PLESETSK", Quantity: 2},
+	}, stored.Stock)
+
+	// После повторного чтения обновление проходит и сохраняет перемещение
+	fresh := *stored
+	fresh.Name = "Renamed"
+	fresh.UpdatedAt = stored.UpdatedAt.Add(time.Second)
+	updated, err := s.repository.UpdatePart(ctx, &fresh, stored.UpdatedAt)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "Renamed", updated.Name)
+	assert.Equal(s.T(), stored.Stock, updated.Stock)
+	assert.Equal(s.T(), int64(5), updated.StockQuantity)
+}
+
+func (s *MongoRepositorySuite) TestUpdatePart_NotFound() {
+	_, err := s.repository.UpdatePart(context.Background(), &model.Part{PartUuid: uuid.New()}, time.Now())
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+}
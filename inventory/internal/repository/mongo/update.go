@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (r *repository) UpdatePart(ctx context.Context, part *model.Part, readUpdatedAt time.Time) (*model.Part, error) {
+	collection := r.db.Collection(partsCollection)
+
+	repoPart := repoConverter.ToRepositoryPart(part)
+
+	// Документ перезаписывается целиком, в том числе остатки: условие на updated_at
+	// не даёт затереть изменение, сделанное после чтения детали, например TransferStock
+	filter := bson.M{"part_uuid": repoPart.PartUuid, "deleted_at": nil, "updated_at": readUpdatedAt}
+	update := bson.M{"$set": bson.M{
+		"name":              repoPart.Name,
+		"description":       repoPart.Description,
+		"price":             repoPart.Price,
+		"stock_quantity":    repoPart.StockQuantity,
+		"stock":             repoPart.Stock,
+		"category":          repoPart.Category,
+		"dimensions":        repoPart.Dimensions,
+		"manufacturer_uuid": repoPart.ManufacturerUuid,
+		"tags":              repoPart.Tags,
+		"metadata":          repoPart.Metadata,
+		"reorder_threshold": repoPart.ReorderThreshold,
+		"updated_at":        repoPart.UpdatedAt,
+		"search":            newSearchText(repoPart, part.Manufacturer.Name),
+	}}
+
+	var updated repoModel.RepositoryPart
+	err := collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, r.updateMissError(ctx, repoPart.PartUuid)
+		}
+		return nil, err
+	}
+
+	result, err := repoConverter.ToModelPart(&updated)
+	if err != nil {
+		return nil, err
+	}
+	result.Manufacturer = part.Manufacturer
+
+	return result, nil
+}
+
+// updateMissError объясняет, почему условное обновление не нашло документ:
+// деталь удалена или изменена после чтения
+func (r *repository) updateMissError(ctx context.Context, partUuid string) error {
+	count, err := r.db.Collection(partsCollection).CountDocuments(ctx,
+		bson.M{"part_uuid": partUuid, "deleted_at": nil},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return model.ErrPartConflict
+}
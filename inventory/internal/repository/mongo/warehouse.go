@@ -0,0 +1,97 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	cursor, err := r.db.Collection(warehousesCollection).Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "code", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var repoWarehouses []repoModel.Warehouse
+	if err = cursor.All(ctx, &repoWarehouses); err != nil {
+		return nil, err
+	}
+
+	warehouses := make([]model.Warehouse, 0, len(repoWarehouses))
+	for _, warehouse := range repoWarehouses {
+		warehouses = append(warehouses, repoConverter.ToModelWarehouse(&warehouse))
+	}
+
+	return warehouses, nil
+}
+
+// TransferStock меняет остатки детали и записывает перемещение в одной транзакции:
+// журнал перемещений не расходится с остатками
+func (r *repository) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error) {
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	result, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return r.transferStock(sessCtx, transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.Part), nil
+}
+
+func (r *repository) transferStock(ctx mongo.SessionContext, transfer *model.StockTransfer) (*model.Part, error) {
+	parts := r.db.Collection(partsCollection)
+	filter := bson.M{"part_uuid": transfer.PartUuid.String(), "deleted_at": nil}
+
+	var repoPart repoModel.RepositoryPart
+	if err := parts.FindOne(ctx, filter).Decode(&repoPart); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, err
+	}
+
+	part, err := repoConverter.ToModelPart(&repoPart)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.joinManufacturer(ctx, part); err != nil {
+		return nil, err
+	}
+
+	if err = part.Transfer(transfer.FromWarehouse, transfer.ToWarehouse, transfer.Quantity); err != nil {
+		return nil, err
+	}
+	part.UpdatedAt = transfer.CreatedAt
+
+	updated := repoConverter.ToRepositoryPart(part)
+	_, err = parts.UpdateOne(ctx, filter, bson.M{"$set": bson.M{
+		"stock":          updated.Stock,
+		"stock_quantity": updated.StockQuantity,
+		"updated_at":     updated.UpdatedAt,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.db.Collection(stockTransfersCollection).InsertOne(ctx, repoConverter.ToRepositoryStockTransfer(transfer))
+	if err != nil {
+		return nil, err
+	}
+
+	return part, nil
+}
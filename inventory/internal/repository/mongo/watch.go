@@ -0,0 +1,154 @@
+package mongo
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	repoConverter "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/converter"
+	repoModel "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/model"
+)
+
+// changeStreamHistoryLost — код ошибки MongoDB, когда resume token уже вытеснен из oplog
+const changeStreamHistoryLost = 286
+
+// changeEvent — интересующие нас поля события change stream
+type changeEvent struct {
+	OperationType string                    `bson:"operationType"`
+	FullDocument  *repoModel.RepositoryPart `bson:"fullDocument"`
+	ClusterTime   primitive.Timestamp       `bson:"clusterTime"`
+}
+
+// WatchParts читает change stream коллекции деталей. Для обновлений MongoDB отдаёт
+// текущую версию документа (updateLookup); soft delete приходит как обновление
+// с заполненным deleted_at. Физическое удаление документов не отслеживается
+func (r *repository) WatchParts(ctx context.Context, req *model.WatchRequest) error {
+	collection := r.db.Collection(partsCollection)
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if req.ProgressInterval > 0 {
+		// Пустой батч возвращается не реже раза в ProgressInterval, и по нему
+		// подписчик получает прогресс
+		opts.SetMaxAwaitTime(req.ProgressInterval)
+	}
+	if req.ResumeToken != "" {
+		token, err := base64.RawURLEncoding.DecodeString(req.ResumeToken)
+		if err != nil || bson.Raw(token).Validate() != nil {
+			return fmt.Errorf("%w: malformed token", model.ErrInvalidResumeToken)
+		}
+		opts.SetResumeAfter(bson.Raw(token))
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{
+		"operationType": bson.M{"$in": bson.A{"insert", "update", "replace"}},
+	}}}}
+
+	stream, err := collection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return watchError(err)
+	}
+	defer func() {
+		if err := stream.Close(context.Background()); err != nil {
+			log.Printf("failed to close change stream: %v", err)
+		}
+	}()
+
+	if req.Subscribed != nil {
+		req.Subscribed()
+	}
+
+	for {
+		if !stream.TryNext(ctx) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err = stream.Err(); err != nil {
+				return watchError(err)
+			}
+			// Курсор закрыт сервером, например коллекция удалена
+			if stream.ID() == 0 {
+				return nil
+			}
+
+			// Батч пуст: ResumeToken указывает на конец батча (post-batch resume token)
+			if req.ProgressInterval > 0 && stream.ResumeToken() != nil {
+				if err = req.Handle(progressEvent(stream, time.Now().UTC())); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		var change changeEvent
+		if err = stream.Decode(&change); err != nil {
+			return err
+		}
+		occurredAt := time.Unix(int64(change.ClusterTime.T), 0).UTC()
+
+		// Документ мог быть удалён физически до того, как мы прочитали событие.
+		// Позиция в потоке всё равно сдвинулась
+		if change.FullDocument == nil {
+			if err = req.Handle(progressEvent(stream, occurredAt)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		part, err := repoConverter.ToModelPart(change.FullDocument)
+		if err != nil {
+			return err
+		}
+		if err = r.joinManufacturer(ctx, part); err != nil {
+			return err
+		}
+
+		err = req.Handle(model.PartEvent{
+			Type:        eventType(change.OperationType, part),
+			Part:        *part,
+			ResumeToken: base64.RawURLEncoding.EncodeToString(stream.ResumeToken()),
+			OccurredAt:  occurredAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// progressEvent — событие прогресса с текущей позицией потока
+func progressEvent(stream *mongo.ChangeStream, occurredAt time.Time) model.PartEvent {
+	return model.PartEvent{
+		Type:        model.PartProgress,
+		ResumeToken: base64.RawURLEncoding.EncodeToString(stream.ResumeToken()),
+		OccurredAt:  occurredAt,
+	}
+}
+
+func eventType(operationType string, part *model.Part) model.PartEventType {
+	switch {
+	case part.DeletedAt != nil:
+		return model.PartDeleted
+	case operationType == "insert":
+		return model.PartCreated
+	default:
+		return model.PartUpdated
+	}
+}
+
+// watchError переводит ошибку устаревшего resume token в доменную
+func watchError(err error) error {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(changeStreamHistoryLost) {
+		return fmt.Errorf("%w: %v", model.ErrResumeTokenExpired, err)
+	}
+
+	return err
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,5 +11,29 @@ import (
 
 type InventoryRepository interface {
 	GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error)
+	ListParts(ctx context.Context, filter *model.Filter, query *model.ListQuery) (*[]model.Part, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error)
+	// UpdatePart сохраняет деталь, если с момента чтения она не менялась: updated_at
+	// в хранилище должен совпадать с readUpdatedAt, иначе возвращается ErrPartConflict
+	UpdatePart(ctx context.Context, part *model.Part, readUpdatedAt time.Time) (*model.Part, error)
+	DeletePart(ctx context.Context, uuid uuid.UUID, deletedAt time.Time) error
+	SearchParts(ctx context.Context, query *model.SearchQuery) (*[]model.SearchHit, error)
+	WatchParts(ctx context.Context, req *model.WatchRequest) error
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+	TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error)
+	AddAttachment(ctx context.Context, partUuid uuid.UUID, attachment *model.Attachment) (*model.Part, error)
+	GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error)
+	SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error)
+	GetPricesAt(ctx context.Context, partUuids []uuid.UUID, at time.Time) (map[uuid.UUID]float64, error)
+	SyncCurrentPrices(ctx context.Context, now time.Time) (int64, error)
+	CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error)
+	GetManufacturer(ctx context.Context, uuid uuid.UUID) (*model.Manufacturer, error)
+	FindManufacturerByName(ctx context.Context, name string) (*model.Manufacturer, error)
+	ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error)
+	UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error)
+	DeleteManufacturer(ctx context.Context, uuid uuid.UUID) error
+	ListCompatibility(ctx context.Context, partUuid uuid.UUID) ([]model.Compatibility, error)
+	ListKits(ctx context.Context) ([]model.Kit, error)
+	GetKit(ctx context.Context, uuid uuid.UUID) (*model.Kit, error)
 }
@@ -0,0 +1,112 @@
+// Package search содержит разбор текста для полнотекстового поиска деталей:
+// токенизацию, стемминг русских и английских слов и подсветку совпадений.
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kljensen/snowball/english"
+	"github.com/kljensen/snowball/russian"
+)
+
+// Token — слово текста с его основой и позицией в исходной строке (в байтах)
+type Token struct {
+	Text  string
+	Stem  string
+	Start int
+	End   int
+}
+
+// Tokenize разбивает текст на слова из букв и цифр. Дефисы и знаки препинания
+// считаются разделителями, поэтому "RD-180" даёт два токена: "rd" и "180"
+func Tokenize(text string) []Token {
+	var (
+		tokens []Token
+		start  = -1
+	)
+
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := text[start:end]
+		tokens = append(tokens, Token{Text: word, Stem: Stem(word), Start: start, End: end})
+		start = -1
+	}
+
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(text))
+
+	return tokens
+}
+
+// Stem приводит слово к основе: кириллица — русским стеммером, латиница — английским,
+// остальное (числа, артикулы) только переводится в нижний регистр
+func Stem(word string) string {
+	word = strings.ReplaceAll(strings.ToLower(word), "ё", "е")
+
+	switch {
+	case isCyrillic(word):
+		return russian.Stem(word, true)
+	case isLatin(word):
+		return english.Stem(word, true)
+	default:
+		return word
+	}
+}
+
+// Terms возвращает уникальные основы значимых слов текста без стоп-слов
+func Terms(text string) []string {
+	seen := make(map[string]bool)
+	terms := make([]string, 0)
+	for _, token := range Tokenize(text) {
+		word := strings.ToLower(token.Text)
+		if russian.IsStopWord(word) || english.IsStopWord(word) || seen[token.Stem] {
+			continue
+		}
+		seen[token.Stem] = true
+		terms = append(terms, token.Stem)
+	}
+
+	return terms
+}
+
+// Index возвращает основы всех слов текста через пробел — в таком виде текст
+// хранится в MongoDB под текстовым индексом без собственного стемминга
+func Index(texts ...string) string {
+	stems := make([]string, 0)
+	for _, text := range texts {
+		for _, token := range Tokenize(text) {
+			stems = append(stems, token.Stem)
+		}
+	}
+
+	return strings.Join(stems, " ")
+}
+
+func isCyrillic(word string) bool {
+	for _, r := range word {
+		if unicode.Is(unicode.Cyrillic, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLatin(word string) bool {
+	for _, r := range word {
+		if unicode.Is(unicode.Latin, r) {
+			return true
+		}
+	}
+	return false
+}
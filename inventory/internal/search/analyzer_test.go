@@ -0,0 +1,71 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "Русские формы слова", text: "кислородный кислородного", expected: []string{"кислородн"}},
+		{name: "Английские формы слова", text: "engines engine", expected: []string{"engin"}},
+		{name: "Артикул через дефис", text: "RD-180", expected: []string{"rd", "180"}},
+		{name: "Стоп-слова пропускаются", text: "двигатель для the rocket", expected: []string{"двигател", "rocket"}},
+		{name: "Ё и е совпадают", text: "Облегчённый", expected: []string{Stem("облегченный")}},
+		{name: "Без слов", text: " - , ", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Terms(tt.text); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Terms(%q) = %v, want %v", tt.text, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "Выделение по основе слова",
+			text:     "Ракетный двигатель RD-180.",
+			query:    "двигатели rd",
+			expected: "Ракетный <em>двигатель</em> <em>RD</em>-180",
+		},
+		{
+			name:     "Длинный текст обрезается",
+			text:     "один два три четыре пять шесть семь восемь девять десять сопло одиннадцать",
+			query:    "сопло",
+			expected: "…три четыре пять шесть семь восемь девять десять <em>сопло</em> одиннадцать",
+		},
+		{
+			name:     "Разметка в тексте экранируется",
+			text:     `Сопло <script>alert("x")</script> & "камера"`,
+			query:    "сопло камера",
+			expected: `<em>Сопло</em> &lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; &#34;<em>камера</em>`,
+		},
+		{
+			name:     "Нет совпадений",
+			text:     "Иллюминатор",
+			query:    "engine",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Highlight(tt.text, Terms(tt.query)); got != tt.expected {
+				t.Errorf("Highlight() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
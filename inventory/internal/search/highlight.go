@@ -0,0 +1,63 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	// HighlightStart и HighlightEnd обрамляют найденные слова во фрагменте
+	HighlightStart = "<em>"
+	HighlightEnd   = "</em>"
+
+	// snippetContext — сколько слов показывать до и после первого совпадения
+	snippetContext = 8
+	ellipsis       = "…"
+)
+
+// Highlight возвращает фрагмент текста вокруг первого совпадения с основами terms,
+// выделяя найденные слова. Текст экранируется для HTML, разметкой во фрагменте остаются
+// только маркеры выделения. Если совпадений нет, возвращается пустая строка
+func Highlight(text string, terms []string) string {
+	termSet := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		termSet[term] = true
+	}
+
+	tokens := Tokenize(text)
+	first := -1
+	for i, token := range tokens {
+		if termSet[token.Stem] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	from := max(first-snippetContext, 0)
+	to := min(first+snippetContext, len(tokens)-1)
+
+	var builder strings.Builder
+	if from > 0 {
+		builder.WriteString(ellipsis)
+	}
+
+	position := tokens[from].Start
+	for _, token := range tokens[from : to+1] {
+		builder.WriteString(html.EscapeString(text[position:token.Start]))
+		if termSet[token.Stem] {
+			builder.WriteString(HighlightStart + html.EscapeString(token.Text) + HighlightEnd)
+		} else {
+			builder.WriteString(html.EscapeString(token.Text))
+		}
+		position = token.End
+	}
+
+	if to < len(tokens)-1 {
+		builder.WriteString(ellipsis)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,66 @@
+package search
+
+import "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+
+// Поля детали, по которым выполняется поиск
+const (
+	FieldName         = "name"
+	FieldDescription  = "description"
+	FieldTags         = "tags"
+	FieldManufacturer = "manufacturer.name"
+)
+
+// Weights — вес совпадения в каждом поле: слово в названии важнее слова в описании
+var Weights = map[string]int{
+	FieldName:         10,
+	FieldTags:         5,
+	FieldManufacturer: 3,
+	FieldDescription:  1,
+}
+
+// Fields возвращает текст полей детали, участвующих в поиске
+func Fields(part *model.Part) map[string][]string {
+	return map[string][]string{
+		FieldName:         {part.Name},
+		FieldDescription:  {part.Description},
+		FieldTags:         part.Tags,
+		FieldManufacturer: {part.Manufacturer.Name},
+	}
+}
+
+// Score считает релевантность детали: сумма весов полей по всем совпавшим словам.
+// Используется там, где нет текстового индекса MongoDB
+func Score(part *model.Part, terms []string) float64 {
+	termSet := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		termSet[term] = true
+	}
+
+	var score float64
+	for field, texts := range Fields(part) {
+		for _, text := range texts {
+			for _, token := range Tokenize(text) {
+				if termSet[token.Stem] {
+					score += float64(Weights[field])
+				}
+			}
+		}
+	}
+
+	return score
+}
+
+// Highlights возвращает фрагменты полей детали, в которых нашлись слова запроса
+func Highlights(part *model.Part, terms []string) []model.Highlight {
+	fields := Fields(part)
+	highlights := make([]model.Highlight, 0)
+	for _, field := range []string{FieldName, FieldDescription, FieldTags, FieldManufacturer} {
+		for _, text := range fields[field] {
+			if snippet := Highlight(text, terms); snippet != "" {
+				highlights = append(highlights, model.Highlight{Field: field, Snippet: snippet})
+			}
+		}
+	}
+
+	return highlights
+}
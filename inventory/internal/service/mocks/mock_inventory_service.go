@@ -7,9 +7,13 @@ package mocks
 import (
 	context "context"
 
+	io "io"
+
 	model "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -26,29 +30,147 @@ func (_m *InventoryService) EXPECT() *InventoryService_Expecter {
 	return &InventoryService_Expecter{mock: &_m.Mock}
 }
 
-// GetPart provides a mock function with given fields: ctx, _a1
-func (_m *InventoryService) GetPart(ctx context.Context, _a1 uuid.UUID) (*model.Part, error) {
-	ret := _m.Called(ctx, _a1)
+// BatchCreateParts provides a mock function with given fields: ctx, parts
+func (_m *InventoryService) BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error) {
+	ret := _m.Called(ctx, parts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPart")
+		panic("no return value specified for BatchCreateParts")
+	}
+
+	var r0 *[]model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Part) (*[]model.Part, error)); ok {
+		return rf(ctx, parts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Part) *[]model.Part); ok {
+		r0 = rf(ctx, parts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.Part) error); ok {
+		r1 = rf(ctx, parts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_BatchCreateParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateParts'
+type InventoryService_BatchCreateParts_Call struct {
+	*mock.Call
+}
+
+// BatchCreateParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parts []model.Part
+func (_e *InventoryService_Expecter) BatchCreateParts(ctx interface{}, parts interface{}) *InventoryService_BatchCreateParts_Call {
+	return &InventoryService_BatchCreateParts_Call{Call: _e.mock.On("BatchCreateParts", ctx, parts)}
+}
+
+func (_c *InventoryService_BatchCreateParts_Call) Run(run func(ctx context.Context, parts []model.Part)) *InventoryService_BatchCreateParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryService_BatchCreateParts_Call) Return(_a0 *[]model.Part, _a1 error) *InventoryService_BatchCreateParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_BatchCreateParts_Call) RunAndReturn(run func(context.Context, []model.Part) (*[]model.Part, error)) *InventoryService_BatchCreateParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateManufacturer provides a mock function with given fields: ctx, manufacturer
+func (_m *InventoryService) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, manufacturer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManufacturer")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) (*model.Manufacturer, error)); ok {
+		return rf(ctx, manufacturer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) *model.Manufacturer); ok {
+		r0 = rf(ctx, manufacturer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Manufacturer) error); ok {
+		r1 = rf(ctx, manufacturer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_CreateManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManufacturer'
+type InventoryService_CreateManufacturer_Call struct {
+	*mock.Call
+}
+
+// CreateManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manufacturer *model.Manufacturer
+func (_e *InventoryService_Expecter) CreateManufacturer(ctx interface{}, manufacturer interface{}) *InventoryService_CreateManufacturer_Call {
+	return &InventoryService_CreateManufacturer_Call{Call: _e.mock.On("CreateManufacturer", ctx, manufacturer)}
+}
+
+func (_c *InventoryService_CreateManufacturer_Call) Run(run func(ctx context.Context, manufacturer *model.Manufacturer)) *InventoryService_CreateManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Manufacturer))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CreateManufacturer_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryService_CreateManufacturer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CreateManufacturer_Call) RunAndReturn(run func(context.Context, *model.Manufacturer) (*model.Manufacturer, error)) *InventoryService_CreateManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *InventoryService) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
 	}
 
 	var r0 *model.Part
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Part, error)); ok {
-		return rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, part)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Part); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, part)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Part)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part) error); ok {
+		r1 = rf(ctx, part)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,58 +178,280 @@ func (_m *InventoryService) GetPart(ctx context.Context, _a1 uuid.UUID) (*model.
 	return r0, r1
 }
 
-// InventoryService_GetPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPart'
-type InventoryService_GetPart_Call struct {
+// InventoryService_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type InventoryService_CreatePart_Call struct {
 	*mock.Call
 }
 
-// GetPart is a helper method to define mock.On call
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *InventoryService_Expecter) CreatePart(ctx interface{}, part interface{}) *InventoryService_CreatePart_Call {
+	return &InventoryService_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *InventoryService_CreatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *InventoryService_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CreatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CreatePart_Call) RunAndReturn(run func(context.Context, *model.Part) (*model.Part, error)) *InventoryService_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteManufacturer provides a mock function with given fields: ctx, _a1
+func (_m *InventoryService) DeleteManufacturer(ctx context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteManufacturer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_DeleteManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteManufacturer'
+type InventoryService_DeleteManufacturer_Call struct {
+	*mock.Call
+}
+
+// DeleteManufacturer is a helper method to define mock.On call
 //   - ctx context.Context
 //   - _a1 uuid.UUID
-func (_e *InventoryService_Expecter) GetPart(ctx interface{}, _a1 interface{}) *InventoryService_GetPart_Call {
-	return &InventoryService_GetPart_Call{Call: _e.mock.On("GetPart", ctx, _a1)}
+func (_e *InventoryService_Expecter) DeleteManufacturer(ctx interface{}, _a1 interface{}) *InventoryService_DeleteManufacturer_Call {
+	return &InventoryService_DeleteManufacturer_Call{Call: _e.mock.On("DeleteManufacturer", ctx, _a1)}
 }
 
-func (_c *InventoryService_GetPart_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryService_GetPart_Call {
+func (_c *InventoryService_DeleteManufacturer_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryService_DeleteManufacturer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *InventoryService_GetPart_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_GetPart_Call {
+func (_c *InventoryService_DeleteManufacturer_Call) Return(_a0 error) *InventoryService_DeleteManufacturer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_DeleteManufacturer_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InventoryService_DeleteManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, _a1
+func (_m *InventoryService) DeletePart(ctx context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type InventoryService_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryService_Expecter) DeletePart(ctx interface{}, _a1 interface{}) *InventoryService_DeletePart_Call {
+	return &InventoryService_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, _a1)}
+}
+
+func (_c *InventoryService_DeletePart_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryService_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryService_DeletePart_Call) Return(_a0 error) *InventoryService_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_DeletePart_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InventoryService_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadAttachment provides a mock function with given fields: ctx, partUuid, attachmentUuid
+func (_m *InventoryService) DownloadAttachment(ctx context.Context, partUuid uuid.UUID, attachmentUuid uuid.UUID) (*model.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, partUuid, attachmentUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadAttachment")
+	}
+
+	var r0 *model.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*model.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, partUuid, attachmentUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *model.Attachment); ok {
+		r0 = rf(ctx, partUuid, attachmentUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) io.ReadCloser); ok {
+		r1 = rf(ctx, partUuid, attachmentUuid)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r2 = rf(ctx, partUuid, attachmentUuid)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// InventoryService_DownloadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadAttachment'
+type InventoryService_DownloadAttachment_Call struct {
+	*mock.Call
+}
+
+// DownloadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid uuid.UUID
+//   - attachmentUuid uuid.UUID
+func (_e *InventoryService_Expecter) DownloadAttachment(ctx interface{}, partUuid interface{}, attachmentUuid interface{}) *InventoryService_DownloadAttachment_Call {
+	return &InventoryService_DownloadAttachment_Call{Call: _e.mock.On("DownloadAttachment", ctx, partUuid, attachmentUuid)}
+}
+
+func (_c *InventoryService_DownloadAttachment_Call) Run(run func(ctx context.Context, partUuid uuid.UUID, attachmentUuid uuid.UUID)) *InventoryService_DownloadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryService_DownloadAttachment_Call) Return(_a0 *model.Attachment, _a1 io.ReadCloser, _a2 error) *InventoryService_DownloadAttachment_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *InventoryService_DownloadAttachment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*model.Attachment, io.ReadCloser, error)) *InventoryService_DownloadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpandKit provides a mock function with given fields: ctx, kitUuid
+func (_m *InventoryService) ExpandKit(ctx context.Context, kitUuid uuid.UUID) (*model.KitExpansion, error) {
+	ret := _m.Called(ctx, kitUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandKit")
+	}
+
+	var r0 *model.KitExpansion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.KitExpansion, error)); ok {
+		return rf(ctx, kitUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.KitExpansion); ok {
+		r0 = rf(ctx, kitUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.KitExpansion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, kitUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ExpandKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandKit'
+type InventoryService_ExpandKit_Call struct {
+	*mock.Call
+}
+
+// ExpandKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kitUuid uuid.UUID
+func (_e *InventoryService_Expecter) ExpandKit(ctx interface{}, kitUuid interface{}) *InventoryService_ExpandKit_Call {
+	return &InventoryService_ExpandKit_Call{Call: _e.mock.On("ExpandKit", ctx, kitUuid)}
+}
+
+func (_c *InventoryService_ExpandKit_Call) Run(run func(ctx context.Context, kitUuid uuid.UUID)) *InventoryService_ExpandKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ExpandKit_Call) Return(_a0 *model.KitExpansion, _a1 error) *InventoryService_ExpandKit_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryService_GetPart_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Part, error)) *InventoryService_GetPart_Call {
+func (_c *InventoryService_ExpandKit_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.KitExpansion, error)) *InventoryService_ExpandKit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *InventoryService) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// GetCompatibleParts provides a mock function with given fields: ctx, partUuid, categories
+func (_m *InventoryService) GetCompatibleParts(ctx context.Context, partUuid uuid.UUID, categories []model.Category) ([]model.CompatiblePart, error) {
+	ret := _m.Called(ctx, partUuid, categories)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListParts")
+		panic("no return value specified for GetCompatibleParts")
 	}
 
-	var r0 *[]model.Part
+	var r0 []model.CompatiblePart
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter) (*[]model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.Category) ([]model.CompatiblePart, error)); ok {
+		return rf(ctx, partUuid, categories)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter) *[]model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.Category) []model.CompatiblePart); ok {
+		r0 = rf(ctx, partUuid, categories)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]model.Part)
+			r0 = ret.Get(0).([]model.CompatiblePart)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Filter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []model.Category) error); ok {
+		r1 = rf(ctx, partUuid, categories)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -115,31 +459,965 @@ func (_m *InventoryService) ListParts(ctx context.Context, filter *model.Filter)
 	return r0, r1
 }
 
-// InventoryService_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
-type InventoryService_ListParts_Call struct {
+// InventoryService_GetCompatibleParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompatibleParts'
+type InventoryService_GetCompatibleParts_Call struct {
 	*mock.Call
 }
 
-// ListParts is a helper method to define mock.On call
+// GetCompatibleParts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter *model.Filter
-func (_e *InventoryService_Expecter) ListParts(ctx interface{}, filter interface{}) *InventoryService_ListParts_Call {
-	return &InventoryService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - partUuid uuid.UUID
+//   - categories []model.Category
+func (_e *InventoryService_Expecter) GetCompatibleParts(ctx interface{}, partUuid interface{}, categories interface{}) *InventoryService_GetCompatibleParts_Call {
+	return &InventoryService_GetCompatibleParts_Call{Call: _e.mock.On("GetCompatibleParts", ctx, partUuid, categories)}
 }
 
-func (_c *InventoryService_ListParts_Call) Run(run func(ctx context.Context, filter *model.Filter)) *InventoryService_ListParts_Call {
+func (_c *InventoryService_GetCompatibleParts_Call) Run(run func(ctx context.Context, partUuid uuid.UUID, categories []model.Category)) *InventoryService_GetCompatibleParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Filter))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]model.Category))
 	})
 	return _c
 }
 
-func (_c *InventoryService_ListParts_Call) Return(_a0 *[]model.Part, _a1 error) *InventoryService_ListParts_Call {
+func (_c *InventoryService_GetCompatibleParts_Call) Return(_a0 []model.CompatiblePart, _a1 error) *InventoryService_GetCompatibleParts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context, *model.Filter) (*[]model.Part, error)) *InventoryService_ListParts_Call {
+func (_c *InventoryService_GetCompatibleParts_Call) RunAndReturn(run func(context.Context, uuid.UUID, []model.Category) ([]model.CompatiblePart, error)) *InventoryService_GetCompatibleParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetManufacturer provides a mock function with given fields: ctx, _a1
+func (_m *InventoryService) GetManufacturer(ctx context.Context, _a1 uuid.UUID) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetManufacturer")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Manufacturer, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Manufacturer); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_GetManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetManufacturer'
+type InventoryService_GetManufacturer_Call struct {
+	*mock.Call
+}
+
+// GetManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryService_Expecter) GetManufacturer(ctx interface{}, _a1 interface{}) *InventoryService_GetManufacturer_Call {
+	return &InventoryService_GetManufacturer_Call{Call: _e.mock.On("GetManufacturer", ctx, _a1)}
+}
+
+func (_c *InventoryService_GetManufacturer_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryService_GetManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryService_GetManufacturer_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryService_GetManufacturer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_GetManufacturer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Manufacturer, error)) *InventoryService_GetManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPart provides a mock function with given fields: ctx, _a1
+func (_m *InventoryService) GetPart(ctx context.Context, _a1 uuid.UUID) (*model.Part, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Part, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Part); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_GetPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPart'
+type InventoryService_GetPart_Call struct {
+	*mock.Call
+}
+
+// GetPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *InventoryService_Expecter) GetPart(ctx interface{}, _a1 interface{}) *InventoryService_GetPart_Call {
+	return &InventoryService_GetPart_Call{Call: _e.mock.On("GetPart", ctx, _a1)}
+}
+
+func (_c *InventoryService_GetPart_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *InventoryService_GetPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryService_GetPart_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_GetPart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_GetPart_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Part, error)) *InventoryService_GetPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartAt provides a mock function with given fields: ctx, _a1, priceAt
+func (_m *InventoryService) GetPartAt(ctx context.Context, _a1 uuid.UUID, priceAt time.Time) (*model.Part, error) {
+	ret := _m.Called(ctx, _a1, priceAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartAt")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*model.Part, error)); ok {
+		return rf(ctx, _a1, priceAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *model.Part); ok {
+		r0 = rf(ctx, _a1, priceAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, _a1, priceAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_GetPartAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartAt'
+type InventoryService_GetPartAt_Call struct {
+	*mock.Call
+}
+
+// GetPartAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+//   - priceAt time.Time
+func (_e *InventoryService_Expecter) GetPartAt(ctx interface{}, _a1 interface{}, priceAt interface{}) *InventoryService_GetPartAt_Call {
+	return &InventoryService_GetPartAt_Call{Call: _e.mock.On("GetPartAt", ctx, _a1, priceAt)}
+}
+
+func (_c *InventoryService_GetPartAt_Call) Run(run func(ctx context.Context, _a1 uuid.UUID, priceAt time.Time)) *InventoryService_GetPartAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryService_GetPartAt_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_GetPartAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_GetPartAt_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (*model.Part, error)) *InventoryService_GetPartAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, partUuid
+func (_m *InventoryService) GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error) {
+	ret := _m.Called(ctx, partUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []model.PricePeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.PricePeriod, error)); ok {
+		return rf(ctx, partUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.PricePeriod); ok {
+		r0 = rf(ctx, partUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PricePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, partUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type InventoryService_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid uuid.UUID
+func (_e *InventoryService_Expecter) GetPriceHistory(ctx interface{}, partUuid interface{}) *InventoryService_GetPriceHistory_Call {
+	return &InventoryService_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", ctx, partUuid)}
+}
+
+func (_c *InventoryService_GetPriceHistory_Call) Run(run func(ctx context.Context, partUuid uuid.UUID)) *InventoryService_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryService_GetPriceHistory_Call) Return(_a0 []model.PricePeriod, _a1 error) *InventoryService_GetPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_GetPriceHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.PricePeriod, error)) *InventoryService_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKits provides a mock function with given fields: ctx
+func (_m *InventoryService) ListKits(ctx context.Context) ([]model.Kit, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKits")
+	}
+
+	var r0 []model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Kit, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Kit); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListKits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKits'
+type InventoryService_ListKits_Call struct {
+	*mock.Call
+}
+
+// ListKits is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryService_Expecter) ListKits(ctx interface{}) *InventoryService_ListKits_Call {
+	return &InventoryService_ListKits_Call{Call: _e.mock.On("ListKits", ctx)}
+}
+
+func (_c *InventoryService_ListKits_Call) Run(run func(ctx context.Context)) *InventoryService_ListKits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListKits_Call) Return(_a0 []model.Kit, _a1 error) *InventoryService_ListKits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListKits_Call) RunAndReturn(run func(context.Context) ([]model.Kit, error)) *InventoryService_ListKits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListManufacturers provides a mock function with given fields: ctx, filter
+func (_m *InventoryService) ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListManufacturers")
+	}
+
+	var r0 []model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ManufacturerFilter) ([]model.Manufacturer, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ManufacturerFilter) []model.Manufacturer); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ManufacturerFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListManufacturers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListManufacturers'
+type InventoryService_ListManufacturers_Call struct {
+	*mock.Call
+}
+
+// ListManufacturers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.ManufacturerFilter
+func (_e *InventoryService_Expecter) ListManufacturers(ctx interface{}, filter interface{}) *InventoryService_ListManufacturers_Call {
+	return &InventoryService_ListManufacturers_Call{Call: _e.mock.On("ListManufacturers", ctx, filter)}
+}
+
+func (_c *InventoryService_ListManufacturers_Call) Run(run func(ctx context.Context, filter *model.ManufacturerFilter)) *InventoryService_ListManufacturers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ManufacturerFilter))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListManufacturers_Call) Return(_a0 []model.Manufacturer, _a1 error) *InventoryService_ListManufacturers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListManufacturers_Call) RunAndReturn(run func(context.Context, *model.ManufacturerFilter) ([]model.Manufacturer, error)) *InventoryService_ListManufacturers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParts provides a mock function with given fields: ctx, filter, page
+func (_m *InventoryService) ListParts(ctx context.Context, filter *model.Filter, page *model.PageRequest) (*model.PartsPage, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListParts")
+	}
+
+	var r0 *model.PartsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter, *model.PageRequest) (*model.PartsPage, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Filter, *model.PageRequest) *model.PartsPage); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PartsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Filter, *model.PageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListParts'
+type InventoryService_ListParts_Call struct {
+	*mock.Call
+}
+
+// ListParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *model.Filter
+//   - page *model.PageRequest
+func (_e *InventoryService_Expecter) ListParts(ctx interface{}, filter interface{}, page interface{}) *InventoryService_ListParts_Call {
+	return &InventoryService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter, page)}
+}
+
+func (_c *InventoryService_ListParts_Call) Run(run func(ctx context.Context, filter *model.Filter, page *model.PageRequest)) *InventoryService_ListParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Filter), args[2].(*model.PageRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListParts_Call) Return(_a0 *model.PartsPage, _a1 error) *InventoryService_ListParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListParts_Call) RunAndReturn(run func(context.Context, *model.Filter, *model.PageRequest) (*model.PartsPage, error)) *InventoryService_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWarehouses provides a mock function with given fields: ctx
+func (_m *InventoryService) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWarehouses")
+	}
+
+	var r0 []model.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWarehouses'
+type InventoryService_ListWarehouses_Call struct {
+	*mock.Call
+}
+
+// ListWarehouses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryService_Expecter) ListWarehouses(ctx interface{}) *InventoryService_ListWarehouses_Call {
+	return &InventoryService_ListWarehouses_Call{Call: _e.mock.On("ListWarehouses", ctx)}
+}
+
+func (_c *InventoryService_ListWarehouses_Call) Run(run func(ctx context.Context)) *InventoryService_ListWarehouses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListWarehouses_Call) Return(_a0 []model.Warehouse, _a1 error) *InventoryService_ListWarehouses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListWarehouses_Call) RunAndReturn(run func(context.Context) ([]model.Warehouse, error)) *InventoryService_ListWarehouses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchedulePrice provides a mock function with given fields: ctx, partUuid, price, from
+func (_m *InventoryService) SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error) {
+	ret := _m.Called(ctx, partUuid, price, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulePrice")
+	}
+
+	var r0 []model.PricePeriod
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64, time.Time) ([]model.PricePeriod, error)); ok {
+		return rf(ctx, partUuid, price, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, float64, time.Time) []model.PricePeriod); ok {
+		r0 = rf(ctx, partUuid, price, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PricePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, float64, time.Time) error); ok {
+		r1 = rf(ctx, partUuid, price, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_SchedulePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePrice'
+type InventoryService_SchedulePrice_Call struct {
+	*mock.Call
+}
+
+// SchedulePrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid uuid.UUID
+//   - price float64
+//   - from time.Time
+func (_e *InventoryService_Expecter) SchedulePrice(ctx interface{}, partUuid interface{}, price interface{}, from interface{}) *InventoryService_SchedulePrice_Call {
+	return &InventoryService_SchedulePrice_Call{Call: _e.mock.On("SchedulePrice", ctx, partUuid, price, from)}
+}
+
+func (_c *InventoryService_SchedulePrice_Call) Run(run func(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time)) *InventoryService_SchedulePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(float64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryService_SchedulePrice_Call) Return(_a0 []model.PricePeriod, _a1 error) *InventoryService_SchedulePrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_SchedulePrice_Call) RunAndReturn(run func(context.Context, uuid.UUID, float64, time.Time) ([]model.PricePeriod, error)) *InventoryService_SchedulePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, req
+func (_m *InventoryService) SearchParts(ctx context.Context, req *model.SearchRequest) (*model.SearchPage, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 *model.SearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SearchRequest) (*model.SearchPage, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SearchRequest) *model.SearchPage); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SearchPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SearchRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type InventoryService_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.SearchRequest
+func (_e *InventoryService_Expecter) SearchParts(ctx interface{}, req interface{}) *InventoryService_SearchParts_Call {
+	return &InventoryService_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, req)}
+}
+
+func (_c *InventoryService_SearchParts_Call) Run(run func(ctx context.Context, req *model.SearchRequest)) *InventoryService_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SearchRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_SearchParts_Call) Return(_a0 *model.SearchPage, _a1 error) *InventoryService_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_SearchParts_Call) RunAndReturn(run func(context.Context, *model.SearchRequest) (*model.SearchPage, error)) *InventoryService_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncPrices provides a mock function with given fields: ctx
+func (_m *InventoryService) SyncPrices(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncPrices")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_SyncPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncPrices'
+type InventoryService_SyncPrices_Call struct {
+	*mock.Call
+}
+
+// SyncPrices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryService_Expecter) SyncPrices(ctx interface{}) *InventoryService_SyncPrices_Call {
+	return &InventoryService_SyncPrices_Call{Call: _e.mock.On("SyncPrices", ctx)}
+}
+
+func (_c *InventoryService_SyncPrices_Call) Run(run func(ctx context.Context)) *InventoryService_SyncPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryService_SyncPrices_Call) Return(_a0 int64, _a1 error) *InventoryService_SyncPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_SyncPrices_Call) RunAndReturn(run func(context.Context) (int64, error)) *InventoryService_SyncPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferStock provides a mock function with given fields: ctx, transfer
+func (_m *InventoryService) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error) {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferStock")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) (*model.Part, error)); ok {
+		return rf(ctx, transfer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) *model.Part); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockTransfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_TransferStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferStock'
+type InventoryService_TransferStock_Call struct {
+	*mock.Call
+}
+
+// TransferStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer *model.StockTransfer
+func (_e *InventoryService_Expecter) TransferStock(ctx interface{}, transfer interface{}) *InventoryService_TransferStock_Call {
+	return &InventoryService_TransferStock_Call{Call: _e.mock.On("TransferStock", ctx, transfer)}
+}
+
+func (_c *InventoryService_TransferStock_Call) Run(run func(ctx context.Context, transfer *model.StockTransfer)) *InventoryService_TransferStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockTransfer))
+	})
+	return _c
+}
+
+func (_c *InventoryService_TransferStock_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_TransferStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_TransferStock_Call) RunAndReturn(run func(context.Context, *model.StockTransfer) (*model.Part, error)) *InventoryService_TransferStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateManufacturer provides a mock function with given fields: ctx, manufacturer, paths
+func (_m *InventoryService) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer, paths []string) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, manufacturer, paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManufacturer")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer, []string) (*model.Manufacturer, error)); ok {
+		return rf(ctx, manufacturer, paths)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer, []string) *model.Manufacturer); ok {
+		r0 = rf(ctx, manufacturer, paths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Manufacturer, []string) error); ok {
+		r1 = rf(ctx, manufacturer, paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_UpdateManufacturer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManufacturer'
+type InventoryService_UpdateManufacturer_Call struct {
+	*mock.Call
+}
+
+// UpdateManufacturer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manufacturer *model.Manufacturer
+//   - paths []string
+func (_e *InventoryService_Expecter) UpdateManufacturer(ctx interface{}, manufacturer interface{}, paths interface{}) *InventoryService_UpdateManufacturer_Call {
+	return &InventoryService_UpdateManufacturer_Call{Call: _e.mock.On("UpdateManufacturer", ctx, manufacturer, paths)}
+}
+
+func (_c *InventoryService_UpdateManufacturer_Call) Run(run func(ctx context.Context, manufacturer *model.Manufacturer, paths []string)) *InventoryService_UpdateManufacturer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Manufacturer), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_UpdateManufacturer_Call) Return(_a0 *model.Manufacturer, _a1 error) *InventoryService_UpdateManufacturer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_UpdateManufacturer_Call) RunAndReturn(run func(context.Context, *model.Manufacturer, []string) (*model.Manufacturer, error)) *InventoryService_UpdateManufacturer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, part, paths
+func (_m *InventoryService) UpdatePart(ctx context.Context, part *model.Part, paths []string) (*model.Part, error) {
+	ret := _m.Called(ctx, part, paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part, []string) (*model.Part, error)); ok {
+		return rf(ctx, part, paths)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part, []string) *model.Part); ok {
+		r0 = rf(ctx, part, paths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part, []string) error); ok {
+		r1 = rf(ctx, part, paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type InventoryService_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+//   - paths []string
+func (_e *InventoryService_Expecter) UpdatePart(ctx interface{}, part interface{}, paths interface{}) *InventoryService_UpdatePart_Call {
+	return &InventoryService_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, part, paths)}
+}
+
+func (_c *InventoryService_UpdatePart_Call) Run(run func(ctx context.Context, part *model.Part, paths []string)) *InventoryService_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *InventoryService_UpdatePart_Call) Return(_a0 *model.Part, _a1 error) *InventoryService_UpdatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_UpdatePart_Call) RunAndReturn(run func(context.Context, *model.Part, []string) (*model.Part, error)) *InventoryService_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachment provides a mock function with given fields: ctx, upload
+func (_m *InventoryService) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload) (*model.Attachment, error) {
+	ret := _m.Called(ctx, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 *model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AttachmentUpload) (*model.Attachment, error)); ok {
+		return rf(ctx, upload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AttachmentUpload) *model.Attachment); ok {
+		r0 = rf(ctx, upload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AttachmentUpload) error); ok {
+		r1 = rf(ctx, upload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_UploadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachment'
+type InventoryService_UploadAttachment_Call struct {
+	*mock.Call
+}
+
+// UploadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upload *model.AttachmentUpload
+func (_e *InventoryService_Expecter) UploadAttachment(ctx interface{}, upload interface{}) *InventoryService_UploadAttachment_Call {
+	return &InventoryService_UploadAttachment_Call{Call: _e.mock.On("UploadAttachment", ctx, upload)}
+}
+
+func (_c *InventoryService_UploadAttachment_Call) Run(run func(ctx context.Context, upload *model.AttachmentUpload)) *InventoryService_UploadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AttachmentUpload))
+	})
+	return _c
+}
+
+func (_c *InventoryService_UploadAttachment_Call) Return(_a0 *model.Attachment, _a1 error) *InventoryService_UploadAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_UploadAttachment_Call) RunAndReturn(run func(context.Context, *model.AttachmentUpload) (*model.Attachment, error)) *InventoryService_UploadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchParts provides a mock function with given fields: ctx, req
+func (_m *InventoryService) WatchParts(ctx context.Context, req *model.WatchRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WatchRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryService_WatchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchParts'
+type InventoryService_WatchParts_Call struct {
+	*mock.Call
+}
+
+// WatchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.WatchRequest
+func (_e *InventoryService_Expecter) WatchParts(ctx interface{}, req interface{}) *InventoryService_WatchParts_Call {
+	return &InventoryService_WatchParts_Call{Call: _e.mock.On("WatchParts", ctx, req)}
+}
+
+func (_c *InventoryService_WatchParts_Call) Run(run func(ctx context.Context, req *model.WatchRequest)) *InventoryService_WatchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.WatchRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_WatchParts_Call) Return(_a0 error) *InventoryService_WatchParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryService_WatchParts_Call) RunAndReturn(run func(context.Context, *model.WatchRequest) error) *InventoryService_WatchParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,133 @@
+package part
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+// sniffLen — сколько первых байт содержимого нужно http.DetectContentType
+const sniffLen = 512
+
+// UploadAttachment сохраняет содержимое в блоб-хранилище и дописывает описание вложения в деталь.
+// Размер и контрольная сумма считаются по мере записи; если содержимое не принято,
+// записанный блоб удаляется
+func (s *service) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload) (*model.Attachment, error) {
+	if err := upload.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetPart(ctx, upload.PartUuid); err != nil {
+		return nil, fmt.Errorf("service: failed to get part from repository: %w", err)
+	}
+
+	content := bufio.NewReaderSize(upload.Content, sniffLen)
+
+	contentType := upload.ContentType
+	if contentType == "" {
+		head, err := content.Peek(sniffLen)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("service: failed to read attachment content: %w", err)
+		}
+		contentType = http.DetectContentType(head)
+	}
+
+	attachment := &model.Attachment{
+		AttachmentUuid: uuid.New(),
+		FileName:       upload.FileName,
+		ContentType:    contentType,
+		Kind:           upload.Kind,
+		CreatedAt:      time.Now().UTC(),
+	}
+	key := model.BlobKey(upload.PartUuid, attachment.AttachmentUuid)
+
+	counter := &countingReader{r: content, hash: sha256.New(), limit: s.maxAttachment}
+	if err := s.blobs.Put(ctx, key, counter, contentType); err != nil {
+		return nil, fmt.Errorf("service: failed to put attachment content to storage: %w", err)
+	}
+
+	attachment.SizeBytes = counter.n
+	attachment.ChecksumSha256 = hex.EncodeToString(counter.hash.Sum(nil))
+
+	var err error
+	switch {
+	case attachment.SizeBytes == 0:
+		err = fmt.Errorf("%w: content must not be empty", model.ErrInvalidAttachment)
+	case upload.ChecksumSha256 != "" && upload.ChecksumSha256 != attachment.ChecksumSha256:
+		err = fmt.Errorf("%w: checksum_sha256 mismatch, content has %s", model.ErrInvalidAttachment, attachment.ChecksumSha256)
+	default:
+		if _, err = s.repo.AddAttachment(ctx, upload.PartUuid, attachment); err != nil {
+			err = fmt.Errorf("service: failed to add attachment in repository: %w", err)
+		}
+	}
+	if err != nil {
+		s.deleteBlob(ctx, key)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// DownloadAttachment возвращает описание вложения и его содержимое. Читатель закрывает вызывающий
+func (s *service) DownloadAttachment(ctx context.Context, partUuid, attachmentUuid uuid.UUID) (*model.Attachment, io.ReadCloser, error) {
+	part, err := s.repo.GetPart(ctx, partUuid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("service: failed to get part from repository: %w", err)
+	}
+
+	attachment, err := part.Attachment(attachmentUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Get(ctx, model.BlobKey(partUuid, attachmentUuid))
+	if err != nil {
+		return nil, nil, fmt.Errorf("service: failed to get attachment content from storage: %w", err)
+	}
+
+	return attachment, content, nil
+}
+
+// deleteBlob удаляет непринятое содержимое. Запрос к этому моменту может быть уже отменён,
+// поэтому удаление не зависит от его контекста; ошибка только логируется
+func (s *service) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+		logger.Error(ctx, "Failed to delete rejected attachment content",
+			zap.String("key", key),
+			zap.Error(err))
+	}
+}
+
+// countingReader считает прочитанные байты и их сумму и обрывает чтение
+// с ErrAttachmentTooLarge, как только содержимое превысит limit
+type countingReader struct {
+	r     io.Reader
+	hash  hash.Hash
+	limit int64
+	n     int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	c.hash.Write(p[:n])
+
+	if c.n > c.limit {
+		return n, fmt.Errorf("%w: limit is %d bytes", model.ErrAttachmentTooLarge, c.limit)
+	}
+
+	return n, err
+}
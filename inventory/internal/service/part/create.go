@@ -0,0 +1,85 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *service) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	prepareNewPart(part, time.Now().UTC())
+
+	if err := part.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkWarehouses(ctx, part); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolveManufacturer(ctx, part); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.CreatePart(ctx, part)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to create part in repository: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *service) BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error) {
+	now := time.Now().UTC()
+
+	// Повтор идентификатора в пачке отклоняется до обращения к репозиторию
+	seen := make(map[uuid.UUID]int, len(parts))
+	for i := range parts {
+		prepareNewPart(&parts[i], now)
+
+		if first, ok := seen[parts[i].PartUuid]; ok {
+			return nil, fmt.Errorf("part #%d: %w: part_uuid duplicates part #%d", i, model.ErrInvalidPart, first)
+		}
+		seen[parts[i].PartUuid] = i
+
+		if err := parts[i].Validate(); err != nil {
+			return nil, fmt.Errorf("part #%d: %w", i, err)
+		}
+
+		if err := s.checkWarehouses(ctx, &parts[i]); err != nil {
+			return nil, fmt.Errorf("part #%d: %w", i, err)
+		}
+	}
+
+	// Производители заводятся только после проверки всей пачки
+	for i := range parts {
+		if err := s.resolveManufacturer(ctx, &parts[i]); err != nil {
+			return nil, fmt.Errorf("part #%d: %w", i, err)
+		}
+	}
+
+	created, err := s.repo.BatchCreateParts(ctx, parts)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to create parts in repository: %w", err)
+	}
+
+	return created, nil
+}
+
+// prepareNewPart выдаёт идентификатор, если он не задан, выставляет даты создания
+// и пересчитывает общий остаток по складам. Вложения загружаются отдельно через UploadAttachment
+func prepareNewPart(part *model.Part, now time.Time) {
+	if part.PartUuid == uuid.Nil {
+		part.PartUuid = uuid.New()
+	}
+
+	part.CreatedAt = now
+	part.UpdatedAt = now
+	part.DeletedAt = nil
+	part.Attachments = nil
+	part.SyncStockQuantity()
+}
@@ -0,0 +1,18 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) DeletePart(ctx context.Context, uuid uuid.UUID) error {
+	err := s.repo.DeletePart(ctx, uuid, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("service: failed to delete part in repository: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package part
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,10 +11,25 @@ import (
 )
 
 func (s *service) GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part, error) {
-	part, err := s.repo.GetPart(ctx, uuid)
+	return s.GetPartAt(ctx, uuid, time.Now().UTC())
+}
+
+func (s *service) GetPartAt(ctx context.Context, id uuid.UUID, priceAt time.Time) (*model.Part, error) {
+	part, err := s.repo.GetPart(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("service: failed to get part from repository: %w", err)
 	}
 
+	prices, err := s.repo.GetPricesAt(ctx, []uuid.UUID{id}, priceAt)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get part price from repository: %w", err)
+	}
+
+	price, ok := prices[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: part has no price at %s", model.ErrPriceNotFound, priceAt.Format(time.RFC3339))
+	}
+	part.Price = price
+
 	return part, nil
 }
@@ -0,0 +1,88 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *service) GetCompatibleParts(ctx context.Context, partUuid uuid.UUID, categories []model.Category) ([]model.CompatiblePart, error) {
+	if _, err := s.repo.GetPart(ctx, partUuid); err != nil {
+		return nil, fmt.Errorf("service: failed to get part from repository: %w", err)
+	}
+
+	edges, err := s.repo.ListCompatibility(ctx, partUuid)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get compatibility from repository: %w", err)
+	}
+	if len(edges) == 0 {
+		return nil, nil
+	}
+
+	notes := make(map[uuid.UUID]string, len(edges))
+	ids := make([]uuid.UUID, 0, len(edges))
+	for _, edge := range edges {
+		notes[edge.CompatibleUuid] = edge.Note
+		ids = append(ids, edge.CompatibleUuid)
+	}
+
+	// Удалённые детали в выборку не попадают, поэтому рёбра к ним не возвращаются
+	parts, err := s.listPartsByUuids(ctx, &model.Filter{Uuids: ids, Categories: categories})
+	if err != nil {
+		return nil, err
+	}
+
+	compatible := make([]model.CompatiblePart, 0, len(parts))
+	for _, part := range parts {
+		compatible = append(compatible, model.CompatiblePart{Part: part, Note: notes[part.PartUuid]})
+	}
+
+	slices.SortFunc(compatible, func(a, b model.CompatiblePart) int {
+		return strings.Compare(a.Part.PartUuid.String(), b.Part.PartUuid.String())
+	})
+
+	return compatible, nil
+}
+
+func (s *service) ListKits(ctx context.Context) ([]model.Kit, error) {
+	kits, err := s.repo.ListKits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get list of kits from repository: %w", err)
+	}
+
+	return kits, nil
+}
+
+func (s *service) ExpandKit(ctx context.Context, kitUuid uuid.UUID) (*model.KitExpansion, error) {
+	kit, err := s.repo.GetKit(ctx, kitUuid)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get kit from repository: %w", err)
+	}
+
+	parts, err := s.listPartsByUuids(ctx, &model.Filter{Uuids: kit.PartUuids()})
+	if err != nil {
+		return nil, err
+	}
+
+	return kit.Expand(parts)
+}
+
+// listPartsByUuids читает все детали под фильтр без постраничной выдачи, с текущими ценами
+func (s *service) listPartsByUuids(ctx context.Context, filter *model.Filter) ([]model.Part, error) {
+	parts, err := s.repo.ListParts(ctx, filter, &model.ListQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get list of parts from repository: %w", err)
+	}
+
+	if err = s.applyPricesAt(ctx, *parts, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	return *parts, nil
+}
@@ -3,15 +3,55 @@ package part
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 )
 
-func (s *service) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
-	parts, err := s.repo.ListParts(ctx, filter)
+func (s *service) ListParts(ctx context.Context, filter *model.Filter, page *model.PageRequest) (*model.PartsPage, error) {
+	if page == nil {
+		page = &model.PageRequest{}
+	}
+
+	size := page.Size
+	if size <= 0 {
+		size = model.DefaultPageSize
+	}
+	size = min(size, model.MaxPageSize)
+
+	query := &model.ListQuery{
+		// Лишняя деталь показывает, есть ли следующая страница
+		Limit:  size + 1,
+		Sort:   page.Sort,
+		Fields: page.Fields,
+	}
+
+	if page.Token != "" {
+		after, err := model.DecodePageToken(page.Token, page.Sort)
+		if err != nil {
+			return nil, err
+		}
+		query.After = after
+	}
+
+	parts, err := s.repo.ListParts(ctx, filter, query)
 	if err != nil {
 		return nil, fmt.Errorf("service: failed to get list of parts from repository: %w", err)
 	}
 
-	return parts, nil
+	result := &model.PartsPage{Parts: *parts}
+	if len(result.Parts) > size {
+		result.Parts = result.Parts[:size]
+		result.NextPageToken = model.NewPageCursor(&result.Parts[size-1], page.Sort).Encode()
+	}
+
+	// Курсор строится по текущей цене, по которой отсортирован список, поэтому
+	// цены на запрошенный момент подставляются уже после него
+	if len(page.Fields) == 0 || slices.Contains(page.Fields, "price") {
+		if err = s.applyPricesAt(ctx, result.Parts, page.PriceAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
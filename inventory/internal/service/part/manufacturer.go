@@ -0,0 +1,139 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *service) CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	manufacturer.Name = strings.TrimSpace(manufacturer.Name)
+	if err := manufacturer.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	manufacturer.ManufacturerUuid = uuid.New()
+	manufacturer.CreatedAt = now
+	manufacturer.UpdatedAt = now
+
+	created, err := s.repo.CreateManufacturer(ctx, manufacturer)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to create manufacturer in repository: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *service) GetManufacturer(ctx context.Context, uuid uuid.UUID) (*model.Manufacturer, error) {
+	manufacturer, err := s.repo.GetManufacturer(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get manufacturer from repository: %w", err)
+	}
+
+	return manufacturer, nil
+}
+
+func (s *service) ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error) {
+	manufacturers, err := s.repo.ListManufacturers(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get list of manufacturers from repository: %w", err)
+	}
+
+	return manufacturers, nil
+}
+
+func (s *service) UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer, paths []string) (*model.Manufacturer, error) {
+	existing, err := s.GetManufacturer(ctx, manufacturer.ManufacturerUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = existing.Apply(manufacturer, paths); err != nil {
+		return nil, err
+	}
+
+	existing.Name = strings.TrimSpace(existing.Name)
+	if err = existing.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing.UpdatedAt = time.Now().UTC()
+
+	updated, err := s.repo.UpdateManufacturer(ctx, existing)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to update manufacturer in repository: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (s *service) DeleteManufacturer(ctx context.Context, uuid uuid.UUID) error {
+	if err := s.repo.DeleteManufacturer(ctx, uuid); err != nil {
+		return fmt.Errorf("service: failed to delete manufacturer in repository: %w", err)
+	}
+
+	return nil
+}
+
+// resolveManufacturer связывает деталь с записью справочника. Ссылка по manufacturer_uuid
+// должна указывать на существующего производителя; без неё производитель ищется по названию
+// и заводится в справочнике, если его там ещё нет. Остальные поля производителя из детали
+// не сохраняются: они меняются только через справочник
+func (s *service) resolveManufacturer(ctx context.Context, part *model.Part) error {
+	var (
+		manufacturer *model.Manufacturer
+		err          error
+	)
+
+	switch {
+	case part.ManufacturerUuid != uuid.Nil:
+		manufacturer, err = s.GetManufacturer(ctx, part.ManufacturerUuid)
+	case strings.TrimSpace(part.Manufacturer.Name) != "":
+		manufacturer, err = s.findOrCreateManufacturer(ctx, &part.Manufacturer)
+	default:
+		part.Manufacturer = model.Manufacturer{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	part.ManufacturerUuid = manufacturer.ManufacturerUuid
+	part.Manufacturer = *manufacturer
+
+	return nil
+}
+
+func (s *service) findOrCreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error) {
+	existing, err := s.repo.FindManufacturerByName(ctx, manufacturer.Name)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, model.ErrManufacturerNotFound) {
+		return nil, fmt.Errorf("service: failed to find manufacturer in repository: %w", err)
+	}
+
+	created, err := s.CreateManufacturer(ctx, &model.Manufacturer{
+		Name:    manufacturer.Name,
+		Country: manufacturer.Country,
+		Website: manufacturer.Website,
+	})
+	if !errors.Is(err, model.ErrManufacturerAlreadyExists) {
+		return created, err
+	}
+
+	// Производителя с тем же названием успели завести параллельным запросом
+	existing, err = s.repo.FindManufacturerByName(ctx, manufacturer.Name)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to find manufacturer in repository: %w", err)
+	}
+
+	return existing, nil
+}
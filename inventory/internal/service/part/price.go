@@ -0,0 +1,96 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *service) GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error) {
+	if _, err := s.repo.GetPart(ctx, partUuid); err != nil {
+		return nil, fmt.Errorf("service: failed to get part from repository: %w", err)
+	}
+
+	history, err := s.repo.GetPriceHistory(ctx, partUuid)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get price history from repository: %w", err)
+	}
+
+	return history, nil
+}
+
+func (s *service) SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error) {
+	if price <= 0 {
+		return nil, fmt.Errorf("%w: price must be greater than 0", model.ErrInvalidPrice)
+	}
+
+	// Немедленное изменение — обычное обновление цены детали
+	if from.IsZero() {
+		if _, err := s.UpdatePart(ctx, &model.Part{PartUuid: partUuid, Price: price}, []string{"price"}); err != nil {
+			return nil, err
+		}
+
+		return s.GetPriceHistory(ctx, partUuid)
+	}
+
+	// Прошлое не переписывается: заказы уже оформлены по действовавшим тогда ценам
+	if from.Before(time.Now()) {
+		return nil, fmt.Errorf("%w: effective_from must not be in the past", model.ErrInvalidPrice)
+	}
+
+	if _, err := s.repo.GetPart(ctx, partUuid); err != nil {
+		return nil, fmt.Errorf("service: failed to get part from repository: %w", err)
+	}
+
+	history, err := s.repo.SchedulePrice(ctx, partUuid, price, from)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to schedule price in repository: %w", err)
+	}
+
+	return history, nil
+}
+
+func (s *service) SyncPrices(ctx context.Context) (int64, error) {
+	updated, err := s.repo.SyncCurrentPrices(ctx, time.Now().UTC())
+	if err != nil {
+		return updated, fmt.Errorf("service: failed to sync current prices in repository: %w", err)
+	}
+
+	return updated, nil
+}
+
+// applyPricesAt подставляет в детали цены, действовавшие в момент at (нулевой — текущий).
+// Если у какой-то детали в этот момент ещё не было цены, возвращает ErrPriceNotFound
+func (s *service) applyPricesAt(ctx context.Context, parts []model.Part, at time.Time) error {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	ids := make([]uuid.UUID, 0, len(parts))
+	for _, part := range parts {
+		ids = append(ids, part.PartUuid)
+	}
+
+	prices, err := s.repo.GetPricesAt(ctx, ids, at)
+	if err != nil {
+		return fmt.Errorf("service: failed to get part prices from repository: %w", err)
+	}
+
+	for i := range parts {
+		price, ok := prices[parts[i].PartUuid]
+		if !ok {
+			return fmt.Errorf("%w: part %s has no price at %s", model.ErrPriceNotFound, parts[i].PartUuid, at.Format(time.RFC3339))
+		}
+		parts[i].Price = price
+	}
+
+	return nil
+}
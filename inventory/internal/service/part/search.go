@@ -0,0 +1,54 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+)
+
+func (s *service) SearchParts(ctx context.Context, req *model.SearchRequest) (*model.SearchPage, error) {
+	terms := search.Terms(req.Text)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w: query has no searchable words", model.ErrInvalidSearch)
+	}
+
+	size := req.PageSize
+	if size <= 0 {
+		size = model.DefaultPageSize
+	}
+	size = min(size, model.MaxPageSize)
+
+	query := &model.SearchQuery{
+		Terms:  terms,
+		Filter: req.Filter,
+		// Лишний результат показывает, есть ли следующая страница
+		Limit: size + 1,
+	}
+
+	if req.PageToken != "" {
+		cursor, err := model.DecodeSearchToken(req.PageToken, req.Text)
+		if err != nil {
+			return nil, err
+		}
+		query.Offset = cursor.Offset
+	}
+
+	hits, err := s.repo.SearchParts(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to search parts in repository: %w", err)
+	}
+
+	result := &model.SearchPage{Hits: *hits}
+	if len(result.Hits) > size {
+		result.Hits = result.Hits[:size]
+		result.NextPageToken = (&model.SearchCursor{Text: req.Text, Offset: query.Offset + size}).Encode()
+	}
+
+	for i := range result.Hits {
+		result.Hits[i].Highlights = search.Highlights(&result.Hits[i].Part, terms)
+	}
+
+	return result, nil
+}
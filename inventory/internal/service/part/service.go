@@ -1,18 +1,36 @@
 package part
 
 import (
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository"
 	def "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage"
 )
 
 var _ def.InventoryService = (*service)(nil)
 
 type service struct {
-	repo repository.InventoryRepository
+	repo             repository.InventoryRepository
+	lowStockProducer def.LowStockProducer
+	reorderPolicy    model.ReorderPolicy
+	blobs            storage.BlobStorage
+	maxAttachment    int64
 }
 
-func NewService(repo repository.InventoryRepository) *service {
+// NewService создаёт сервис деталей. Без lowStockProducer события о низком остатке не публикуются.
+// Содержимое вложений хранится в blobs, вложения больше maxAttachment байт не принимаются
+func NewService(
+	repo repository.InventoryRepository,
+	lowStockProducer def.LowStockProducer,
+	reorderPolicy model.ReorderPolicy,
+	blobs storage.BlobStorage,
+	maxAttachment int64,
+) *service {
 	return &service{
-		repo: repo,
+		repo:             repo,
+		lowStockProducer: lowStockProducer,
+		reorderPolicy:    reorderPolicy,
+		blobs:            blobs,
+		maxAttachment:    maxAttachment,
 	}
 }
@@ -0,0 +1,196 @@
+package part_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *ServiceSuite) TestUploadAttachmentSuccess() {
+	partUuid := uuid.New()
+	content := []byte("%PDF-1.7 datasheet")
+	upload := &model.AttachmentUpload{
+		PartUuid:       partUuid,
+		FileName:       "engine.pdf",
+		ContentType:    "application/pdf",
+		Kind:           model.AttachmentDatasheet,
+		ChecksumSha256: sha256Hex(content),
+		Content:        bytes.NewReader(content),
+	}
+
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+	s.inventoryRepo.On("AddAttachment", context.Background(), partUuid, mock.AnythingOfType("*model.Attachment")).
+		Return(&model.Part{PartUuid: partUuid}, nil)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), upload)
+
+	s.Require().NoError(err)
+	assert.NotEqual(s.T(), uuid.Nil, attachment.AttachmentUuid)
+	assert.Equal(s.T(), "engine.pdf", attachment.FileName)
+	assert.Equal(s.T(), "application/pdf", attachment.ContentType)
+	assert.Equal(s.T(), model.AttachmentDatasheet, attachment.Kind)
+	assert.Equal(s.T(), int64(len(content)), attachment.SizeBytes)
+	assert.Equal(s.T(), sha256Hex(content), attachment.ChecksumSha256)
+
+	blob := s.blobs.blobs[model.BlobKey(partUuid, attachment.AttachmentUuid)]
+	assert.Equal(s.T(), content, blob.data)
+	assert.Equal(s.T(), "application/pdf", blob.contentType)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentDetectsContentType() {
+	partUuid := uuid.New()
+	content := []byte("\x89PNG\r\n\x1a\n image data")
+
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+	s.inventoryRepo.On("AddAttachment", context.Background(), partUuid, mock.AnythingOfType("*model.Attachment")).
+		Return(&model.Part{PartUuid: partUuid}, nil)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid: partUuid,
+		FileName: "nozzle.png",
+		Kind:     model.AttachmentImage,
+		Content:  bytes.NewReader(content),
+	})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "image/png", attachment.ContentType)
+	// Определение типа не съедает начало содержимого
+	assert.Equal(s.T(), content, s.blobs.blobs[model.BlobKey(partUuid, attachment.AttachmentUuid)].data)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentInvalidFileName() {
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid: uuid.New(),
+		FileName: "../engine.pdf",
+		Content:  strings.NewReader("data"),
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidAttachment)
+	assert.Nil(s.T(), attachment)
+	s.inventoryRepo.AssertNotCalled(s.T(), "GetPart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentPartNotFound() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(nil, model.ErrPartNotFound)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid: partUuid,
+		FileName: "engine.pdf",
+		Content:  strings.NewReader("data"),
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), attachment)
+	assert.Empty(s.T(), s.blobs.blobs)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentChecksumMismatch() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid:       partUuid,
+		FileName:       "engine.pdf",
+		ChecksumSha256: sha256Hex([]byte("other")),
+		Content:        strings.NewReader("data"),
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidAttachment)
+	assert.Nil(s.T(), attachment)
+	assert.Empty(s.T(), s.blobs.blobs)
+	assert.Len(s.T(), s.blobs.deleted, 1)
+	s.inventoryRepo.AssertNotCalled(s.T(), "AddAttachment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentEmpty() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid: partUuid,
+		FileName: "engine.pdf",
+		Content:  strings.NewReader(""),
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidAttachment)
+	assert.Nil(s.T(), attachment)
+	assert.Empty(s.T(), s.blobs.blobs)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentTooLarge() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid: partUuid,
+		FileName: "engine.step",
+		Content:  bytes.NewReader(make([]byte, maxAttachment+1)),
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrAttachmentTooLarge)
+	assert.Nil(s.T(), attachment)
+	assert.Empty(s.T(), s.blobs.blobs)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentRepositoryError() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+	s.inventoryRepo.On("AddAttachment", context.Background(), partUuid, mock.AnythingOfType("*model.Attachment")).
+		Return(nil, model.ErrPartNotFound)
+
+	attachment, err := s.service.UploadAttachment(context.Background(), &model.AttachmentUpload{
+		PartUuid: partUuid,
+		FileName: "engine.pdf",
+		Content:  strings.NewReader("data"),
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), attachment)
+	// Деталь удалили во время загрузки: содержимое без описания не остаётся
+	assert.Empty(s.T(), s.blobs.blobs)
+}
+
+func (s *ServiceSuite) TestDownloadAttachmentSuccess() {
+	partUuid := uuid.New()
+	stored := model.Attachment{AttachmentUuid: uuid.New(), FileName: "engine.pdf", SizeBytes: 4}
+	s.blobs.blobs[model.BlobKey(partUuid, stored.AttachmentUuid)] = mockBlob{data: []byte("data")}
+
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).
+		Return(&model.Part{PartUuid: partUuid, Attachments: []model.Attachment{stored}}, nil)
+
+	attachment, content, err := s.service.DownloadAttachment(context.Background(), partUuid, stored.AttachmentUuid)
+
+	s.Require().NoError(err)
+	defer func() { _ = content.Close() }()
+	assert.Equal(s.T(), stored, *attachment)
+
+	data, err := io.ReadAll(content)
+	s.Require().NoError(err)
+	assert.Equal(s.T(), []byte("data"), data)
+}
+
+func (s *ServiceSuite) TestDownloadAttachmentNotFound() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+
+	attachment, content, err := s.service.DownloadAttachment(context.Background(), partUuid, uuid.New())
+
+	assert.ErrorIs(s.T(), err, model.ErrAttachmentNotFound)
+	assert.Nil(s.T(), attachment)
+	assert.Nil(s.T(), content)
+}
@@ -0,0 +1,123 @@
+package part_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func validPart() *model.Part {
+	return &model.Part{
+		Name:          "Test Part",
+		Description:   "Test Description",
+		Price:         100.50,
+		StockQuantity: 10,
+		Category:      model.ENGINE,
+		Dimensions: model.Dimensions{
+			Length: 1,
+			Width:  2,
+			Height: 3,
+			Weight: 4,
+		},
+	}
+}
+
+func (s *ServiceSuite) TestCreateSuccess() {
+	part := validPart()
+
+	s.inventoryRepo.On("CreatePart", context.Background(), mock.AnythingOfType("*model.Part")).
+		Return(func(_ context.Context, p *model.Part) (*model.Part, error) { return p, nil })
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.NoError(s.T(), err)
+	assert.NotEqual(s.T(), uuid.Nil, result.PartUuid)
+	assert.WithinDuration(s.T(), time.Now(), result.CreatedAt, time.Second)
+	assert.Equal(s.T(), result.CreatedAt, result.UpdatedAt)
+	s.inventoryRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCreateKeepsProvidedUUID() {
+	part := validPart()
+	part.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("CreatePart", context.Background(), part).Return(part, nil)
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), part.PartUuid, result.PartUuid)
+}
+
+func (s *ServiceSuite) TestCreateInvalidPart() {
+	part := validPart()
+	part.Category = model.UNKNOWN
+	part.Price = 0
+	part.Dimensions.Weight = -1
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Contains(s.T(), err.Error(), "category")
+	assert.Contains(s.T(), err.Error(), "price")
+	assert.Contains(s.T(), err.Error(), "dimensions.weight")
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "CreatePart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreateAlreadyExists() {
+	part := validPart()
+
+	s.inventoryRepo.On("CreatePart", context.Background(), part).Return(nil, model.ErrPartAlreadyExists)
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.True(s.T(), errors.Is(err, model.ErrPartAlreadyExists))
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestBatchCreateSuccess() {
+	parts := []model.Part{*validPart(), *validPart()}
+
+	s.inventoryRepo.On("BatchCreateParts", context.Background(), mock.Anything).
+		Return(func(_ context.Context, p []model.Part) (*[]model.Part, error) { return &p, nil })
+
+	result, err := s.service.BatchCreateParts(context.Background(), parts)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), *result, 2)
+	assert.NotEqual(s.T(), (*result)[0].PartUuid, (*result)[1].PartUuid)
+}
+
+func (s *ServiceSuite) TestBatchCreateInvalidPart() {
+	invalid := *validPart()
+	invalid.Name = ""
+	parts := []model.Part{*validPart(), invalid}
+
+	result, err := s.service.BatchCreateParts(context.Background(), parts)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Contains(s.T(), err.Error(), "part #1")
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "BatchCreateParts", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestBatchCreateDuplicateUuid() {
+	duplicate := *validPart()
+	duplicate.PartUuid = uuid.New()
+	parts := []model.Part{duplicate, *validPart(), duplicate}
+
+	result, err := s.service.BatchCreateParts(context.Background(), parts)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Contains(s.T(), err.Error(), "part #2")
+	assert.Contains(s.T(), err.Error(), "duplicates part #0")
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "BatchCreateParts", mock.Anything, mock.Anything)
+}
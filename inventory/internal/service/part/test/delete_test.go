@@ -0,0 +1,32 @@
+package part_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeleteSuccess() {
+	id := uuid.New()
+
+	s.inventoryRepo.On("DeletePart", context.Background(), id, mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := s.service.DeletePart(context.Background(), id)
+
+	assert.NoError(s.T(), err)
+	s.inventoryRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestDeleteNotFound() {
+	id := uuid.New()
+
+	s.inventoryRepo.On("DeletePart", context.Background(), id, mock.AnythingOfType("time.Time")).Return(model.ErrPartNotFound)
+
+	err := s.service.DeletePart(context.Background(), id)
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+}
@@ -15,7 +15,7 @@ func (s *ServiceSuite) TestGetSuccess() {
 	// Тестовые данные
 	partUUID := uuid.New()
 	expectedPart := &model.Part{
-		OrderUuid:     partUUID,
+		PartUuid:      partUUID,
 		Name:          "Test Part",
 		Description:   "Test Description",
 		Price:         100.50,
@@ -25,6 +25,7 @@ func (s *ServiceSuite) TestGetSuccess() {
 	}
 
 	s.inventoryRepo.On("GetPart", context.Background(), partUUID).Return(expectedPart, nil)
+	s.expectCurrentPrices(*expectedPart)
 
 	// Вызов метода
 	result, err := s.service.GetPart(context.Background(), partUUID)
@@ -33,7 +34,7 @@ func (s *ServiceSuite) TestGetSuccess() {
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
 	assert.Equal(s.T(), expectedPart, result)
-	assert.Equal(s.T(), partUUID, result.OrderUuid)
+	assert.Equal(s.T(), partUUID, result.PartUuid)
 	assert.Equal(s.T(), "Test Part", result.Name)
 	s.inventoryRepo.AssertExpectations(s.T())
 }
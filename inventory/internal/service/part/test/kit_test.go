@@ -0,0 +1,83 @@
+package part_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetCompatibleParts() {
+	partUuid := uuid.New()
+	first := model.Part{PartUuid: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Category: model.FUEL}
+	second := model.Part{PartUuid: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Category: model.FUEL}
+	deleted := uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(&model.Part{PartUuid: partUuid}, nil)
+	s.inventoryRepo.On("ListCompatibility", context.Background(), partUuid).Return([]model.Compatibility{
+		{PartUuid: partUuid, CompatibleUuid: second.PartUuid},
+		{PartUuid: partUuid, CompatibleUuid: deleted},
+		{PartUuid: partUuid, CompatibleUuid: first.PartUuid, Note: "через переходник"},
+	}, nil)
+
+	filter := &model.Filter{Uuids: []uuid.UUID{second.PartUuid, deleted, first.PartUuid}, Categories: []model.Category{model.FUEL}}
+	parts := []model.Part{second, first}
+	s.inventoryRepo.On("ListParts", context.Background(), filter, &model.ListQuery{}).Return(&parts, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), mock.Anything, mock.Anything).
+		Return(map[uuid.UUID]float64{first.PartUuid: 10, second.PartUuid: 20}, nil)
+
+	result, err := s.service.GetCompatibleParts(context.Background(), partUuid, []model.Category{model.FUEL})
+
+	s.Require().NoError(err)
+	s.Require().Len(result, 2)
+	assert.Equal(s.T(), first.PartUuid, result[0].Part.PartUuid)
+	assert.Equal(s.T(), "через переходник", result[0].Note)
+	assert.Equal(s.T(), 10.0, result[0].Part.Price)
+	assert.Equal(s.T(), second.PartUuid, result[1].Part.PartUuid)
+}
+
+func (s *ServiceSuite) TestGetCompatiblePartsPartNotFound() {
+	partUuid := uuid.New()
+	s.inventoryRepo.On("GetPart", context.Background(), partUuid).Return(nil, model.ErrPartNotFound)
+
+	result, err := s.service.GetCompatibleParts(context.Background(), partUuid, nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "ListCompatibility", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestExpandKit() {
+	engine := model.Part{PartUuid: uuid.New(), Name: "Двигатель"}
+	kit := &model.Kit{KitUuid: uuid.New(), Name: "Первая ступень", Items: []model.KitItem{{PartUuid: engine.PartUuid, Quantity: 9}}}
+	parts := []model.Part{engine}
+
+	s.inventoryRepo.On("GetKit", context.Background(), kit.KitUuid).Return(kit, nil)
+	s.inventoryRepo.On("ListParts", context.Background(), &model.Filter{Uuids: []uuid.UUID{engine.PartUuid}}, &model.ListQuery{}).
+		Return(&parts, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), []uuid.UUID{engine.PartUuid}, mock.Anything).
+		Return(map[uuid.UUID]float64{engine.PartUuid: 100}, nil)
+
+	result, err := s.service.ExpandKit(context.Background(), kit.KitUuid)
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 900.0, result.TotalPrice)
+	s.Require().Len(result.Lines, 1)
+	assert.Equal(s.T(), int64(9), result.Lines[0].Quantity)
+}
+
+func (s *ServiceSuite) TestExpandKitPartDeleted() {
+	kit := &model.Kit{KitUuid: uuid.New(), Name: "Первая ступень", Items: []model.KitItem{{PartUuid: uuid.New(), Quantity: 1}}}
+	parts := []model.Part{}
+
+	s.inventoryRepo.On("GetKit", context.Background(), kit.KitUuid).Return(kit, nil)
+	s.inventoryRepo.On("ListParts", context.Background(), mock.Anything, &model.ListQuery{}).Return(&parts, nil)
+
+	result, err := s.service.ExpandKit(context.Background(), kit.KitUuid)
+
+	assert.ErrorIs(s.T(), err, model.ErrKitUnavailable)
+	assert.Nil(s.T(), result)
+}
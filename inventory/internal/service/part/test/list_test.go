@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 )
@@ -19,7 +20,7 @@ func (s *ServiceSuite) TestListSuccess() {
 
 	expectedParts := []model.Part{
 		{
-			OrderUuid:     uuid.New(),
+			PartUuid:      uuid.New(),
 			Name:          "Test Part 1",
 			Description:   "Test Description 1",
 			Price:         100.50,
@@ -28,7 +29,7 @@ func (s *ServiceSuite) TestListSuccess() {
 			UpdatedAt:     time.Now(),
 		},
 		{
-			OrderUuid:     uuid.New(),
+			PartUuid:      uuid.New(),
 			Name:          "Test Part 2",
 			Description:   "Test Description 2",
 			Price:         200.75,
@@ -39,17 +40,19 @@ func (s *ServiceSuite) TestListSuccess() {
 	}
 
 	// Настраиваем мок
-	s.inventoryRepo.On("ListParts", context.Background(), filter).Return(&expectedParts, nil)
+	s.inventoryRepo.On("ListParts", context.Background(), filter, defaultListQuery()).Return(&expectedParts, nil)
+	s.expectCurrentPrices(expectedParts...)
 
 	// Вызов метода
-	result, err := s.service.ListParts(context.Background(), filter)
+	result, err := s.service.ListParts(context.Background(), filter, nil)
 
 	// Проверка результата
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
-	assert.Len(s.T(), *result, 2)
-	assert.Equal(s.T(), "Test Part 1", (*result)[0].Name)
-	assert.Equal(s.T(), "Test Part 2", (*result)[1].Name)
+	assert.Len(s.T(), result.Parts, 2)
+	assert.Empty(s.T(), result.NextPageToken)
+	assert.Equal(s.T(), "Test Part 1", result.Parts[0].Name)
+	assert.Equal(s.T(), "Test Part 2", result.Parts[1].Name)
 
 	// Проверяем, что мок был вызван
 	s.inventoryRepo.AssertExpectations(s.T())
@@ -63,15 +66,15 @@ func (s *ServiceSuite) TestListEmptyResult() {
 
 	expectedParts := []model.Part{}
 
-	s.inventoryRepo.On("ListParts", context.Background(), filter).Return(&expectedParts, nil)
+	s.inventoryRepo.On("ListParts", context.Background(), filter, defaultListQuery()).Return(&expectedParts, nil)
 
 	// Вызов метода
-	result, err := s.service.ListParts(context.Background(), filter)
+	result, err := s.service.ListParts(context.Background(), filter, nil)
 
 	// Проверка результата
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
-	assert.Empty(s.T(), *result)
+	assert.Empty(s.T(), result.Parts)
 	s.inventoryRepo.AssertExpectations(s.T())
 }
 
@@ -83,10 +86,10 @@ func (s *ServiceSuite) TestListRepoError() {
 
 	expectedError := assert.AnError
 
-	s.inventoryRepo.On("ListParts", context.Background(), filter).Return(nil, expectedError)
+	s.inventoryRepo.On("ListParts", context.Background(), filter, defaultListQuery()).Return(nil, expectedError)
 
 	// Вызов метода
-	result, err := s.service.ListParts(context.Background(), filter)
+	result, err := s.service.ListParts(context.Background(), filter, nil)
 
 	// Проверка результата
 	assert.Error(s.T(), err)
@@ -99,7 +102,7 @@ func (s *ServiceSuite) TestListWithNilFilter() {
 	// Тестовые данные
 	expectedParts := []model.Part{
 		{
-			OrderUuid:     uuid.New(),
+			PartUuid:      uuid.New(),
 			Name:          "All Parts",
 			Description:   "All parts without filter",
 			Price:         150.00,
@@ -109,14 +112,80 @@ func (s *ServiceSuite) TestListWithNilFilter() {
 		},
 	}
 
-	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil)).Return(&expectedParts, nil)
+	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil), defaultListQuery()).Return(&expectedParts, nil)
+	s.expectCurrentPrices(expectedParts...)
 
 	// Вызов метода
-	result, err := s.service.ListParts(context.Background(), nil)
+	result, err := s.service.ListParts(context.Background(), nil, nil)
 
 	// Проверка результата
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), result)
-	assert.Len(s.T(), *result, 1)
+	assert.Len(s.T(), result.Parts, 1)
 	s.inventoryRepo.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestListNextPageToken() {
+	sort := model.Sort{Field: model.SortByPrice, Desc: true}
+	parts := []model.Part{
+		{PartUuid: uuid.New(), Price: 300},
+		{PartUuid: uuid.New(), Price: 200},
+		{PartUuid: uuid.New(), Price: 100},
+	}
+
+	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil), &model.ListQuery{
+		Limit: 3,
+		Sort:  sort,
+	}).Return(&parts, nil)
+	s.expectCurrentPrices(parts...)
+
+	result, err := s.service.ListParts(context.Background(), nil, &model.PageRequest{Size: 2, Sort: sort})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), result.Parts, 2)
+	assert.NotEmpty(s.T(), result.NextPageToken)
+
+	// Токен указывает на последнюю деталь страницы и передаётся в репозиторий
+	cursor, err := model.DecodePageToken(result.NextPageToken, sort)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), parts[1].PartUuid, cursor.PartUuid)
+	assert.Equal(s.T(), 200.0, cursor.Price)
+
+	nextParts := []model.Part{parts[2]}
+	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil), &model.ListQuery{
+		Limit: 3,
+		Sort:  sort,
+		After: cursor,
+	}).Return(&nextParts, nil)
+
+	next, err := s.service.ListParts(context.Background(), nil, &model.PageRequest{
+		Size:  2,
+		Token: result.NextPageToken,
+		Sort:  sort,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), next.Parts, 1)
+	assert.Empty(s.T(), next.NextPageToken)
+	s.inventoryRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestListInvalidPageToken() {
+	token := model.NewPageCursor(&model.Part{PartUuid: uuid.New()}, model.Sort{Field: model.SortByName}).Encode()
+
+	for _, page := range []*model.PageRequest{
+		{Token: "not a token"},
+		{Token: token, Sort: model.Sort{Field: model.SortByPrice}},
+	} {
+		result, err := s.service.ListParts(context.Background(), nil, page)
+
+		assert.ErrorIs(s.T(), err, model.ErrInvalidPageToken)
+		assert.Nil(s.T(), result)
+	}
+
+	s.inventoryRepo.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func defaultListQuery() *model.ListQuery {
+	return &model.ListQuery{Limit: model.DefaultPageSize + 1}
+}
@@ -0,0 +1,116 @@
+package part_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateManufacturer() {
+	s.inventoryRepo.On("CreateManufacturer", context.Background(), mock.AnythingOfType("*model.Manufacturer")).
+		Return(func(_ context.Context, m *model.Manufacturer) (*model.Manufacturer, error) { return m, nil })
+
+	result, err := s.service.CreateManufacturer(context.Background(), &model.Manufacturer{Name: "  SpaceX ", Country: "USA"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "SpaceX", result.Name)
+	assert.NotEqual(s.T(), uuid.Nil, result.ManufacturerUuid)
+	assert.WithinDuration(s.T(), time.Now(), result.CreatedAt, time.Second)
+	assert.Equal(s.T(), result.CreatedAt, result.UpdatedAt)
+}
+
+func (s *ServiceSuite) TestCreateManufacturerInvalid() {
+	result, err := s.service.CreateManufacturer(context.Background(), &model.Manufacturer{Country: "USA"})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidManufacturer)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "CreateManufacturer", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdateManufacturerAppliesMask() {
+	existing := &model.Manufacturer{ManufacturerUuid: uuid.New(), Name: "Energomash", Country: "Russia"}
+
+	s.inventoryRepo.On("GetManufacturer", context.Background(), existing.ManufacturerUuid).Return(existing, nil)
+	s.inventoryRepo.On("UpdateManufacturer", context.Background(), mock.AnythingOfType("*model.Manufacturer")).
+		Return(func(_ context.Context, m *model.Manufacturer) (*model.Manufacturer, error) { return m, nil })
+
+	update := &model.Manufacturer{ManufacturerUuid: existing.ManufacturerUuid, Name: "ignored", Website: "https://energomash.ru"}
+	result, err := s.service.UpdateManufacturer(context.Background(), update, []string{"url"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "Energomash", result.Name)
+	assert.Equal(s.T(), "Russia", result.Country)
+	assert.Equal(s.T(), "https://energomash.ru", result.Website)
+}
+
+func (s *ServiceSuite) TestUpdateManufacturerNotFound() {
+	id := uuid.New()
+	s.inventoryRepo.On("GetManufacturer", context.Background(), id).Return(nil, model.ErrManufacturerNotFound)
+
+	result, err := s.service.UpdateManufacturer(context.Background(), &model.Manufacturer{ManufacturerUuid: id, Name: "SpaceX"}, nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerNotFound)
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestDeleteManufacturerInUse() {
+	id := uuid.New()
+	s.inventoryRepo.On("DeleteManufacturer", context.Background(), id).Return(model.ErrManufacturerInUse)
+
+	err := s.service.DeleteManufacturer(context.Background(), id)
+
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerInUse)
+}
+
+func (s *ServiceSuite) TestCreatePartLinksExistingManufacturer() {
+	manufacturer := &model.Manufacturer{ManufacturerUuid: uuid.New(), Name: "SpaceX", Country: "USA"}
+	part := validPart()
+	part.Manufacturer = model.Manufacturer{Name: "spacex", Country: "ignored"}
+
+	s.inventoryRepo.On("FindManufacturerByName", context.Background(), "spacex").Return(manufacturer, nil)
+	s.inventoryRepo.On("CreatePart", context.Background(), mock.AnythingOfType("*model.Part")).
+		Return(func(_ context.Context, p *model.Part) (*model.Part, error) { return p, nil })
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), manufacturer.ManufacturerUuid, result.ManufacturerUuid)
+	assert.Equal(s.T(), *manufacturer, result.Manufacturer)
+	s.inventoryRepo.AssertNotCalled(s.T(), "CreateManufacturer", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreatePartCreatesUnknownManufacturer() {
+	part := validPart()
+	part.Manufacturer = model.Manufacturer{Name: "Boeing", Country: "USA", Website: "https://boeing.com"}
+
+	s.inventoryRepo.On("FindManufacturerByName", context.Background(), "Boeing").Return(nil, model.ErrManufacturerNotFound)
+	s.inventoryRepo.On("CreateManufacturer", context.Background(), mock.AnythingOfType("*model.Manufacturer")).
+		Return(func(_ context.Context, m *model.Manufacturer) (*model.Manufacturer, error) { return m, nil })
+	s.inventoryRepo.On("CreatePart", context.Background(), mock.AnythingOfType("*model.Part")).
+		Return(func(_ context.Context, p *model.Part) (*model.Part, error) { return p, nil })
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.NoError(s.T(), err)
+	assert.NotEqual(s.T(), uuid.Nil, result.ManufacturerUuid)
+	assert.Equal(s.T(), result.ManufacturerUuid, result.Manufacturer.ManufacturerUuid)
+	assert.Equal(s.T(), "https://boeing.com", result.Manufacturer.Website)
+}
+
+func (s *ServiceSuite) TestCreatePartUnknownManufacturerUuid() {
+	part := validPart()
+	part.ManufacturerUuid = uuid.New()
+
+	s.inventoryRepo.On("GetManufacturer", context.Background(), part.ManufacturerUuid).Return(nil, model.ErrManufacturerNotFound)
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.ErrorIs(s.T(), err, model.ErrManufacturerNotFound)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "CreatePart", mock.Anything, mock.Anything)
+}
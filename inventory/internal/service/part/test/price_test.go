@@ -0,0 +1,170 @@
+package part_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetPartAt_PriceFromHistory() {
+	part := validPart()
+	part.PartUuid = uuid.New()
+	at := time.Now().Add(-24 * time.Hour)
+
+	s.inventoryRepo.On("GetPart", context.Background(), part.PartUuid).Return(part, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), []uuid.UUID{part.PartUuid}, at).
+		Return(map[uuid.UUID]float64{part.PartUuid: 80}, nil)
+
+	result, err := s.service.GetPartAt(context.Background(), part.PartUuid, at)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 80.0, result.Price)
+}
+
+func (s *ServiceSuite) TestGetPartAt_NoPrice() {
+	part := validPart()
+	part.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), part.PartUuid).Return(part, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), mock.Anything, mock.Anything).
+		Return(map[uuid.UUID]float64{}, nil)
+
+	result, err := s.service.GetPartAt(context.Background(), part.PartUuid, time.Now().AddDate(-1, 0, 0))
+
+	assert.ErrorIs(s.T(), err, model.ErrPriceNotFound)
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestListPartsPriceAt() {
+	at := time.Now().Add(-24 * time.Hour)
+	parts := []model.Part{
+		{PartUuid: uuid.New(), Price: 300},
+		{PartUuid: uuid.New(), Price: 200},
+	}
+
+	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil), defaultListQuery()).Return(&parts, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), []uuid.UUID{parts[0].PartUuid, parts[1].PartUuid}, at).
+		Return(map[uuid.UUID]float64{parts[0].PartUuid: 250, parts[1].PartUuid: 180}, nil)
+
+	result, err := s.service.ListParts(context.Background(), nil, &model.PageRequest{PriceAt: at})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 250.0, result.Parts[0].Price)
+	assert.Equal(s.T(), 180.0, result.Parts[1].Price)
+}
+
+func (s *ServiceSuite) TestListPartsPriceAt_NoPrice() {
+	at := time.Now().Add(-24 * time.Hour)
+	parts := []model.Part{
+		{PartUuid: uuid.New(), Price: 300},
+		{PartUuid: uuid.New(), Price: 200},
+	}
+
+	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil), defaultListQuery()).Return(&parts, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), []uuid.UUID{parts[0].PartUuid, parts[1].PartUuid}, at).
+		Return(map[uuid.UUID]float64{parts[0].PartUuid: 250}, nil)
+
+	result, err := s.service.ListParts(context.Background(), nil, &model.PageRequest{PriceAt: at})
+
+	// У второй детали в этот момент ещё не было цены
+	assert.ErrorIs(s.T(), err, model.ErrPriceNotFound)
+	assert.Contains(s.T(), err.Error(), parts[1].PartUuid.String())
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestListPartsReadMaskWithoutPrice() {
+	parts := []model.Part{{PartUuid: uuid.New(), Name: "Test Part"}}
+	query := defaultListQuery()
+	query.Fields = []string{"name"}
+
+	s.inventoryRepo.On("ListParts", context.Background(), (*model.Filter)(nil), query).Return(&parts, nil)
+
+	_, err := s.service.ListParts(context.Background(), nil, &model.PageRequest{Fields: []string{"name"}})
+
+	assert.NoError(s.T(), err)
+	s.inventoryRepo.AssertNotCalled(s.T(), "GetPricesAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePart_ScheduledPriceTakesEffect() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+
+	// Запланированная цена уже наступила, но ещё не перенесена в деталь синхронизацией
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.inventoryRepo.On("GetPricesAt", context.Background(), mock.Anything, mock.Anything).
+		Return(map[uuid.UUID]float64{existing.PartUuid: 120}, nil)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+
+	result, err := s.service.UpdatePart(context.Background(),
+		&model.Part{PartUuid: existing.PartUuid, Name: "Renamed"}, []string{"name"})
+
+	// Обновление других полей не откатывает цену и не меняет историю
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 120.0, result.Price)
+	s.inventoryRepo.AssertNotCalled(s.T(), "SchedulePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestSchedulePrice_Future() {
+	part := validPart()
+	part.PartUuid = uuid.New()
+	from := time.Now().Add(time.Hour)
+	history := []model.PricePeriod{{Price: 100.50}, {Price: 150, EffectiveFrom: from}}
+
+	s.inventoryRepo.On("GetPart", context.Background(), part.PartUuid).Return(part, nil)
+	s.inventoryRepo.On("SchedulePrice", context.Background(), part.PartUuid, 150.0, from).Return(history, nil)
+
+	result, err := s.service.SchedulePrice(context.Background(), part.PartUuid, 150, from)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), history, result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestSchedulePrice_Immediate() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	history := []model.PricePeriod{{Price: 100.50}, {Price: 150}}
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+	s.inventoryRepo.On("SchedulePrice", context.Background(), existing.PartUuid, 150.0, mock.AnythingOfType("time.Time")).
+		Return(history, nil)
+	s.inventoryRepo.On("GetPriceHistory", context.Background(), existing.PartUuid).Return(history, nil)
+
+	result, err := s.service.SchedulePrice(context.Background(), existing.PartUuid, 150, time.Time{})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), history, result)
+}
+
+func (s *ServiceSuite) TestSchedulePrice_Invalid() {
+	id := uuid.New()
+
+	_, err := s.service.SchedulePrice(context.Background(), id, 0, time.Now().Add(time.Hour))
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPrice)
+
+	// Прошлое не переписывается
+	_, err = s.service.SchedulePrice(context.Background(), id, 150, time.Now().Add(-time.Hour))
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPrice)
+
+	s.inventoryRepo.AssertNotCalled(s.T(), "SchedulePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestGetPriceHistory_PartNotFound() {
+	id := uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), id).Return(nil, model.ErrPartNotFound)
+
+	result, err := s.service.GetPriceHistory(context.Background(), id)
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), result)
+}
@@ -0,0 +1,51 @@
+package part_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/search"
+)
+
+func (s *ServiceSuite) TestSearchSuccess() {
+	hits := []model.SearchHit{
+		{Part: model.Part{PartUuid: uuid.New(), Name: "Ракетный двигатель RD-180"}, Score: 20},
+		{Part: model.Part{PartUuid: uuid.New(), Name: "Двигатель", Description: "Для RD-180"}, Score: 11},
+	}
+
+	s.inventoryRepo.On("SearchParts", context.Background(), &model.SearchQuery{
+		Terms: search.Terms("RD-180"),
+		Limit: 2,
+	}).Return(&hits, nil)
+
+	result, err := s.service.SearchParts(context.Background(), &model.SearchRequest{Text: "RD-180", PageSize: 1})
+
+	s.Require().NoError(err)
+	s.Require().Len(result.Hits, 1)
+	assert.Equal(s.T(), []model.Highlight{
+		{Field: search.FieldName, Snippet: "Ракетный двигатель <em>RD</em>-<em>180</em>"},
+	}, result.Hits[0].Highlights)
+	s.Require().NotEmpty(result.NextPageToken)
+
+	cursor, err := model.DecodeSearchToken(result.NextPageToken, "RD-180")
+	s.Require().NoError(err)
+	assert.Equal(s.T(), 1, cursor.Offset)
+}
+
+func (s *ServiceSuite) TestSearchInvalidQuery() {
+	for _, req := range []*model.SearchRequest{
+		{Text: " - "},
+		{Text: "engine", PageToken: (&model.SearchCursor{Text: "wing", Offset: 10}).Encode()},
+	} {
+		result, err := s.service.SearchParts(context.Background(), req)
+
+		assert.Error(s.T(), err)
+		assert.Nil(s.T(), result)
+	}
+
+	s.inventoryRepo.AssertNotCalled(s.T(), "SearchParts", mock.Anything, mock.Anything)
+}
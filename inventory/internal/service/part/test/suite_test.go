@@ -1,29 +1,53 @@
 package part_test
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/repository/mocks"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
 	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service/part"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 )
 
 type ServiceSuite struct {
 	suite.Suite
-	inventoryRepo *mocks.InventoryRepository
-	service       service.InventoryService
+	inventoryRepo    *mocks.InventoryRepository
+	lowStockProducer *mockLowStockProducer
+	blobs            *mockBlobStorage
+	service          service.InventoryService
 }
 
+// maxAttachment — предел размера вложения в тестах сервиса
+const maxAttachment = 1024
+
 func (s *ServiceSuite) SetupSuite() {
+	logger.SetNopLogger()
+
 	s.inventoryRepo = mocks.NewInventoryRepository(s.T())
-	s.service = part.NewService(s.inventoryRepo)
+	s.lowStockProducer = &mockLowStockProducer{}
+	s.blobs = &mockBlobStorage{}
+	s.service = part.NewService(s.inventoryRepo, s.lowStockProducer, model.ReorderPolicy{
+		Default:    1,
+		Categories: map[model.Category]int64{model.ENGINE: 5},
+	}, s.blobs, maxAttachment)
 }
 
 func (s *ServiceSuite) SetupTest() {
 	// Сбрасываем моки перед каждым тестом
 	s.inventoryRepo.ExpectedCalls = nil
+	s.inventoryRepo.Calls = nil
+	s.lowStockProducer.events = nil
+	s.lowStockProducer.err = nil
+	s.blobs.blobs = make(map[string]mockBlob)
+	s.blobs.deleted = nil
 }
 
 func (s *ServiceSuite) TearDownSuite() {
@@ -32,3 +56,62 @@ func (s *ServiceSuite) TearDownSuite() {
 func TestService(t *testing.T) {
 	suite.Run(t, new(ServiceSuite))
 }
+
+// expectCurrentPrices настраивает историю цен так, что на любой момент детали стоят
+// столько же, сколько записано в самих деталях
+func (s *ServiceSuite) expectCurrentPrices(parts ...model.Part) {
+	prices := make(map[uuid.UUID]float64, len(parts))
+	for _, part := range parts {
+		prices[part.PartUuid] = part.Price
+	}
+
+	s.inventoryRepo.On("GetPricesAt", context.Background(), mock.Anything, mock.AnythingOfType("time.Time")).
+		Return(prices, nil)
+}
+
+// mockLowStockProducer - мок для LowStockProducer
+type mockLowStockProducer struct {
+	events []model.LowStockEvent
+	err    error
+}
+
+func (m *mockLowStockProducer) ProduceLowStock(_ context.Context, event model.LowStockEvent) error {
+	m.events = append(m.events, event)
+	return m.err
+}
+
+// mockBlobStorage - мок для BlobStorage, хранит содержимое в памяти
+type mockBlobStorage struct {
+	blobs   map[string]mockBlob
+	deleted []string
+}
+
+type mockBlob struct {
+	data        []byte
+	contentType string
+}
+
+func (m *mockBlobStorage) Put(_ context.Context, key string, content io.Reader, contentType string) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+
+	m.blobs[key] = mockBlob{data: data, contentType: contentType}
+	return nil
+}
+
+func (m *mockBlobStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	blob, ok := m.blobs[key]
+	if !ok {
+		return nil, model.ErrBlobNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(blob.data)), nil
+}
+
+func (m *mockBlobStorage) Delete(_ context.Context, key string) error {
+	delete(m.blobs, key)
+	m.deleted = append(m.deleted, key)
+	return nil
+}
@@ -0,0 +1,219 @@
+package part_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdateWithMask() {
+	createdAt := time.Now().Add(-time.Hour)
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	existing.CreatedAt = createdAt
+	existing.UpdatedAt = createdAt
+
+	update := &model.Part{
+		PartUuid:   existing.PartUuid,
+		Name:       "Ignored",
+		Price:      250,
+		Dimensions: model.Dimensions{Weight: 42},
+	}
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+	s.inventoryRepo.On("SchedulePrice", context.Background(), existing.PartUuid, 250.0, mock.AnythingOfType("time.Time")).
+		Return([]model.PricePeriod{}, nil)
+
+	result, err := s.service.UpdatePart(context.Background(), update, []string{"price", "dimensions.weight"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "Test Part", result.Name)
+	assert.Equal(s.T(), 250.0, result.Price)
+	assert.Equal(s.T(), 42.0, result.Dimensions.Weight)
+	assert.Equal(s.T(), 1.0, result.Dimensions.Length)
+	assert.Equal(s.T(), createdAt, result.CreatedAt)
+	assert.True(s.T(), result.UpdatedAt.After(createdAt))
+}
+
+func (s *ServiceSuite) TestUpdatePriceScheduleError() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	scheduleErr := errors.New("transaction aborted")
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("SchedulePrice", context.Background(), existing.PartUuid, 250.0, mock.AnythingOfType("time.Time")).
+		Return(nil, scheduleErr)
+
+	result, err := s.service.UpdatePart(context.Background(),
+		&model.Part{PartUuid: existing.PartUuid, Price: 250}, []string{"price"})
+
+	// Цена детали не расходится с историей: без записи в историю деталь не обновляется
+	assert.ErrorIs(s.T(), err, scheduleErr)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdateUnknownMaskField() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+
+	result, err := s.service.UpdatePart(context.Background(), &model.Part{PartUuid: existing.PartUuid}, []string{"created_at"})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidUpdateMask)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdateInvalidResult() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+
+	// Пустая маска заменяет все изменяемые поля, поэтому неполная деталь не проходит проверку
+	result, err := s.service.UpdatePart(context.Background(), &model.Part{PartUuid: existing.PartUuid}, nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestUpdateNotFound() {
+	id := uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), id).Return(nil, model.ErrPartNotFound)
+
+	result, err := s.service.UpdatePart(context.Background(), &model.Part{PartUuid: id}, []string{"price"})
+
+	assert.ErrorIs(s.T(), err, model.ErrPartNotFound)
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestUpdateStockBelowThreshold() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+
+	update := &model.Part{PartUuid: existing.PartUuid, StockQuantity: 5}
+	_, err := s.service.UpdatePart(context.Background(), update, []string{"stock_quantity"})
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), s.lowStockProducer.events, 1) {
+		event := s.lowStockProducer.events[0]
+		assert.Equal(s.T(), existing.PartUuid, event.PartUUID)
+		assert.Equal(s.T(), model.ENGINE, event.Category)
+		assert.Equal(s.T(), int64(5), event.StockQuantity)
+		assert.Equal(s.T(), int64(10), event.PreviousStockQuantity)
+		assert.Equal(s.T(), int64(5), event.Threshold)
+		assert.NotEqual(s.T(), uuid.Nil, event.EventUUID)
+	}
+}
+
+func (s *ServiceSuite) TestUpdateStockAlreadyBelowThreshold() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	existing.StockQuantity = 3
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+
+	// Остаток уже был на пороге: повторное оповещение не отправляется
+	update := &model.Part{PartUuid: existing.PartUuid, StockQuantity: 0}
+	_, err := s.service.UpdatePart(context.Background(), update, []string{"stock_quantity"})
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.lowStockProducer.events)
+}
+
+func (s *ServiceSuite) TestUpdatePartThresholdOverridesCategory() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	existing.Category = model.FUEL
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+
+	threshold := int64(8)
+	update := &model.Part{PartUuid: existing.PartUuid, StockQuantity: 7, ReorderThreshold: &threshold}
+	_, err := s.service.UpdatePart(context.Background(), update, []string{"stock_quantity", "reorder_threshold"})
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), s.lowStockProducer.events, 1) {
+		assert.Equal(s.T(), int64(8), s.lowStockProducer.events[0].Threshold)
+	}
+}
+
+func (s *ServiceSuite) TestUpdateLowStockProducerError() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), mock.AnythingOfType("time.Time")).
+		Return(func(_ context.Context, p *model.Part, _ time.Time) (*model.Part, error) { return p, nil })
+	s.lowStockProducer.err = errors.New("kafka unavailable")
+
+	// Изменение уже сохранено, поэтому ошибка публикации не возвращается клиенту
+	update := &model.Part{PartUuid: existing.PartUuid, StockQuantity: 1}
+	result, err := s.service.UpdatePart(context.Background(), update, []string{"stock_quantity"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), result.StockQuantity)
+	assert.Len(s.T(), s.lowStockProducer.events, 1)
+}
+
+func (s *ServiceSuite) TestUpdateNegativeReorderThreshold() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+
+	threshold := int64(-1)
+	update := &model.Part{PartUuid: existing.PartUuid, ReorderThreshold: &threshold}
+	result, err := s.service.UpdatePart(context.Background(), update, []string{"reorder_threshold"})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPart)
+	assert.Nil(s.T(), result)
+}
+
+func (s *ServiceSuite) TestUpdateConflict() {
+	readAt := time.Now().Add(-time.Hour).UTC()
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	existing.UpdatedAt = readAt
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+	// Репозиторий сверяет updated_at прочитанной детали: деталь изменилась после чтения
+	s.inventoryRepo.On("UpdatePart", context.Background(), mock.AnythingOfType("*model.Part"), readAt).
+		Return(nil, model.ErrPartConflict)
+
+	result, err := s.service.UpdatePart(context.Background(),
+		&model.Part{PartUuid: existing.PartUuid, Name: "Renamed"}, []string{"name"})
+
+	assert.ErrorIs(s.T(), err, model.ErrPartConflict)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertExpectations(s.T())
+}
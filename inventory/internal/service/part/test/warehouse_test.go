@@ -0,0 +1,119 @@
+package part_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func warehouses() []model.Warehouse {
+	return []model.Warehouse{
+		{Code: "BAIKONUR", Name: "Байконур"},
+		{Code: "VOSTOCHNY", Name: "Восточный"},
+	}
+}
+
+func (s *ServiceSuite) TestTransferStockSuccess() {
+	transfer := &model.StockTransfer{
+		PartUuid:      uuid.New(),
+		FromWarehouse: "BAIKONUR",
+		ToWarehouse:   "VOSTOCHNY",
+		Quantity:      2,
+		Reason:        "rebalance",
+	}
+	moved := &model.Part{PartUuid: transfer.PartUuid, StockQuantity: 5}
+
+	s.inventoryRepo.On("ListWarehouses", context.Background()).Return(warehouses(), nil)
+	s.inventoryRepo.On("TransferStock", context.Background(), transfer).Return(moved, nil)
+
+	result, err := s.service.TransferStock(context.Background(), transfer)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), moved, result)
+	assert.NotEqual(s.T(), uuid.Nil, transfer.TransferUuid)
+	assert.WithinDuration(s.T(), time.Now(), transfer.CreatedAt, time.Second)
+}
+
+func (s *ServiceSuite) TestTransferStockSameWarehouse() {
+	transfer := &model.StockTransfer{
+		PartUuid:      uuid.New(),
+		FromWarehouse: "BAIKONUR",
+		ToWarehouse:   "BAIKONUR",
+		Quantity:      1,
+	}
+
+	result, err := s.service.TransferStock(context.Background(), transfer)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidTransfer)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "TransferStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestTransferStockUnknownWarehouse() {
+	transfer := &model.StockTransfer{
+		PartUuid:      uuid.New(),
+		FromWarehouse: "BAIKONUR",
+		ToWarehouse:   "PLESETSK",
+		Quantity:      1,
+	}
+
+	s.inventoryRepo.On("ListWarehouses", context.Background()).Return(warehouses(), nil)
+
+	result, err := s.service.TransferStock(context.Background(), transfer)
+
+	assert.ErrorIs(s.T(), err, model.ErrWarehouseNotFound)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "TransferStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreateSumsStockByWarehouse() {
+	part := validPart()
+	part.StockQuantity = 0
+	part.Stock = []model.StockLevel{
+		{Warehouse: "BAIKONUR", Quantity: 3},
+		{Warehouse: "VOSTOCHNY", Quantity: 4},
+	}
+
+	s.inventoryRepo.On("ListWarehouses", context.Background()).Return(warehouses(), nil)
+	s.inventoryRepo.On("CreatePart", context.Background(), mock.AnythingOfType("*model.Part")).
+		Return(func(_ context.Context, p *model.Part) (*model.Part, error) { return p, nil })
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(7), result.StockQuantity)
+}
+
+func (s *ServiceSuite) TestCreateUnknownWarehouse() {
+	part := validPart()
+	part.Stock = []model.StockLevel{{Warehouse: "KAPUSTIN_YAR", Quantity: 1}}
+
+	s.inventoryRepo.On("ListWarehouses", context.Background()).Return(warehouses(), nil)
+
+	result, err := s.service.CreatePart(context.Background(), part)
+
+	assert.ErrorIs(s.T(), err, model.ErrWarehouseNotFound)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "CreatePart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdateStockQuantityOfPartWithWarehouses() {
+	existing := validPart()
+	existing.PartUuid = uuid.New()
+	existing.Stock = []model.StockLevel{{Warehouse: "BAIKONUR", Quantity: 10}}
+
+	s.inventoryRepo.On("GetPart", context.Background(), existing.PartUuid).Return(existing, nil)
+	s.expectCurrentPrices(*existing)
+
+	result, err := s.service.UpdatePart(context.Background(),
+		&model.Part{PartUuid: existing.PartUuid, StockQuantity: 3}, []string{"stock_quantity"})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidUpdateMask)
+	assert.Nil(s.T(), result)
+	s.inventoryRepo.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,81 @@
+package part_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestWatchFiltersEvents() {
+	engine := model.PartEvent{Type: model.PartCreated, Part: model.Part{PartUuid: uuid.New(), Category: model.ENGINE}}
+	wing := model.PartEvent{Type: model.PartUpdated, Part: model.Part{PartUuid: uuid.New(), Category: model.WING}}
+
+	s.inventoryRepo.On("WatchParts", mock.Anything, mock.MatchedBy(func(req *model.WatchRequest) bool {
+		return req.ResumeToken == "token"
+	})).Run(func(args mock.Arguments) {
+		req := args.Get(1).(*model.WatchRequest)
+		_ = req.Handle(engine)
+		_ = req.Handle(wing)
+	}).Return(nil)
+
+	var received []model.PartEvent
+	err := s.service.WatchParts(context.Background(), &model.WatchRequest{
+		Filter:      &model.Filter{Categories: []model.Category{model.WING}},
+		ResumeToken: "token",
+		Handle: func(event model.PartEvent) error {
+			received = append(received, event)
+			return nil
+		},
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []model.PartEvent{wing}, received)
+	s.inventoryRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestWatchSendsProgressForFilteredEvents() {
+	const interval = 20 * time.Millisecond
+
+	engine := func(token string) model.PartEvent {
+		return model.PartEvent{Type: model.PartCreated, Part: model.Part{Category: model.ENGINE}, ResumeToken: token}
+	}
+	wing := model.PartEvent{Type: model.PartUpdated, Part: model.Part{Category: model.WING}, ResumeToken: "3"}
+
+	s.inventoryRepo.On("WatchParts", mock.Anything, mock.MatchedBy(func(req *model.WatchRequest) bool {
+		return req.ProgressInterval == interval
+	})).Run(func(args mock.Arguments) {
+		req := args.Get(1).(*model.WatchRequest)
+		time.Sleep(2 * interval)
+		// Отброшенное фильтром изменение становится прогрессом, следующее — уже нет
+		_ = req.Handle(engine("1"))
+		_ = req.Handle(engine("2"))
+		_ = req.Handle(wing)
+		// Прогресс сразу после события не нужен
+		_ = req.Handle(model.PartEvent{Type: model.PartProgress, ResumeToken: "4"})
+		time.Sleep(2 * interval)
+		_ = req.Handle(model.PartEvent{Type: model.PartProgress, ResumeToken: "5"})
+	}).Return(nil)
+
+	var received []model.PartEvent
+	err := s.service.WatchParts(context.Background(), &model.WatchRequest{
+		Filter:           &model.Filter{Categories: []model.Category{model.WING}},
+		ProgressInterval: interval,
+		Handle: func(event model.PartEvent) error {
+			received = append(received, event)
+			return nil
+		},
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []model.PartEvent{
+		{Type: model.PartProgress, ResumeToken: "1"},
+		wing,
+		{Type: model.PartProgress, ResumeToken: "5"},
+	}, received)
+	s.inventoryRepo.AssertExpectations(s.T())
+}
@@ -0,0 +1,101 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+func (s *service) UpdatePart(ctx context.Context, part *model.Part, paths []string) (*model.Part, error) {
+	now := time.Now().UTC()
+
+	existing, err := s.GetPartAt(ctx, part.PartUuid, now)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *existing
+	readUpdatedAt := existing.UpdatedAt
+
+	if err = existing.Apply(part, paths); err != nil {
+		return nil, err
+	}
+
+	// Общий остаток детали с остатками по складам вычисляется, а не задаётся
+	if len(existing.Stock) > 0 && slices.Contains(paths, "stock_quantity") && !slices.Contains(paths, "stock") {
+		return nil, fmt.Errorf("%w: stock_quantity is computed from stock, update stock instead", model.ErrInvalidUpdateMask)
+	}
+	existing.SyncStockQuantity()
+
+	if err = existing.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = s.checkWarehouses(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 || slices.Contains(paths, "manufacturer") || slices.Contains(paths, "manufacturer_uuid") {
+		if err = s.resolveManufacturer(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.UpdatedAt = now
+
+	// Новая цена действует с момента обновления, запланированные изменения сохраняются.
+	// История цен записывается раньше детали: если обновление детали не пройдёт,
+	// цену в деталь перенесёт синхронизация, а наоборот цена детали разошлась бы с историей
+	if existing.Price != before.Price {
+		if _, err = s.repo.SchedulePrice(ctx, existing.PartUuid, existing.Price, now); err != nil {
+			return nil, fmt.Errorf("service: failed to schedule price in repository: %w", err)
+		}
+	}
+
+	// Деталь перезаписывается целиком, поэтому изменение, сделанное после чтения
+	// (например, перемещение остатков), не должно потеряться: репозиторий вернёт конфликт
+	updated, err := s.repo.UpdatePart(ctx, existing, readUpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to update part in repository: %w", err)
+	}
+
+	s.notifyLowStock(ctx, &before, updated)
+
+	return updated, nil
+}
+
+// notifyLowStock публикует событие, если остаток опустился до порога дозаказа.
+// Изменение уже сохранено, поэтому ошибка публикации только логируется
+func (s *service) notifyLowStock(ctx context.Context, old, updated *model.Part) {
+	if s.lowStockProducer == nil {
+		return
+	}
+
+	threshold, crossed := s.reorderPolicy.LowStock(old, updated)
+	if !crossed {
+		return
+	}
+
+	err := s.lowStockProducer.ProduceLowStock(ctx, model.LowStockEvent{
+		EventUUID:             uuid.New(),
+		PartUUID:              updated.PartUuid,
+		Name:                  updated.Name,
+		Category:              updated.Category,
+		StockQuantity:         updated.StockQuantity,
+		PreviousStockQuantity: old.StockQuantity,
+		Threshold:             threshold,
+		OccurredAt:            updated.UpdatedAt,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to publish InventoryLowStock event",
+			zap.String("part_uuid", updated.PartUuid.String()),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,69 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+func (s *service) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	warehouses, err := s.repo.ListWarehouses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get list of warehouses from repository: %w", err)
+	}
+
+	return warehouses, nil
+}
+
+func (s *service) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error) {
+	if err := transfer.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkWarehouseCodes(ctx, transfer.FromWarehouse, transfer.ToWarehouse); err != nil {
+		return nil, err
+	}
+
+	transfer.TransferUuid = uuid.New()
+	transfer.CreatedAt = time.Now().UTC()
+
+	part, err := s.repo.TransferStock(ctx, transfer)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to transfer stock in repository: %w", err)
+	}
+
+	return part, nil
+}
+
+// checkWarehouses проверяет, что остатки детали числятся на известных складах
+func (s *service) checkWarehouses(ctx context.Context, part *model.Part) error {
+	if len(part.Stock) == 0 {
+		return nil
+	}
+
+	return s.checkWarehouseCodes(ctx, part.Warehouses()...)
+}
+
+func (s *service) checkWarehouseCodes(ctx context.Context, codes ...string) error {
+	warehouses, err := s.repo.ListWarehouses(ctx)
+	if err != nil {
+		return fmt.Errorf("service: failed to get list of warehouses from repository: %w", err)
+	}
+
+	known := make(map[string]bool, len(warehouses))
+	for _, warehouse := range warehouses {
+		known[warehouse.Code] = true
+	}
+
+	for _, code := range codes {
+		if !known[code] {
+			return fmt.Errorf("%w: %s", model.ErrWarehouseNotFound, code)
+		}
+	}
+
+	return nil
+}
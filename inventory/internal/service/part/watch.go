@@ -0,0 +1,47 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+)
+
+// defaultWatchProgressInterval — как часто подписчик без подходящих изменений
+// получает актуальный resume token
+const defaultWatchProgressInterval = 30 * time.Second
+
+func (s *service) WatchParts(ctx context.Context, req *model.WatchRequest) error {
+	interval := req.ProgressInterval
+	if interval <= 0 {
+		interval = defaultWatchProgressInterval
+	}
+
+	// Фильтр проверяется по снимку детали после изменения: деталь, переставшая
+	// подходить под фильтр, перестаёт попадать в поток.
+	// Отброшенные изменения и простой потока превращаются в PartProgress не чаще
+	// раза в interval: иначе при узком фильтре токен клиента стоит на месте и
+	// к переподключению может оказаться вытеснен из истории
+	lastSent := time.Now()
+	filtered := *req
+	filtered.ProgressInterval = interval
+	filtered.Handle = func(event model.PartEvent) error {
+		if event.Type != model.PartProgress && req.Filter.Match(&event.Part) {
+			lastSent = time.Now()
+			return req.Handle(event)
+		}
+
+		if time.Since(lastSent) < interval {
+			return nil
+		}
+		lastSent = time.Now()
+
+		return req.Handle(model.PartEvent{
+			Type:        model.PartProgress,
+			ResumeToken: event.ResumeToken,
+			OccurredAt:  event.OccurredAt,
+		})
+	}
+
+	return s.repo.WatchParts(ctx, &filtered)
+}
@@ -0,0 +1,73 @@
+package producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	def "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/service"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
+	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
+)
+
+// eventSource — источник событий в заголовке ce_source.
+const eventSource = "inventory"
+
+var _ def.LowStockProducer = (*service)(nil)
+
+type service struct {
+	lowStockProducer kafka.Producer
+}
+
+func NewService(lowStockProducer kafka.Producer) *service {
+	return &service{
+		lowStockProducer: lowStockProducer,
+	}
+}
+
+func (p *service) ProduceLowStock(ctx context.Context, event model.LowStockEvent) error {
+	msg := &eventsV1.InventoryLowStock{
+		EventUuid:             event.EventUUID.String(),
+		PartUuid:              event.PartUUID.String(),
+		Name:                  event.Name,
+		Category:              event.Category.String(),
+		StockQuantity:         event.StockQuantity,
+		PreviousStockQuantity: event.PreviousStockQuantity,
+		Threshold:             event.Threshold,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal InventoryLowStock event", zap.Error(err))
+		return err
+	}
+
+	meta := kafkaEvent.Meta{
+		OccurredAt:    event.OccurredAt,
+		ID:            event.EventUUID.String(),
+		Type:          events.LowStockType,
+		SchemaVersion: events.SchemaVersionV1,
+		Source:        eventSource,
+		CorrelationID: event.PartUUID.String(),
+	}
+
+	// Ключ — деталь: события одной детали попадают в одну партицию и читаются по порядку
+	err = p.lowStockProducer.SendWithHeaders(ctx, []byte(event.PartUUID.String()), payload, meta.Headers())
+	if err != nil {
+		logger.Error(ctx, "Failed to send InventoryLowStock event to Kafka", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "InventoryLowStock event sent successfully",
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("part_uuid", event.PartUUID.String()),
+		zap.Int64("stock_quantity", event.StockQuantity),
+		zap.Int64("threshold", event.Threshold))
+
+	return nil
+}
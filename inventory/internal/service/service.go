@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,5 +12,31 @@ import (
 
 type InventoryService interface {
 	GetPart(ctx context.Context, uuid uuid.UUID) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error)
+	GetPartAt(ctx context.Context, uuid uuid.UUID, priceAt time.Time) (*model.Part, error)
+	ListParts(ctx context.Context, filter *model.Filter, page *model.PageRequest) (*model.PartsPage, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	BatchCreateParts(ctx context.Context, parts []model.Part) (*[]model.Part, error)
+	UpdatePart(ctx context.Context, part *model.Part, paths []string) (*model.Part, error)
+	DeletePart(ctx context.Context, uuid uuid.UUID) error
+	SearchParts(ctx context.Context, req *model.SearchRequest) (*model.SearchPage, error)
+	WatchParts(ctx context.Context, req *model.WatchRequest) error
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+	TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.Part, error)
+	GetPriceHistory(ctx context.Context, partUuid uuid.UUID) ([]model.PricePeriod, error)
+	SchedulePrice(ctx context.Context, partUuid uuid.UUID, price float64, from time.Time) ([]model.PricePeriod, error)
+	SyncPrices(ctx context.Context) (int64, error)
+	CreateManufacturer(ctx context.Context, manufacturer *model.Manufacturer) (*model.Manufacturer, error)
+	GetManufacturer(ctx context.Context, uuid uuid.UUID) (*model.Manufacturer, error)
+	ListManufacturers(ctx context.Context, filter *model.ManufacturerFilter) ([]model.Manufacturer, error)
+	UpdateManufacturer(ctx context.Context, manufacturer *model.Manufacturer, paths []string) (*model.Manufacturer, error)
+	DeleteManufacturer(ctx context.Context, uuid uuid.UUID) error
+	GetCompatibleParts(ctx context.Context, partUuid uuid.UUID, categories []model.Category) ([]model.CompatiblePart, error)
+	ListKits(ctx context.Context) ([]model.Kit, error)
+	ExpandKit(ctx context.Context, kitUuid uuid.UUID) (*model.KitExpansion, error)
+	UploadAttachment(ctx context.Context, upload *model.AttachmentUpload) (*model.Attachment, error)
+	DownloadAttachment(ctx context.Context, partUuid, attachmentUuid uuid.UUID) (*model.Attachment, io.ReadCloser, error)
+}
+
+type LowStockProducer interface {
+	ProduceLowStock(ctx context.Context, event model.LowStockEvent) error
 }
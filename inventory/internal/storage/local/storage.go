@@ -0,0 +1,117 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	def "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage"
+)
+
+var _ def.BlobStorage = (*storage)(nil)
+
+// storage хранит содержимое в файлах под корневым каталогом, ключ — относительный путь файла
+type storage struct {
+	root string
+}
+
+// NewStorage создаёт хранилище в каталоге root, создавая его при необходимости
+func NewStorage(root string) (def.BlobStorage, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &storage{root: root}, nil
+}
+
+// Put пишет содержимое во временный файл рядом с целевым и переименовывает его:
+// читатели не видят недописанный файл
+func (s *storage) Put(ctx context.Context, key string, content io.Reader, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	_, err = io.Copy(file, contextReader{ctx: ctx, r: content})
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(file.Name(), path)
+	return err
+}
+
+func (s *storage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, model.ErrBlobNotFound
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *storage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// path переводит ключ в путь файла, не выпуская его за пределы корневого каталога
+func (s *storage) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// contextReader прерывает копирование при отмене контекста
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.r.Read(p)
+}
@@ -0,0 +1,105 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	def "github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage"
+)
+
+var _ def.BlobStorage = (*storage)(nil)
+
+// partSize — размер части multipart-загрузки. Размер вложения заранее неизвестен,
+// без него клиент рассчитывает части на объект в 5 ТиБ и держит в памяти по 512 МиБ
+const partSize = 16 << 20
+
+// Config — параметры подключения к S3-совместимому хранилищу
+type Config struct {
+	Endpoint  string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	Region    string
+	UseSSL    bool
+}
+
+type storage struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewStorage подключается к хранилищу и создаёт бакет, если его ещё нет
+func NewStorage(ctx context.Context, cfg Config) (def.BlobStorage, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		// С явным регионом клиент не запрашивает расположение бакета перед каждой операцией
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check s3 bucket %q: %w", cfg.Bucket, err)
+	}
+	if !exists {
+		err = client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 bucket %q: %w", cfg.Bucket, err)
+		}
+	}
+
+	return &storage{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *storage) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, content, -1, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    partSize,
+	})
+
+	return err
+}
+
+func (s *storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, toStorageError(err)
+	}
+
+	// GetObject не обращается к хранилищу до первого чтения: отсутствие объекта выясняем сразу
+	if _, err = object.Stat(); err != nil {
+		_ = object.Close()
+		return nil, toStorageError(err)
+	}
+
+	return object, nil
+}
+
+func (s *storage) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func toStorageError(err error) error {
+	if isNotFound(err) {
+		return model.ErrBlobNotFound
+	}
+
+	return err
+}
+
+func isNotFound(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
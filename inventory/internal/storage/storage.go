@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStorage — хранилище содержимого вложений. Ключи — пути через «/» без «..»
+type BlobStorage interface {
+	// Put записывает содержимое целиком. Уже существующий ключ перезаписывается
+	Put(ctx context.Context, key string, content io.Reader, contentType string) error
+	// Get открывает содержимое на чтение, для отсутствующего ключа — model.ErrBlobNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete удаляет содержимое. Отсутствующий ключ ошибкой не считается
+	Delete(ctx context.Context, key string) error
+}
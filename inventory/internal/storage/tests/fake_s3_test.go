@@ -0,0 +1,205 @@
+package storage_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeS3 — S3-совместимый сервер в памяти процесса на месте MinIO. Поддерживает только
+// запросы, которые делает драйвер: бакеты, multipart-загрузку, чтение и удаление объектов
+type fakeS3 struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	buckets map[string]map[string]fakeObject
+	uploads map[string]*fakeUpload
+	nextID  int
+}
+
+type fakeUpload struct {
+	contentType string
+	parts       map[int][]byte
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+	modified    time.Time
+}
+
+func newFakeS3() *fakeS3 {
+	f := &fakeS3{
+		buckets: make(map[string]map[string]fakeObject),
+		uploads: make(map[string]*fakeUpload),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+
+	return f
+}
+
+// Endpoint возвращает адрес сервера в виде host:port
+func (f *fakeS3) Endpoint() string {
+	return strings.TrimPrefix(f.URL, "http://")
+}
+
+func (f *fakeS3) object(bucket, key string) (fakeObject, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	object, ok := f.buckets[bucket][key]
+	return object, ok
+}
+
+func (f *fakeS3) handle(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequest")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	objects, bucketExists := f.buckets[bucket]
+
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		if !bucketExists {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case key == "" && r.Method == http.MethodPut:
+		f.buckets[bucket] = make(map[string]fakeObject)
+	case !bucketExists:
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.nextID++
+		uploadID := strconv.Itoa(f.nextID)
+		f.uploads[uploadID] = &fakeUpload{contentType: r.Header.Get("Content-Type"), parts: make(map[int][]byte)}
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: uploadID})
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		upload, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		upload.parts[number] = body
+		sum := md5.Sum(body)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		upload, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		delete(f.uploads, query.Get("uploadId"))
+
+		numbers := make([]int, 0, len(upload.parts))
+		for number := range upload.parts {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+
+		var data []byte
+		for _, number := range numbers {
+			data = append(data, upload.parts[number]...)
+		}
+		objects[key] = fakeObject{data: data, contentType: upload.contentType, modified: time.Now()}
+
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: `"multipart"`})
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.data)))
+		w.Header().Set("Content-Type", object.contentType)
+		w.Header().Set("Last-Modified", object.modified.UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"object"`)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object.data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+// readBody читает тело запроса, снимая разметку потоковой подписи aws-chunked:
+// «<размер в hex>;chunk-signature=…\r\n<данные>\r\n», последний фрагмент нулевой длины
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk size %q: %w", sizeHex, err)
+		}
+		if size == 0 {
+			return body.Bytes(), nil
+		}
+
+		if _, err = io.CopyN(&body, reader, size); err != nil {
+			return nil, err
+		}
+		if _, err = reader.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func writeXML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: code})
+}
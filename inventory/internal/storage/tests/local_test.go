@@ -0,0 +1,41 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/local"
+)
+
+func TestLocalStorageRejectsKeysOutsideRoot(t *testing.T) {
+	root := t.TempDir()
+	blobs, err := local.NewStorage(filepath.Join(root, "blobs"))
+	require.NoError(t, err)
+
+	for _, key := range []string{"../escape", "parts/../../escape", "/abs"} {
+		err = blobs.Put(context.Background(), key, bytes.NewReader([]byte("data")), "text/plain")
+		assert.Error(t, err, key)
+	}
+
+	_, err = os.Stat(filepath.Join(root, "escape"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLocalStorageLeavesNoTempFiles(t *testing.T) {
+	root := t.TempDir()
+	blobs, err := local.NewStorage(root)
+	require.NoError(t, err)
+
+	require.NoError(t, blobs.Put(context.Background(), "parts/a/b", bytes.NewReader([]byte("data")), "text/plain"))
+
+	entries, err := os.ReadDir(filepath.Join(root, "parts", "a"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "b", entries[0].Name())
+}
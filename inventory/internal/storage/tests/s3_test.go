@@ -0,0 +1,53 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/s3"
+)
+
+func TestS3StorageCreatesBucketAndKeepsContentType(t *testing.T) {
+	server := newFakeS3()
+	t.Cleanup(server.Close)
+
+	blobs, err := s3.NewStorage(context.Background(), s3.Config{
+		Endpoint:  server.Endpoint(),
+		Bucket:    "new-bucket",
+		AccessKey: "minio",
+		SecretKey: "minio-secret",
+		Region:    "us-east-1",
+	})
+	require.NoError(t, err)
+
+	err = blobs.Put(context.Background(), "parts/a/b", bytes.NewReader([]byte("%PDF-1.7")), "application/pdf")
+	require.NoError(t, err)
+
+	object, ok := server.object("new-bucket", "parts/a/b")
+	require.True(t, ok)
+	assert.Equal(t, "application/pdf", object.contentType)
+	assert.Equal(t, []byte("%PDF-1.7"), object.data)
+}
+
+func TestS3StorageUnavailable(t *testing.T) {
+	server := newFakeS3()
+	endpoint := server.Endpoint()
+	server.Close()
+
+	// Клиент повторяет запросы к недоступному хранилищу, ограничиваем ожидание
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	_, err := s3.NewStorage(ctx, s3.Config{
+		Endpoint: endpoint,
+		Bucket:   "attachments",
+		Region:   "us-east-1",
+	})
+
+	assert.Error(t, err)
+}
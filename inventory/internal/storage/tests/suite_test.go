@@ -0,0 +1,112 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/local"
+	"github.com/kont1n/MSA_Rocket_Factory/inventory/internal/storage/s3"
+)
+
+// BlobStorageSuite — общие проверки драйверов хранилища. Каждый драйвер запускает её
+// со своей фабрикой newStorage
+type BlobStorageSuite struct {
+	suite.Suite
+	newStorage func() storage.BlobStorage
+	storage    storage.BlobStorage
+}
+
+func (s *BlobStorageSuite) SetupTest() {
+	s.storage = s.newStorage()
+}
+
+func TestLocalStorage(t *testing.T) {
+	suite.Run(t, &BlobStorageSuite{newStorage: func() storage.BlobStorage {
+		blobs, err := local.NewStorage(t.TempDir())
+		require.NoError(t, err)
+		return blobs
+	}})
+}
+
+func TestS3Storage(t *testing.T) {
+	server := newFakeS3()
+	t.Cleanup(server.Close)
+
+	suite.Run(t, &BlobStorageSuite{newStorage: func() storage.BlobStorage {
+		blobs, err := s3.NewStorage(context.Background(), s3.Config{
+			Endpoint:  server.Endpoint(),
+			Bucket:    "attachments",
+			AccessKey: "minio",
+			SecretKey: "minio-secret",
+			Region:    "us-east-1",
+		})
+		require.NoError(t, err)
+		return blobs
+	}})
+}
+
+// read читает содержимое по ключу целиком
+func (s *BlobStorageSuite) read(key string) []byte {
+	reader, err := s.storage.Get(context.Background(), key)
+	s.Require().NoError(err)
+	defer func() { _ = reader.Close() }()
+
+	data, err := io.ReadAll(reader)
+	s.Require().NoError(err)
+
+	return data
+}
+
+func (s *BlobStorageSuite) TestPutGet() {
+	ctx := context.Background()
+
+	// Больше фрагмента потоковой подписи S3 (64 КиБ), чтобы содержимое шло несколькими частями
+	content := make([]byte, 200<<10)
+	_, _ = rand.Read(content)
+
+	err := s.storage.Put(ctx, "parts/a/b", bytes.NewReader(content), "application/pdf")
+
+	s.Require().NoError(err)
+	s.Equal(content, s.read("parts/a/b"))
+}
+
+func (s *BlobStorageSuite) TestPutOverwrites() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.storage.Put(ctx, "parts/a/b", bytes.NewReader([]byte("first")), "text/plain"))
+	s.Require().NoError(s.storage.Put(ctx, "parts/a/b", bytes.NewReader([]byte("second")), "text/plain"))
+
+	s.Equal([]byte("second"), s.read("parts/a/b"))
+}
+
+func (s *BlobStorageSuite) TestGetNotFound() {
+	reader, err := s.storage.Get(context.Background(), "parts/a/missing")
+
+	s.ErrorIs(err, model.ErrBlobNotFound)
+	s.Nil(reader)
+}
+
+func (s *BlobStorageSuite) TestDelete() {
+	ctx := context.Background()
+	s.Require().NoError(s.storage.Put(ctx, "parts/a/b", bytes.NewReader([]byte("data")), "text/plain"))
+
+	err := s.storage.Delete(ctx, "parts/a/b")
+
+	s.Require().NoError(err)
+	_, err = s.storage.Get(ctx, "parts/a/b")
+	s.ErrorIs(err, model.ErrBlobNotFound)
+}
+
+func (s *BlobStorageSuite) TestDeleteMissing() {
+	err := s.storage.Delete(context.Background(), "parts/a/missing")
+
+	s.NoError(err)
+}
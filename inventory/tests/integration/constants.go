@@ -8,4 +8,10 @@ const (
 
 	// collectionName - имя коллекции MongoDB для деталей ракет
 	collectionName = "parts"
+
+	// pricesCollectionName - имя коллекции MongoDB для истории цен деталей
+	pricesCollectionName = "part_prices"
+
+	// manufacturersCollectionName - имя коллекции MongoDB для справочника производителей
+	manufacturersCollectionName = "manufacturers"
 )
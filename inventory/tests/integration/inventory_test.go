@@ -5,13 +5,20 @@ package integration
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
 )
@@ -197,6 +204,57 @@ var _ = Describe("InventoryService", func() {
 			}
 		})
 
+		It("должен фильтровать детали по диапазону цен, остатку и производителю", func() {
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{
+					PriceMin:         proto.Float64(500000),
+					PriceMax:         proto.Float64(3000000),
+					MinStock:         proto.Int64(4),
+					ManufacturerName: []string{"Roscosmos", "Boeing"},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(HaveLen(1))
+			Expect(resp.GetParts()[0].GetPartUuid()).To(Equal(partUUIDs[2]))
+		})
+
+		It("должен требовать все теги в режиме TAG_MATCH_MODE_ALL", func() {
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{
+					Tags:     []string{"rocket", "fuel"},
+					TagMatch: inventoryV1.TagMatchMode_TAG_MATCH_MODE_ALL,
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(BeEmpty())
+		})
+
+		It("должен фильтровать детали по метаданным", func() {
+			part := env.GetTestPart()
+			part.PartUuid = ""
+			part.Metadata = map[string]*inventoryV1.Value{
+				"thrust": {Kind: &inventoryV1.Value_DoubleValue{DoubleValue: 1200}},
+			}
+			created, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: part})
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{
+					Metadata: []*inventoryV1.MetadataPredicate{{
+						Key:      "thrust",
+						Operator: inventoryV1.MetadataOperator_METADATA_OPERATOR_GT,
+						Value:    &inventoryV1.Value{Kind: &inventoryV1.Value_DoubleValue{DoubleValue: 1000}},
+					}},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(HaveLen(1))
+			Expect(resp.GetParts()[0].GetPartUuid()).To(Equal(created.GetPart().GetPartUuid()))
+		})
+
 		It("должен возвращать пустой список для фильтра без совпадений", func() {
 			filter := &inventoryV1.PartsFilter{
 				PartName: []string{"NonexistentPart"},
@@ -228,6 +286,462 @@ var _ = Describe("InventoryService", func() {
 				Expect(part.GetManufacturer().GetCountry()).To(Equal("Russia"))
 			}
 		})
+
+		It("должен отдавать детали постранично в порядке сортировки", func() {
+			var (
+				prices []float64
+				token  string
+			)
+			for {
+				resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+					PageSize:  2,
+					PageToken: token,
+					OrderBy:   "price desc",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(len(resp.GetParts())).To(BeNumerically("<=", 2))
+
+				for _, part := range resp.GetParts() {
+					prices = append(prices, part.GetPrice())
+				}
+
+				token = resp.GetNextPageToken()
+				if token == "" {
+					break
+				}
+			}
+
+			Expect(prices).To(HaveLen(3))
+			Expect(prices[0]).To(BeNumerically(">=", prices[1]))
+			Expect(prices[1]).To(BeNumerically(">=", prices[2]))
+		})
+
+		It("должен отклонять токен, выданный для другой сортировки", func() {
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				PageSize: 1,
+				OrderBy:  "name",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetNextPageToken()).ToNot(BeEmpty())
+
+			_, err = inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				PageSize:  1,
+				PageToken: resp.GetNextPageToken(),
+				OrderBy:   "price",
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("должен возвращать только поля из read_mask", func() {
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "price"}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(HaveLen(3))
+			for _, part := range resp.GetParts() {
+				Expect(part.GetPartUuid()).ToNot(BeEmpty())
+				Expect(part.GetName()).ToNot(BeEmpty())
+				Expect(part.GetDescription()).To(BeEmpty())
+				Expect(part.GetMetadata()).To(BeEmpty())
+			}
+		})
+	})
+
+	Describe("Управление каталогом", func() {
+		newPart := func(name string) *inventoryV1.Part {
+			return &inventoryV1.Part{
+				Name:          name,
+				Description:   "Деталь из интеграционного теста",
+				Price:         1000,
+				StockQuantity: 3,
+				Category:      inventoryV1.Category_CATEGORY_WING,
+				Dimensions:    &inventoryV1.Dimensions{Length: 10, Width: 5, Height: 1, Weight: 20},
+				Manufacturer:  &inventoryV1.Manufacturer{Name: "Wings Ltd", Country: "UK"},
+				Tags:          []string{"wing"},
+			}
+		}
+
+		It("должен создавать, обновлять по маске и удалять деталь", func() {
+			created, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: newPart("Крыло")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.GetPart().GetPartUuid()).ToNot(BeEmpty())
+			Expect(created.GetPart().GetCreatedAt()).ToNot(BeNil())
+
+			partUUID := created.GetPart().GetPartUuid()
+
+			updated, err := inventoryClient.UpdatePart(ctx, &inventoryV1.UpdatePartRequest{
+				Part:       &inventoryV1.Part{PartUuid: partUUID, Price: 1500, Name: "Не должно измениться"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetPart().GetPrice()).To(Equal(1500.0))
+			Expect(updated.GetPart().GetName()).To(Equal("Крыло"))
+			Expect(updated.GetPart().GetUpdatedAt().AsTime()).To(BeTemporally(">=", created.GetPart().GetUpdatedAt().AsTime()))
+
+			_, err = inventoryClient.DeletePart(ctx, &inventoryV1.DeletePartRequest{PartUuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{PartUuid: partUUID})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("должен связывать детали с производителем из справочника", func() {
+			first, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: newPart("Левое крыло")})
+			Expect(err).ToNot(HaveOccurred())
+			manufacturerUUID := first.GetPart().GetManufacturerUuid()
+			Expect(manufacturerUUID).ToNot(BeEmpty())
+
+			// То же название в другом регистре не заводит второго производителя
+			part := newPart("Правое крыло")
+			part.Manufacturer = &inventoryV1.Manufacturer{Name: " wings ltd "}
+			second, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: part})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.GetPart().GetManufacturerUuid()).To(Equal(manufacturerUUID))
+			Expect(second.GetPart().GetManufacturer().GetCountry()).To(Equal("UK"))
+
+			_, err = inventoryClient.UpdateManufacturer(ctx, &inventoryV1.UpdateManufacturerRequest{
+				Manufacturer: &inventoryV1.Manufacturer{ManufacturerUuid: manufacturerUUID, Country: "Ireland"},
+				UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"country"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			list, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{ManufacturerCountry: []string{"Ireland"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.GetParts()).To(HaveLen(2))
+
+			_, err = inventoryClient.DeleteManufacturer(ctx, &inventoryV1.DeleteManufacturerRequest{ManufacturerUuid: manufacturerUUID})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			unknown := newPart("Крыло неизвестного производителя")
+			unknown.ManufacturerUuid = gofakeit.UUID()
+			_, err = inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: unknown})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("должен перемещать остаток между складами и фильтровать детали по складу", func() {
+			part := newPart("Крыло со склада")
+			part.Stock = []*inventoryV1.StockLevel{{Warehouse: "BAIKONUR", Quantity: 3}}
+
+			created, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: part})
+			Expect(err).ToNot(HaveOccurred())
+			partUUID := created.GetPart().GetPartUuid()
+
+			moved, err := inventoryClient.TransferStock(ctx, &inventoryV1.TransferStockRequest{
+				PartUuid:      partUUID,
+				FromWarehouse: "BAIKONUR",
+				ToWarehouse:   "PLESETSK",
+				Quantity:      2,
+				Reason:        "интеграционный тест",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(moved.GetTransfer().GetTransferUuid()).ToNot(BeEmpty())
+			Expect(moved.GetPart().GetStockQuantity()).To(Equal(int64(3)))
+			Expect(moved.GetPart().GetStock()).To(HaveLen(2))
+
+			list, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{PartUuid: []string{partUUID}, Warehouse: []string{"PLESETSK"}, MinStock: proto.Int64(2)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.GetParts()).To(HaveLen(1))
+
+			_, err = inventoryClient.TransferStock(ctx, &inventoryV1.TransferStockRequest{
+				PartUuid:      partUUID,
+				FromWarehouse: "BAIKONUR",
+				ToWarehouse:   "VOSTOCHNY",
+				Quantity:      5,
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("должен вести историю цен и отдавать цену на запрошенный момент", func() {
+			part := newPart("Сопло с историей цен")
+			part.Price = 1000
+
+			created, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: part})
+			Expect(err).ToNot(HaveOccurred())
+			partUUID := created.GetPart().GetPartUuid()
+
+			_, err = inventoryClient.UpdatePart(ctx, &inventoryV1.UpdatePartRequest{
+				Part:       &inventoryV1.Part{PartUuid: partUUID, Price: 1200},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			from := time.Now().Add(time.Hour)
+			scheduled, err := inventoryClient.SchedulePrice(ctx, &inventoryV1.SchedulePriceRequest{
+				PartUuid:      partUUID,
+				Price:         1500,
+				EffectiveFrom: timestamppb.New(from),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scheduled.GetPrices()).To(HaveLen(3))
+			Expect(scheduled.GetPrices()[2].GetEffectiveTo()).To(BeNil())
+
+			current, err := inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{PartUuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(current.GetPart().GetPrice()).To(Equal(1200.0))
+
+			future, err := inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{
+				PartUuid: partUUID,
+				PriceAt:  timestamppb.New(from.Add(time.Minute)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(future.GetPart().GetPrice()).To(Equal(1500.0))
+
+			_, err = inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{
+				PartUuid: partUUID,
+				PriceAt:  timestamppb.New(created.GetPart().GetCreatedAt().AsTime().Add(-time.Hour)),
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+			history, err := inventoryClient.GetPriceHistory(ctx, &inventoryV1.GetPriceHistoryRequest{PartUuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history.GetPrices()).To(HaveLen(3))
+			Expect(history.GetPrices()[0].GetPrice()).To(Equal(1000.0))
+
+			_, err = inventoryClient.SchedulePrice(ctx, &inventoryV1.SchedulePriceRequest{
+				PartUuid:      partUUID,
+				Price:         900,
+				EffectiveFrom: timestamppb.New(time.Now().Add(-time.Hour)),
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("должен отклонять деталь без категории и с нулевой ценой", func() {
+			part := newPart("Некорректная деталь")
+			part.Category = inventoryV1.Category_CATEGORY_UNSPECIFIED
+			part.Price = 0
+
+			_, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: part})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("должен создавать пачку деталей целиком", func() {
+			resp, err := inventoryClient.BatchCreateParts(ctx, &inventoryV1.BatchCreatePartsRequest{
+				Parts: []*inventoryV1.Part{newPart("Левое крыло"), newPart("Правое крыло")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(HaveLen(2))
+
+			_, err = inventoryClient.BatchCreateParts(ctx, &inventoryV1.BatchCreatePartsRequest{
+				Parts: []*inventoryV1.Part{newPart("Новое крыло"), resp.GetParts()[0]},
+			})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+			duplicate := newPart("Хвостовое крыло")
+			duplicate.PartUuid = uuid.NewString()
+			_, err = inventoryClient.BatchCreateParts(ctx, &inventoryV1.BatchCreatePartsRequest{
+				Parts: []*inventoryV1.Part{duplicate, duplicate},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			// Отклонённые пачки не сохраняются частично
+			list, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{PartName: []string{"Новое крыло", "Хвостовое крыло"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.GetParts()).To(BeEmpty())
+		})
+
+		It("должен находить детали по словам с учётом словоформ", func() {
+			tank := newPart("Кислородный бак")
+			tank.Description = "Бак для жидкого кислорода"
+			pump := newPart("Насос")
+			pump.Description = "Подаёт окислитель из кислородного бака"
+			_, err := inventoryClient.BatchCreateParts(ctx, &inventoryV1.BatchCreatePartsRequest{
+				Parts: []*inventoryV1.Part{tank, pump, newPart("Крыло")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := inventoryClient.SearchParts(ctx, &inventoryV1.SearchPartsRequest{Query: "кислородные баки"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetResults()).To(HaveLen(2))
+
+			// Совпадение в названии поднимает деталь выше
+			Expect(resp.GetResults()[0].GetPart().GetName()).To(Equal("Кислородный бак"))
+			Expect(resp.GetResults()[0].GetScore()).To(BeNumerically(">", resp.GetResults()[1].GetScore()))
+			Expect(resp.GetResults()[0].GetHighlights()).To(ContainElement(HaveField("Snippet", "<em>Кислородный</em> <em>бак</em>")))
+
+			filtered, err := inventoryClient.SearchParts(ctx, &inventoryV1.SearchPartsRequest{
+				Query:  "кислородные баки",
+				Filter: &inventoryV1.PartsFilter{PartName: []string{"Насос"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filtered.GetResults()).To(HaveLen(1))
+		})
+
+		It("должен присылать изменения деталей и продолжать поток по resume_token", func() {
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			filter := &inventoryV1.PartsFilter{Category: []inventoryV1.Category{inventoryV1.Category_CATEGORY_WING}}
+			stream, err := inventoryClient.WatchParts(watchCtx, &inventoryV1.WatchPartsRequest{Filter: filter})
+			Expect(err).ToNot(HaveOccurred())
+
+			// Заголовки приходят после регистрации подписки: изменения ниже не потеряются
+			_, err = stream.Header()
+			Expect(err).ToNot(HaveOccurred())
+
+			engine := newPart("Двигатель")
+			engine.Category = inventoryV1.Category_CATEGORY_ENGINE
+			_, err = inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: engine})
+			Expect(err).ToNot(HaveOccurred())
+
+			created, err := inventoryClient.CreatePart(ctx, &inventoryV1.CreatePartRequest{Part: newPart("Закрылок")})
+			Expect(err).ToNot(HaveOccurred())
+			partUUID := created.GetPart().GetPartUuid()
+
+			_, err = inventoryClient.UpdatePart(ctx, &inventoryV1.UpdatePartRequest{
+				Part:       &inventoryV1.Part{PartUuid: partUUID, StockQuantity: 7},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"stock_quantity"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			// Деталь другой категории отфильтрована, первым приходит создание закрылка
+			first, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.GetType()).To(Equal(inventoryV1.PartEventType_PART_EVENT_TYPE_CREATED))
+			Expect(first.GetPart().GetPartUuid()).To(Equal(partUUID))
+			Expect(first.GetResumeToken()).ToNot(BeEmpty())
+
+			second, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.GetType()).To(Equal(inventoryV1.PartEventType_PART_EVENT_TYPE_UPDATED))
+			Expect(second.GetPart().GetStockQuantity()).To(Equal(int64(7)))
+
+			// Новая подписка с токеном первого события начинается со второго
+			resumed, err := inventoryClient.WatchParts(watchCtx, &inventoryV1.WatchPartsRequest{
+				Filter:      filter,
+				ResumeToken: first.GetResumeToken(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			replayed, err := resumed.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replayed.GetType()).To(Equal(inventoryV1.PartEventType_PART_EVENT_TYPE_UPDATED))
+			Expect(replayed.GetResumeToken()).To(Equal(second.GetResumeToken()))
+		})
+
+		It("должен отклонять некорректный resume_token", func() {
+			stream, err := inventoryClient.WatchParts(ctx, &inventoryV1.WatchPartsRequest{ResumeToken: "не токен"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Наборы и совместимость деталей", func() {
+		It("должен возвращать наборы из справочника в порядке названия", func() {
+			resp, err := inventoryClient.ListKits(ctx, &inventoryV1.ListKitsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetKits()).To(HaveLen(2))
+			Expect(resp.GetKits()[0].GetName()).To(Equal("Двигательная установка"))
+			Expect(resp.GetKits()[1].GetItems()).ToNot(BeEmpty())
+		})
+
+		It("должен возвращать NotFound для неизвестного набора", func() {
+			_, err := inventoryClient.ExpandKit(ctx, &inventoryV1.ExpandKitRequest{KitUuid: gofakeit.UUID()})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("должен возвращать NotFound для совместимых деталей неизвестной детали", func() {
+			_, err := inventoryClient.GetCompatibleParts(ctx, &inventoryV1.GetCompatiblePartsRequest{PartUuid: gofakeit.UUID()})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("Вложения деталей", func() {
+		upload := func(partUUID string, content []byte) (*inventoryV1.UploadAttachmentResponse, error) {
+			stream, err := inventoryClient.UploadAttachment(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = stream.Send(&inventoryV1.UploadAttachmentRequest{Data: &inventoryV1.UploadAttachmentRequest_Info{
+				Info: &inventoryV1.AttachmentInfo{
+					PartUuid: partUUID,
+					FileName: "engine.pdf",
+					Kind:     inventoryV1.AttachmentKind_ATTACHMENT_KIND_DATASHEET,
+				},
+			}})
+			Expect(err).ToNot(HaveOccurred())
+
+			// Содержимое частями, как его отправляет клиент. io.EOF значит, что сервер уже
+			// завершил вызов: его статус вернёт CloseAndRecv
+			for start := 0; start < len(content); start += 100 << 10 {
+				end := min(start+100<<10, len(content))
+				err = stream.Send(&inventoryV1.UploadAttachmentRequest{
+					Data: &inventoryV1.UploadAttachmentRequest_Chunk{Chunk: content[start:end]},
+				})
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			return stream.CloseAndRecv()
+		}
+
+		It("должен загружать вложение и отдавать его содержимое", func() {
+			partUUID, err := env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			content := append([]byte("%PDF-1.7\n"), []byte(gofakeit.LoremIpsumParagraph(100, 10, 20, "\n"))...)
+
+			uploaded, err := upload(partUUID, content)
+			Expect(err).ToNot(HaveOccurred())
+			attachment := uploaded.GetAttachment()
+			Expect(attachment.GetContentType()).To(Equal("application/pdf"))
+			Expect(attachment.GetSizeBytes()).To(Equal(int64(len(content))))
+			Expect(attachment.GetChecksumSha256()).To(HaveLen(64))
+
+			part, err := inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{PartUuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(part.GetPart().GetAttachments()).To(HaveLen(1))
+			Expect(part.GetPart().GetAttachments()[0].GetAttachmentUuid()).To(Equal(attachment.GetAttachmentUuid()))
+
+			stream, err := inventoryClient.DownloadAttachment(ctx, &inventoryV1.DownloadAttachmentRequest{
+				PartUuid:       partUUID,
+				AttachmentUuid: attachment.GetAttachmentUuid(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			first, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.GetAttachment().GetFileName()).To(Equal("engine.pdf"))
+
+			var downloaded []byte
+			for {
+				msg, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				downloaded = append(downloaded, msg.GetChunk()...)
+			}
+			Expect(downloaded).To(Equal(content))
+		})
+
+		It("должен возвращать NotFound при загрузке вложения неизвестной детали", func() {
+			_, err := upload(gofakeit.UUID(), []byte("data"))
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("должен возвращать NotFound для неизвестного вложения", func() {
+			partUUID, err := env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			stream, err := inventoryClient.DownloadAttachment(ctx, &inventoryV1.DownloadAttachmentRequest{
+				PartUuid:       partUUID,
+				AttachmentUuid: gofakeit.UUID(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
 	})
 
 	Describe("Полный сценарий работы с инвентарем", func() {
@@ -552,4 +1066,24 @@ var _ = Describe("InventoryService", func() {
 			}
 		})
 	})
+
+	Describe("Миграции схемы MongoDB", func() {
+		It("должен применить миграции при запуске и создать индексы", func() {
+			versions, err := env.AppliedMigrations(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(ContainElements(
+				int64(20250820100000), int64(20250820100100), int64(20250820100200), int64(20250822100000),
+				int64(20250825100000), int64(20250825100100), int64(20250827100000), int64(20250829100000),
+				int64(20250901100000),
+			))
+
+			indexes, err := env.PartsIndexNames(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(indexes).To(ContainElements(
+				"part_uuid_unique", "category", "manufacturer_uuid", "tags", "price_part_uuid", "parts_text_search",
+				"stock_warehouse_quantity",
+			))
+			Expect(indexes).ToNot(ContainElement("manufacturer_country"))
+		})
+	})
 })
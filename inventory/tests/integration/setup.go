@@ -66,6 +66,8 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		mongo.WithImageName(mongoImageName),
 		mongo.WithDatabase(mongoDatabase),
 		mongo.WithAuth(mongoUsername, mongoPassword),
+		// WatchParts читает change streams, которые доступны только на replica set
+		mongo.WithReplicaSet("rs0"),
 		mongo.WithLogger(logger.Logger()),
 	)
 	if err != nil {
@@ -94,6 +96,7 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		testcontainers.MongoUsernameKey: generatedMongo.Config().Username,
 		testcontainers.MongoPasswordKey: generatedMongo.Config().Password,
 		"MONGO_AUTH_DB":                 "admin",
+		"MONGO_DIRECT_CONNECTION":       "true",
 	}
 
 	// Создаем настраиваемую стратегию ожидания с увеличенным таймаутом
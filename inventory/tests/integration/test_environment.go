@@ -5,13 +5,17 @@ package integration
 import (
 	"context"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/migrator"
 	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
 )
 
@@ -22,7 +26,7 @@ func (env *TestEnvironment) InsertTestPart(ctx context.Context) (string, error)
 
 	partDoc := bson.M{
 		"_id":            primitive.NewObjectID(),
-		"order_uuid":     partUUID,
+		"part_uuid":      partUUID,
 		"name":           "Ракетный двигатель RD-180",
 		"description":    "Мощный ракетный двигатель для тяжелых носителей",
 		"price":          15000000.50,
@@ -58,7 +62,7 @@ func (env *TestEnvironment) InsertTestPart(ctx context.Context) (string, error)
 		databaseName = "inventory-service" // fallback значение
 	}
 
-	_, err := env.Mongo.Client().Database(databaseName).Collection(collectionName).InsertOne(ctx, partDoc)
+	err := env.insertPart(ctx, databaseName, partDoc)
 	if err != nil {
 		return "", err
 	}
@@ -87,7 +91,7 @@ func (env *TestEnvironment) InsertTestPartWithData(ctx context.Context, part *in
 
 	partDoc := bson.M{
 		"_id":            primitive.NewObjectID(),
-		"order_uuid":     partUUID,
+		"part_uuid":      partUUID,
 		"name":           part.GetName(),
 		"description":    part.GetDescription(),
 		"price":          part.GetPrice(),
@@ -116,7 +120,7 @@ func (env *TestEnvironment) InsertTestPartWithData(ctx context.Context, part *in
 		databaseName = "inventory-service" // fallback значение
 	}
 
-	_, err := env.Mongo.Client().Database(databaseName).Collection(collectionName).InsertOne(ctx, partDoc)
+	err := env.insertPart(ctx, databaseName, partDoc)
 	if err != nil {
 		return "", err
 	}
@@ -220,7 +224,7 @@ func (env *TestEnvironment) InsertMultipleTestParts(ctx context.Context) ([]stri
 	testParts := []bson.M{
 		{
 			"_id":            primitive.NewObjectID(),
-			"order_uuid":     uuid1,
+			"part_uuid":      uuid1,
 			"name":           "Ракетный двигатель RD-180",
 			"description":    "Мощный ракетный двигатель для тяжелых носителей",
 			"price":          15000000.50,
@@ -236,7 +240,7 @@ func (env *TestEnvironment) InsertMultipleTestParts(ctx context.Context) ([]stri
 		},
 		{
 			"_id":            primitive.NewObjectID(),
-			"order_uuid":     uuid2,
+			"part_uuid":      uuid2,
 			"name":           "Топливный бак Falcon-Tank-9",
 			"description":    "Алюминиевый топливный бак",
 			"price":          2500000.75,
@@ -252,7 +256,7 @@ func (env *TestEnvironment) InsertMultipleTestParts(ctx context.Context) ([]stri
 		},
 		{
 			"_id":            primitive.NewObjectID(),
-			"order_uuid":     uuid3,
+			"part_uuid":      uuid3,
 			"name":           "Иллюминатор космический",
 			"description":    "Прочный иллюминатор для космических кораблей",
 			"price":          750000.00,
@@ -274,10 +278,8 @@ func (env *TestEnvironment) InsertMultipleTestParts(ctx context.Context) ([]stri
 		databaseName = "inventory-service" // fallback значение
 	}
 
-	collection := env.Mongo.Client().Database(databaseName).Collection(collectionName)
-
 	for _, part := range testParts {
-		_, err := collection.InsertOne(ctx, part)
+		err := env.insertPart(ctx, databaseName, part)
 		if err != nil {
 			return nil, err
 		}
@@ -299,5 +301,122 @@ func (env *TestEnvironment) ClearPartsCollection(ctx context.Context) error {
 		return err
 	}
 
+	_, err = env.Mongo.Client().Database(databaseName).Collection(pricesCollectionName).DeleteMany(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+
+	_, err = env.Mongo.Client().Database(databaseName).Collection(manufacturersCollectionName).DeleteMany(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// insertPart — вставляет деталь и её начальную цену: сервис берёт цену из истории цен.
+// Производитель из документа заносится в справочник, а деталь получает ссылку на него
+func (env *TestEnvironment) insertPart(ctx context.Context, databaseName string, partDoc bson.M) error {
+	database := env.Mongo.Client().Database(databaseName)
+
+	if manufacturer, ok := partDoc["manufacturer"].(bson.M); ok {
+		delete(partDoc, "manufacturer")
+
+		manufacturerUUID, err := env.upsertManufacturer(ctx, database, manufacturer)
+		if err != nil {
+			return err
+		}
+		partDoc["manufacturer_uuid"] = manufacturerUUID
+	}
+
+	_, err := database.Collection(collectionName).InsertOne(ctx, partDoc)
+	if err != nil {
+		return err
+	}
+
+	_, err = database.Collection(pricesCollectionName).InsertOne(ctx, bson.M{
+		"part_uuid":      partDoc["part_uuid"],
+		"price":          partDoc["price"],
+		"effective_from": partDoc["created_at"],
+		"effective_to":   nil,
+	})
+
+	return err
+}
+
+// upsertManufacturer — находит производителя в справочнике по названию или добавляет его
+func (env *TestEnvironment) upsertManufacturer(ctx context.Context, database *mongo.Database, manufacturer bson.M) (string, error) {
+	name, _ := manufacturer["name"].(string)
+	country, _ := manufacturer["country"].(string)
+	website, _ := manufacturer["url"].(string)
+	now := primitive.NewDateTimeFromTime(time.Now())
+
+	var stored struct {
+		ManufacturerUUID string `bson:"manufacturer_uuid"`
+	}
+	err := database.Collection(manufacturersCollectionName).FindOneAndUpdate(ctx,
+		bson.M{"name_key": strings.ToLower(strings.TrimSpace(name))},
+		bson.M{"$setOnInsert": bson.M{
+			"manufacturer_uuid": gofakeit.UUID(),
+			"name":              name,
+			"country":           country,
+			"website":           website,
+			"created_at":        now,
+			"updated_at":        now,
+		}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&stored)
+	if err != nil {
+		return "", err
+	}
+
+	return stored.ManufacturerUUID, nil
+}
+
+// PartsIndexNames — возвращает имена индексов коллекции parts
+func (env *TestEnvironment) PartsIndexNames(ctx context.Context) ([]string, error) {
+	databaseName := os.Getenv("MONGO_DATABASE")
+	if databaseName == "" {
+		databaseName = "inventory-service" // fallback значение
+	}
+
+	specs, err := env.Mongo.Client().Database(databaseName).Collection(collectionName).Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+
+	return names, nil
+}
+
+// AppliedMigrations — возвращает версии миграций из журнала schema_migrations
+func (env *TestEnvironment) AppliedMigrations(ctx context.Context) ([]int64, error) {
+	databaseName := os.Getenv("MONGO_DATABASE")
+	if databaseName == "" {
+		databaseName = "inventory-service" // fallback значение
+	}
+
+	cursor, err := env.Mongo.Client().Database(databaseName).Collection(migrator.CollectionName).
+		Find(ctx, bson.M{"_id": bson.M{"$type": "long"}})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []struct {
+		Version int64 `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(records))
+	for _, record := range records {
+		versions = append(versions, record.Version)
+	}
+
+	return versions, nil
+}
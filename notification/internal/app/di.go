@@ -14,22 +14,30 @@ import (
 	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/service/consumer"
 	notificationService "github.com/kont1n/MSA_Rocket_Factory/notification/internal/service/notification"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
 	wrappedKafka "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/consumer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	kafkaMiddleware "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/middleware/kafka"
 )
 
 type diContainer struct {
 	notificationService        service.NotificationService
 	orderPaidConsumer          service.OrderPaidConsumerService
 	shipAssembledConsumer      service.ShipAssembledConsumerService
+	lowStockConsumer           service.LowStockConsumerService
 	telegramClient             telegramClient.TelegramClient
 	orderPaidConsumerGroup     sarama.ConsumerGroup
 	shipAssembledConsumerGroup sarama.ConsumerGroup
+	lowStockConsumerGroup      sarama.ConsumerGroup
 	orderPaidKafkaConsumer     wrappedKafka.Consumer
 	shipAssembledKafkaConsumer wrappedKafka.Consumer
+	lowStockKafkaConsumer      wrappedKafka.Consumer
 	orderPaidDecoder           kafka.OrderPaidDecoder
 	shipAssembledDecoder       kafka.ShipAssembledDecoder
+	lowStockDecoder            kafka.LowStockDecoder
+
+	healthRegistry *health.Registry
 }
 
 func NewDiContainer() *diContainer {
@@ -38,7 +46,10 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) NotificationService(ctx context.Context) service.NotificationService {
 	if d.notificationService == nil {
-		d.notificationService = notificationService.NewService(d.TelegramClient(ctx))
+		d.notificationService = notificationService.NewService(
+			d.TelegramClient(ctx),
+			config.AppConfig().Telegram.OpsChatID(),
+		)
 	}
 	return d.notificationService
 }
@@ -65,6 +76,17 @@ func (d *diContainer) ShipAssembledConsumer(ctx context.Context) service.ShipAss
 	return d.shipAssembledConsumer
 }
 
+func (d *diContainer) LowStockConsumer(ctx context.Context) service.LowStockConsumerService {
+	if d.lowStockConsumer == nil {
+		d.lowStockConsumer = consumer.NewLowStockService(
+			d.LowStockKafkaConsumer(),
+			d.LowStockDecoder(ctx),
+			d.NotificationService(ctx),
+		)
+	}
+	return d.lowStockConsumer
+}
+
 func (d *diContainer) TelegramClient(ctx context.Context) telegramClient.TelegramClient {
 	if d.telegramClient == nil {
 		client, err := telegramClient.NewClient(ctx, config.AppConfig().Telegram)
@@ -92,7 +114,7 @@ func (d *diContainer) OrderPaidConsumerGroup() sarama.ConsumerGroup {
 		if err != nil {
 			panic(fmt.Sprintf("failed to create order paid consumer group: %s\n", err.Error()))
 		}
-		closer.AddNamed("OrderPaid Kafka consumer group", func(ctx context.Context) error {
+		closer.AddPhase(closer.PhaseConsumers, "OrderPaid Kafka consumer group", func(ctx context.Context) error {
 			return d.orderPaidConsumerGroup.Close()
 		})
 
@@ -112,7 +134,7 @@ func (d *diContainer) ShipAssembledConsumerGroup() sarama.ConsumerGroup {
 		if err != nil {
 			panic(fmt.Sprintf("failed to create ship assembled consumer group: %s\n", err.Error()))
 		}
-		closer.AddNamed("ShipAssembled Kafka consumer group", func(ctx context.Context) error {
+		closer.AddPhase(closer.PhaseConsumers, "ShipAssembled Kafka consumer group", func(ctx context.Context) error {
 			return d.shipAssembledConsumerGroup.Close()
 		})
 
@@ -122,6 +144,26 @@ func (d *diContainer) ShipAssembledConsumerGroup() sarama.ConsumerGroup {
 	return d.shipAssembledConsumerGroup
 }
 
+func (d *diContainer) LowStockConsumerGroup() sarama.ConsumerGroup {
+	if d.lowStockConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().LowStockConsumer.GroupID(),
+			config.AppConfig().LowStockConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create low stock consumer group: %s\n", err.Error()))
+		}
+		closer.AddPhase(closer.PhaseConsumers, "InventoryLowStock Kafka consumer group", func(ctx context.Context) error {
+			return d.lowStockConsumerGroup.Close()
+		})
+
+		d.lowStockConsumerGroup = consumerGroup
+	}
+
+	return d.lowStockConsumerGroup
+}
+
 func (d *diContainer) OrderPaidKafkaConsumer() wrappedKafka.Consumer {
 	if d.orderPaidKafkaConsumer == nil {
 		d.orderPaidKafkaConsumer = wrappedKafkaConsumer.NewConsumer(
@@ -129,7 +171,9 @@ func (d *diContainer) OrderPaidKafkaConsumer() wrappedKafka.Consumer {
 			[]string{
 				config.AppConfig().OrderPaidConsumer.Topic(),
 			},
-			logger.Logger(),
+			logger.Named("kafka"),
+			kafkaMiddleware.Recover(logger.Named("kafka")),
+			kafkaMiddleware.Timeout(config.AppConfig().OrderPaidConsumer.HandlerTimeout()),
 		)
 	}
 
@@ -143,13 +187,31 @@ func (d *diContainer) ShipAssembledKafkaConsumer() wrappedKafka.Consumer {
 			[]string{
 				config.AppConfig().ShipAssembledConsumer.Topic(),
 			},
-			logger.Logger(),
+			logger.Named("kafka"),
+			kafkaMiddleware.Recover(logger.Named("kafka")),
+			kafkaMiddleware.Timeout(config.AppConfig().ShipAssembledConsumer.HandlerTimeout()),
 		)
 	}
 
 	return d.shipAssembledKafkaConsumer
 }
 
+func (d *diContainer) LowStockKafkaConsumer() wrappedKafka.Consumer {
+	if d.lowStockKafkaConsumer == nil {
+		d.lowStockKafkaConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.LowStockConsumerGroup(),
+			[]string{
+				config.AppConfig().LowStockConsumer.Topic(),
+			},
+			logger.Named("kafka"),
+			kafkaMiddleware.Recover(logger.Named("kafka")),
+			kafkaMiddleware.Timeout(config.AppConfig().LowStockConsumer.HandlerTimeout()),
+		)
+	}
+
+	return d.lowStockKafkaConsumer
+}
+
 func (d *diContainer) OrderPaidDecoder(ctx context.Context) kafka.OrderPaidDecoder {
 	if d.orderPaidDecoder == nil {
 		d.orderPaidDecoder = decoder.NewOrderPaidDecoder()
@@ -165,3 +227,32 @@ func (d *diContainer) ShipAssembledDecoder(ctx context.Context) kafka.ShipAssemb
 
 	return d.shipAssembledDecoder
 }
+
+func (d *diContainer) LowStockDecoder(ctx context.Context) kafka.LowStockDecoder {
+	if d.lowStockDecoder == nil {
+		d.lowStockDecoder = decoder.NewLowStockDecoder()
+	}
+
+	return d.lowStockDecoder
+}
+
+func (d *diContainer) HealthRegistry(_ context.Context) *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+		registry.Register("kafka", health.Readiness, health.KafkaCheck(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderPaidConsumer.Config(),
+		))
+
+		watchCtx, cancel := context.WithCancel(context.Background())
+		registry.Start(watchCtx)
+		closer.AddNamed("Health checks", func(ctx context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
@@ -1,11 +1,12 @@
 package config
 
 import (
+	"errors"
+	"io"
 	"os"
 
-	"github.com/joho/godotenv"
-
 	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/config/env"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 var appConfig *config
@@ -15,49 +16,94 @@ type config struct {
 	Kafka                 KafkaConfig
 	OrderPaidConsumer     OrderPaidConsumerConfig
 	ShipAssembledConsumer ShipAssemblyConsumerConfig
+	LowStockConsumer      LowStockConsumerConfig
 	Telegram              TelegramConfig
+	Shutdown              ShutdownConfig
+	Admin                 AdminConfig
+
+	effective map[string]string
 }
 
 func Load(path ...string) error {
-	err := godotenv.Load(path...)
-	if err != nil && !os.IsNotExist(err) {
-		return err
-	}
-
-	loggerCfg, err := env.NewLoggerConfig()
+	loader, err := platformConfig.New(
+		platformConfig.WithFile(os.Getenv(platformConfig.FileEnv)),
+		platformConfig.WithDotEnv(path...),
+	)
 	if err != nil {
 		return err
 	}
 
-	kafkaCfg, err := env.NewKafkaConfig()
+	cfg, err := load(loader)
 	if err != nil {
 		return err
 	}
 
-	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig()
-	if err != nil {
-		return err
-	}
+	appConfig = cfg
+
+	return nil
+}
 
-	shipAssembledConsumerCfg, err := env.NewShipAssembledConsumerConfig()
+// WriteReference выводит справочник всех ключей конфигурации сервиса в формате Markdown
+func WriteReference(w io.Writer) error {
+	loader, err := platformConfig.New(platformConfig.WithEnviron(nil))
 	if err != nil {
 		return err
 	}
 
-	telegramCfg, err := env.NewTelegramConfig()
-	if err != nil {
-		return err
+	// На пустом окружении обязательные ключи не заполнены — нужны только их описания
+	_, _ = load(loader)
+
+	return platformConfig.WriteReference(w, "Notification", loader.Keys())
+}
+
+// load читает все секции конфигурации и возвращает ошибки всех секций разом
+func load(loader *platformConfig.Loader) (*config, error) {
+	var errs []error
+
+	loggerCfg, err := env.NewLoggerConfig(loader)
+	errs = append(errs, err)
+
+	kafkaCfg, err := env.NewKafkaConfig(loader)
+	errs = append(errs, err)
+
+	orderPaidConsumerCfg, err := env.NewOrderPaidConsumerConfig(loader)
+	errs = append(errs, err)
+
+	shipAssembledConsumerCfg, err := env.NewShipAssembledConsumerConfig(loader)
+	errs = append(errs, err)
+
+	lowStockConsumerCfg, err := env.NewLowStockConsumerConfig(loader)
+	errs = append(errs, err)
+
+	telegramCfg, err := env.NewTelegramConfig(loader)
+	errs = append(errs, err)
+
+	shutdownCfg, err := env.NewShutdownConfig(loader)
+	errs = append(errs, err)
+
+	adminCfg, err := env.NewAdminConfig(loader)
+	errs = append(errs, err)
+
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
 	}
 
-	appConfig = &config{
+	return &config{
 		Logger:                loggerCfg,
 		Kafka:                 kafkaCfg,
 		OrderPaidConsumer:     orderPaidConsumerCfg,
 		ShipAssembledConsumer: shipAssembledConsumerCfg,
+		LowStockConsumer:      lowStockConsumerCfg,
 		Telegram:              telegramCfg,
-	}
+		Shutdown:              shutdownCfg,
+		Admin:                 adminCfg,
+		effective:             loader.Effective(),
+	}, nil
+}
 
-	return nil
+// Effective возвращает итоговые значения всех ключей конфигурации со скрытыми секретами
+func (c *config) Effective() map[string]string {
+	return c.effective
 }
 
 func AppConfig() *config {
@@ -0,0 +1,49 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type lowStockConsumerEnvConfig struct {
+	Topic          string        `env:"CONSUMER_LOW_STOCK_TOPIC_NAME,required" desc:"Топик событий о низком остатке деталей"`
+	GroupID        string        `env:"CONSUMER_LOW_STOCK_GROUP_ID,required" desc:"Группа потребителей событий о низком остатке"`
+	HandlerTimeout time.Duration `env:"CONSUMER_LOW_STOCK_HANDLER_TIMEOUT" envDefault:"15s" validate:"min=1s" desc:"Максимальное время обработки события о низком остатке"`
+}
+
+type LowStockConsumerConfig struct {
+	raw lowStockConsumerEnvConfig
+}
+
+func NewLowStockConsumerConfig(loader *platformConfig.Loader) (*LowStockConsumerConfig, error) {
+	var raw lowStockConsumerEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &LowStockConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *LowStockConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *LowStockConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *LowStockConsumerConfig) HandlerTimeout() time.Duration {
+	return cfg.raw.HandlerTimeout
+}
+
+func (cfg *LowStockConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
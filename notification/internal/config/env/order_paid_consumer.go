@@ -1,22 +1,26 @@
 package env
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
-	"github.com/caarlos0/env/v11"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type orderPaidConsumerEnvConfig struct {
-	Topic   string `env:"CONSUMER_ORDER_PAID_TOPIC_NAME,required"`
-	GroupID string `env:"CONSUMER_ORDER_PAID_GROUP_ID,required"`
+	Topic          string        `env:"CONSUMER_ORDER_PAID_TOPIC_NAME,required" desc:"Топик событий об оплате заказа"`
+	GroupID        string        `env:"CONSUMER_ORDER_PAID_GROUP_ID,required" desc:"Группа потребителей событий об оплате"`
+	HandlerTimeout time.Duration `env:"CONSUMER_ORDER_PAID_HANDLER_TIMEOUT" envDefault:"15s" validate:"min=1s" desc:"Максимальное время обработки события об оплате"`
 }
 
 type OrderPaidConsumerConfig struct {
 	raw orderPaidConsumerEnvConfig
 }
 
-func NewOrderPaidConsumerConfig() (*OrderPaidConsumerConfig, error) {
+func NewOrderPaidConsumerConfig(loader *platformConfig.Loader) (*OrderPaidConsumerConfig, error) {
 	var raw orderPaidConsumerEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -31,6 +35,10 @@ func (cfg *OrderPaidConsumerConfig) GroupID() string {
 	return cfg.raw.GroupID
 }
 
+func (cfg *OrderPaidConsumerConfig) HandlerTimeout() time.Duration {
+	return cfg.raw.HandlerTimeout
+}
+
 func (cfg *OrderPaidConsumerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V4_0_0_0
@@ -1,20 +1,21 @@
 package env
 
-import "github.com/caarlos0/env/v11"
+import platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 
 type telegramEnvConfig struct {
-	BotToken     string `env:"TELEGRAM_BOT_TOKEN,required"`
-	ChatID       string `env:"TELEGRAM_CHAT_ID,required"`
-	SkipAPICheck bool   `env:"TELEGRAM_SKIP_API_CHECK"`
+	BotToken     string `env:"TELEGRAM_BOT_TOKEN,required" desc:"Токен Telegram-бота" secret:"true"`
+	ChatID       string `env:"TELEGRAM_CHAT_ID,required" desc:"Идентификатор чата для уведомлений"`
+	OpsChatID    int64  `env:"TELEGRAM_OPS_CHAT_ID" envDefault:"0" desc:"Идентификатор чата службы эксплуатации для оповещений о низком остатке; 0 — чат TELEGRAM_CHAT_ID"`
+	SkipAPICheck bool   `env:"TELEGRAM_SKIP_API_CHECK" desc:"Не проверять доступность Telegram API при старте"`
 }
 
 type TelegramConfig struct {
 	raw telegramEnvConfig
 }
 
-func NewTelegramConfig() (*TelegramConfig, error) {
+func NewTelegramConfig(loader *platformConfig.Loader) (*TelegramConfig, error) {
 	var raw telegramEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -29,6 +30,10 @@ func (cfg *TelegramConfig) ChatID() string {
 	return cfg.raw.ChatID
 }
 
+func (cfg *TelegramConfig) OpsChatID() int64 {
+	return cfg.raw.OpsChatID
+}
+
 func (cfg *TelegramConfig) SkipAPICheck() bool {
 	return cfg.raw.SkipAPICheck
 }
@@ -1,10 +1,21 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
+	ModuleLevels() map[string]string
+	Outputs() []string
+	FilePath() string
+	FileMaxSizeMB() int
+	FileMaxBackups() int
+	OTLPEndpoint() string
+	StacktraceRate() int
 }
 
 type KafkaConfig interface {
@@ -14,17 +25,36 @@ type KafkaConfig interface {
 type OrderPaidConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	HandlerTimeout() time.Duration
 	Config() *sarama.Config
 }
 
 type ShipAssemblyConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	HandlerTimeout() time.Duration
+	Config() *sarama.Config
+}
+
+type LowStockConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	HandlerTimeout() time.Duration
 	Config() *sarama.Config
 }
 
 type TelegramConfig interface {
 	BotToken() string
 	ChatID() string
+	OpsChatID() int64
 	SkipAPICheck() bool
 }
+
+type ShutdownConfig interface {
+	Timeout() time.Duration
+	StepTimeout() time.Duration
+}
+
+type AdminConfig interface {
+	Address() string
+}
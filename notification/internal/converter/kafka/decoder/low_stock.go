@@ -0,0 +1,58 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
+	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
+)
+
+type LowStockDecoder struct {
+	registry *kafkaEvent.Registry[*model.LowStockEvent]
+}
+
+func NewLowStockDecoder() *LowStockDecoder {
+	// Сообщения без заголовков CloudEvents считаются событиями версии v1
+	registry := kafkaEvent.NewRegistry[*model.LowStockEvent](events.LowStockType, events.SchemaVersionV1).
+		Register(events.LowStockType, events.SchemaVersionV1, decodeLowStockV1)
+
+	return &LowStockDecoder{registry: registry}
+}
+
+// Decode выбирает декодер по типу и версии события из заголовков сообщения.
+func (d *LowStockDecoder) Decode(msg kafka.Message) (*model.LowStockEvent, error) {
+	return d.registry.Decode(msg)
+}
+
+func decodeLowStockV1(data []byte) (*model.LowStockEvent, error) {
+	var protoEvent eventsV1.InventoryLowStock
+	err := proto.Unmarshal(data, &protoEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	eventUUID, err := parseUUID(protoEvent.EventUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse event UUID: %w", err)
+	}
+
+	partUUID, err := parseUUID(protoEvent.PartUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse part UUID: %w", err)
+	}
+
+	return &model.LowStockEvent{
+		EventUUID:             eventUUID,
+		PartUUID:              partUUID,
+		Name:                  protoEvent.Name,
+		Category:              protoEvent.Category,
+		StockQuantity:         protoEvent.StockQuantity,
+		PreviousStockQuantity: protoEvent.PreviousStockQuantity,
+		Threshold:             protoEvent.Threshold,
+	}, nil
+}
@@ -6,16 +6,30 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
 	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
 )
 
-type OrderPaidDecoder struct{}
+type OrderPaidDecoder struct {
+	registry *kafkaEvent.Registry[*model.OrderPaidEvent]
+}
 
 func NewOrderPaidDecoder() *OrderPaidDecoder {
-	return &OrderPaidDecoder{}
+	// Сообщения без заголовков CloudEvents считаются событиями версии v1
+	registry := kafkaEvent.NewRegistry[*model.OrderPaidEvent](events.OrderPaidType, events.SchemaVersionV1).
+		Register(events.OrderPaidType, events.SchemaVersionV1, decodeOrderPaidV1)
+
+	return &OrderPaidDecoder{registry: registry}
+}
+
+// Decode выбирает декодер по типу и версии события из заголовков сообщения.
+func (d *OrderPaidDecoder) Decode(msg kafka.Message) (*model.OrderPaidEvent, error) {
+	return d.registry.Decode(msg)
 }
 
-func (d *OrderPaidDecoder) Decode(data []byte) (*model.OrderPaidEvent, error) {
+func decodeOrderPaidV1(data []byte) (*model.OrderPaidEvent, error) {
 	var protoEvent eventsV1.OrderPaid
 	err := proto.Unmarshal(data, &protoEvent)
 	if err != nil {
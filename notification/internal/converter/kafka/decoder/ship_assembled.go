@@ -6,16 +6,30 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
 	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
 )
 
-type ShipAssembledDecoder struct{}
+type ShipAssembledDecoder struct {
+	registry *kafkaEvent.Registry[*model.ShipAssembledEvent]
+}
 
 func NewShipAssembledDecoder() *ShipAssembledDecoder {
-	return &ShipAssembledDecoder{}
+	// Сообщения без заголовков CloudEvents считаются событиями версии v1
+	registry := kafkaEvent.NewRegistry[*model.ShipAssembledEvent](events.ShipAssembledType, events.SchemaVersionV1).
+		Register(events.ShipAssembledType, events.SchemaVersionV1, decodeShipAssembledV1)
+
+	return &ShipAssembledDecoder{registry: registry}
+}
+
+// Decode выбирает декодер по типу и версии события из заголовков сообщения.
+func (d *ShipAssembledDecoder) Decode(msg kafka.Message) (*model.ShipAssembledEvent, error) {
+	return d.registry.Decode(msg)
 }
 
-func (d *ShipAssembledDecoder) Decode(data []byte) (*model.ShipAssembledEvent, error) {
+func decodeShipAssembledV1(data []byte) (*model.ShipAssembledEvent, error) {
 	var protoEvent eventsV1.ShipAssembled
 	err := proto.Unmarshal(data, &protoEvent)
 	if err != nil {
@@ -1,11 +1,18 @@
 package kafka
 
-import "github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+)
 
 type OrderPaidDecoder interface {
-	Decode(data []byte) (*model.OrderPaidEvent, error)
+	Decode(msg kafka.Message) (*model.OrderPaidEvent, error)
 }
 
 type ShipAssembledDecoder interface {
-	Decode(data []byte) (*model.ShipAssembledEvent, error)
+	Decode(msg kafka.Message) (*model.ShipAssembledEvent, error)
+}
+
+type LowStockDecoder interface {
+	Decode(msg kafka.Message) (*model.LowStockEvent, error)
 }
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	model "github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	kafka "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -12,9 +13,9 @@ type OrderPaidDecoder struct {
 	mock.Mock
 }
 
-// Decode provides a mock function with given fields: data
-func (_m *OrderPaidDecoder) Decode(data []byte) (*model.OrderPaidEvent, error) {
-	ret := _m.Called(data)
+// Decode provides a mock function with given fields: msg
+func (_m *OrderPaidDecoder) Decode(msg kafka.Message) (*model.OrderPaidEvent, error) {
+	ret := _m.Called(msg)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Decode")
@@ -22,19 +23,19 @@ func (_m *OrderPaidDecoder) Decode(data []byte) (*model.OrderPaidEvent, error) {
 
 	var r0 *model.OrderPaidEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]byte) (*model.OrderPaidEvent, error)); ok {
-		return rf(data)
+	if rf, ok := ret.Get(0).(func(kafka.Message) (*model.OrderPaidEvent, error)); ok {
+		return rf(msg)
 	}
-	if rf, ok := ret.Get(0).(func([]byte) *model.OrderPaidEvent); ok {
-		r0 = rf(data)
+	if rf, ok := ret.Get(0).(func(kafka.Message) *model.OrderPaidEvent); ok {
+		r0 = rf(msg)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.OrderPaidEvent)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(data)
+	if rf, ok := ret.Get(1).(func(kafka.Message) error); ok {
+		r1 = rf(msg)
 	} else {
 		r1 = ret.Error(1)
 	}
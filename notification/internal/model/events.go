@@ -23,6 +23,17 @@ type ShipAssembledEvent struct {
 	BuildTime int64
 }
 
+// LowStockEvent - событие о падении остатка детали до порога дозаказа
+type LowStockEvent struct {
+	EventUUID             uuid.UUID
+	PartUUID              uuid.UUID
+	Name                  string
+	Category              string
+	StockQuantity         int64
+	PreviousStockQuantity int64
+	Threshold             int64
+}
+
 // SightingInfo - информация о наблюдении UFO
 type SightingInfo struct {
 	Location        string
@@ -0,0 +1,63 @@
+package consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/kont1n/MSA_Rocket_Factory/notification/internal/converter/kafka"
+	def "github.com/kont1n/MSA_Rocket_Factory/notification/internal/service"
+	wrappedKafka "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+type lowStockService struct {
+	lowStockConsumer    wrappedKafka.Consumer
+	lowStockDecoder     kafkaConverter.LowStockDecoder
+	notificationService def.NotificationService
+}
+
+func NewLowStockService(lowStockConsumer wrappedKafka.Consumer, lowStockDecoder kafkaConverter.LowStockDecoder, notificationService def.NotificationService) *lowStockService {
+	return &lowStockService{
+		lowStockConsumer:    lowStockConsumer,
+		lowStockDecoder:     lowStockDecoder,
+		notificationService: notificationService,
+	}
+}
+
+func (s *lowStockService) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting InventoryLowStock Consumer service")
+
+	err := s.lowStockConsumer.Consume(ctx, s.LowStockHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from inventory.low_stock topic error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *lowStockService) LowStockHandler(ctx context.Context, msg wrappedKafka.Message) error {
+	event, err := s.lowStockDecoder.Decode(msg)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode InventoryLowStock", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing InventoryLowStock message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID.String()),
+		zap.String("part_uuid", event.PartUUID.String()),
+	)
+
+	// Отправляем уведомление
+	err = s.notificationService.NotifyLowStock(ctx, event)
+	if err != nil {
+		logger.Error(ctx, "Failed to send InventoryLowStock notification", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -38,7 +38,7 @@ func (s *orderPaidService) RunConsumer(ctx context.Context) error {
 }
 
 func (s *orderPaidService) OrderPaidHandler(ctx context.Context, msg wrappedKafka.Message) error {
-	event, err := s.orderPaidDecoder.Decode(msg.Value)
+	event, err := s.orderPaidDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode OrderPaid", zap.Error(err))
 		return err
@@ -38,7 +38,7 @@ func (s *shipAssembledService) RunConsumer(ctx context.Context) error {
 }
 
 func (s *shipAssembledService) ShipAssembledHandler(ctx context.Context, msg wrappedKafka.Message) error {
-	event, err := s.shipAssembledDecoder.Decode(msg.Value)
+	event, err := s.shipAssembledDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode ShipAssembled", zap.Error(err))
 		return err
@@ -0,0 +1,176 @@
+package consumer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	decoderMocks "github.com/kont1n/MSA_Rocket_Factory/notification/internal/converter/kafka/mocks"
+	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/service"
+	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/service/consumer"
+	consumerMocks "github.com/kont1n/MSA_Rocket_Factory/notification/internal/service/consumer/mocks"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+type LowStockConsumerTestSuite struct {
+	suite.Suite
+	lowStockConsumer    *consumerMocks.Consumer
+	lowStockDecoder     *decoderMocks.LowStockDecoder
+	notificationService *mockNotificationService
+	service             service.LowStockConsumerService
+}
+
+func (s *LowStockConsumerTestSuite) SetupSuite() {
+	// Инициализируем no-op логгер для тестов
+	logger.SetNopLogger()
+
+	s.lowStockConsumer = consumerMocks.NewConsumer(s.T())
+	s.lowStockDecoder = decoderMocks.NewLowStockDecoder(s.T())
+	s.notificationService = &mockNotificationService{}
+
+	s.service = consumer.NewLowStockService(
+		s.lowStockConsumer,
+		s.lowStockDecoder,
+		s.notificationService,
+	)
+}
+
+func (s *LowStockConsumerTestSuite) SetupTest() {
+	// Сбрасываем моки перед каждым тестом
+	s.lowStockConsumer.ExpectedCalls = nil
+	s.lowStockDecoder.ExpectedCalls = nil
+	s.notificationService.reset()
+}
+
+func (s *LowStockConsumerTestSuite) TearDownSuite() {
+}
+
+func TestLowStockConsumerTestSuite(t *testing.T) {
+	suite.Run(t, new(LowStockConsumerTestSuite))
+}
+
+func (s *LowStockConsumerTestSuite) TestLowStockHandler_Success() {
+	// Подготавливаем тестовые данные
+	event := &model.LowStockEvent{
+		EventUUID:             uuid.New(),
+		PartUUID:              uuid.New(),
+		Name:                  "RD-180",
+		Category:              "ENGINE",
+		StockQuantity:         0,
+		PreviousStockQuantity: 3,
+		Threshold:             2,
+	}
+
+	msg := kafka.Message{
+		Topic:     "inventory.low_stock",
+		Partition: 0,
+		Offset:    1,
+		Value:     []byte("test message"),
+	}
+
+	// Настраиваем моки
+	s.lowStockDecoder.On("Decode", msg).Return(event, nil)
+	s.notificationService.On("NotifyLowStock", mock.Anything, event).Return(nil)
+
+	// Выполняем тест
+	err := s.service.(interface {
+		LowStockHandler(ctx context.Context, msg kafka.Message) error
+	}).LowStockHandler(context.Background(), msg)
+
+	// Проверяем результат
+	s.NoError(err)
+	s.lowStockDecoder.AssertExpectations(s.T())
+	s.notificationService.AssertExpectations(s.T())
+}
+
+func (s *LowStockConsumerTestSuite) TestLowStockHandler_DecodeError() {
+	// Подготавливаем тестовые данные
+	msg := kafka.Message{
+		Topic:     "inventory.low_stock",
+		Partition: 0,
+		Offset:    1,
+		Value:     []byte("invalid message"),
+	}
+
+	// Настраиваем мок для ошибки декодирования
+	expectedError := errors.New("decode error")
+	s.lowStockDecoder.On("Decode", msg).Return(nil, expectedError)
+
+	// Выполняем тест
+	err := s.service.(interface {
+		LowStockHandler(ctx context.Context, msg kafka.Message) error
+	}).LowStockHandler(context.Background(), msg)
+
+	// Проверяем результат
+	s.Error(err)
+	s.Equal(expectedError, err)
+	s.lowStockDecoder.AssertExpectations(s.T())
+}
+
+func (s *LowStockConsumerTestSuite) TestLowStockHandler_NotificationError() {
+	// Подготавливаем тестовые данные
+	event := &model.LowStockEvent{
+		EventUUID:             uuid.New(),
+		PartUUID:              uuid.New(),
+		Name:                  "RD-180",
+		Category:              "ENGINE",
+		StockQuantity:         0,
+		PreviousStockQuantity: 3,
+		Threshold:             2,
+	}
+
+	msg := kafka.Message{
+		Topic:     "inventory.low_stock",
+		Partition: 0,
+		Offset:    1,
+		Value:     []byte("test message"),
+	}
+
+	// Настраиваем моки
+	s.lowStockDecoder.On("Decode", msg).Return(event, nil)
+	expectedError := errors.New("notification error")
+	s.notificationService.On("NotifyLowStock", mock.Anything, event).Return(expectedError)
+
+	// Выполняем тест
+	err := s.service.(interface {
+		LowStockHandler(ctx context.Context, msg kafka.Message) error
+	}).LowStockHandler(context.Background(), msg)
+
+	// Проверяем результат
+	s.Error(err)
+	s.Equal(expectedError, err)
+	s.lowStockDecoder.AssertExpectations(s.T())
+	s.notificationService.AssertExpectations(s.T())
+}
+
+func (s *LowStockConsumerTestSuite) TestRunConsumer_Success() {
+	// Настраиваем мок для успешного запуска consumer'а
+	s.lowStockConsumer.On("Consume", mock.Anything, mock.AnythingOfType("kafka.MessageHandler")).Return(nil)
+
+	// Выполняем тест
+	err := s.service.RunConsumer(context.Background())
+
+	// Проверяем результат
+	s.NoError(err)
+	s.lowStockConsumer.AssertExpectations(s.T())
+}
+
+func (s *LowStockConsumerTestSuite) TestRunConsumer_Error() {
+	// Настраиваем мок для ошибки запуска consumer'а
+	expectedError := errors.New("consumer error")
+	s.lowStockConsumer.On("Consume", mock.Anything, mock.AnythingOfType("kafka.MessageHandler")).Return(expectedError)
+
+	// Выполняем тест
+	err := s.service.RunConsumer(context.Background())
+
+	// Проверяем результат
+	s.Error(err)
+	s.Equal(expectedError, err)
+	s.lowStockConsumer.AssertExpectations(s.T())
+}
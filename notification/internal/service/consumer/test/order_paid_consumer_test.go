@@ -73,7 +73,7 @@ func (s *OrderPaidConsumerTestSuite) TestOrderPaidHandler_Success() {
 	}
 
 	// Настраиваем моки
-	s.orderPaidDecoder.On("Decode", msg.Value).Return(event, nil)
+	s.orderPaidDecoder.On("Decode", msg).Return(event, nil)
 	s.notificationService.On("NotifyOrderPaid", mock.Anything, event).Return(nil)
 
 	// Выполняем тест
@@ -98,7 +98,7 @@ func (s *OrderPaidConsumerTestSuite) TestOrderPaidHandler_DecodeError() {
 
 	// Настраиваем мок для ошибки декодирования
 	expectedError := errors.New("decode error")
-	s.orderPaidDecoder.On("Decode", msg.Value).Return(nil, expectedError)
+	s.orderPaidDecoder.On("Decode", msg).Return(nil, expectedError)
 
 	// Выполняем тест
 	err := s.service.(interface {
@@ -129,7 +129,7 @@ func (s *OrderPaidConsumerTestSuite) TestOrderPaidHandler_NotificationError() {
 	}
 
 	// Настраиваем моки
-	s.orderPaidDecoder.On("Decode", msg.Value).Return(event, nil)
+	s.orderPaidDecoder.On("Decode", msg).Return(event, nil)
 	expectedError := errors.New("notification error")
 	s.notificationService.On("NotifyOrderPaid", mock.Anything, event).Return(expectedError)
 
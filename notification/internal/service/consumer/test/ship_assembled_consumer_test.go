@@ -72,7 +72,7 @@ func (s *ShipAssembledConsumerTestSuite) TestShipAssembledHandler_Success() {
 	}
 
 	// Настраиваем моки
-	s.shipAssembledDecoder.On("Decode", msg.Value).Return(event, nil)
+	s.shipAssembledDecoder.On("Decode", msg).Return(event, nil)
 	s.notificationService.On("NotifyShipAssembled", mock.Anything, event).Return(nil)
 
 	// Выполняем тест
@@ -97,7 +97,7 @@ func (s *ShipAssembledConsumerTestSuite) TestShipAssembledHandler_DecodeError()
 
 	// Настраиваем мок для ошибки декодирования
 	expectedError := errors.New("decode error")
-	s.shipAssembledDecoder.On("Decode", msg.Value).Return(nil, expectedError)
+	s.shipAssembledDecoder.On("Decode", msg).Return(nil, expectedError)
 
 	// Выполняем тест
 	err := s.service.(interface {
@@ -127,7 +127,7 @@ func (s *ShipAssembledConsumerTestSuite) TestShipAssembledHandler_NotificationEr
 	}
 
 	// Настраиваем моки
-	s.shipAssembledDecoder.On("Decode", msg.Value).Return(event, nil)
+	s.shipAssembledDecoder.On("Decode", msg).Return(event, nil)
 	expectedError := errors.New("notification error")
 	s.notificationService.On("NotifyShipAssembled", mock.Anything, event).Return(expectedError)
 
@@ -35,6 +35,11 @@ func (m *mockNotificationService) NotifyShipAssembled(ctx context.Context, event
 	return args.Error(0)
 }
 
+func (m *mockNotificationService) NotifyLowStock(ctx context.Context, event *model.LowStockEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
 func (m *mockNotificationService) reset() {
 	m.ExpectedCalls = nil
 }
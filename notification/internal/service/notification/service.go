@@ -13,12 +13,15 @@ import (
 
 type service struct {
 	telegramClient telegram.TelegramClient
+	opsChatID      int64
 }
 
-// NewService создает новый сервис уведомлений
-func NewService(telegramClient telegram.TelegramClient) *service {
+// NewService создает новый сервис уведомлений.
+// opsChatID — чат службы эксплуатации; 0 — оповещения уходят в дефолтный чат
+func NewService(telegramClient telegram.TelegramClient, opsChatID int64) *service {
 	return &service{
 		telegramClient: telegramClient,
+		opsChatID:      opsChatID,
 	}
 }
 
@@ -73,3 +76,40 @@ func (s *service) NotifyShipAssembled(ctx context.Context, event *model.ShipAsse
 
 	return nil
 }
+
+// NotifyLowStock отправляет оповещение о низком остатке детали в чат службы эксплуатации
+func (s *service) NotifyLowStock(ctx context.Context, event *model.LowStockEvent) error {
+	headline := "⚠️ Низкий остаток детали!"
+	if event.StockQuantity == 0 {
+		headline = "🚨 Деталь закончилась на складе!"
+	}
+
+	message := fmt.Sprintf(
+		"%s\n"+
+			"🔩 Деталь: %s\n"+
+			"🗂️ Категория: %s\n"+
+			"📉 Остаток: %d (было %d)\n"+
+			"🎯 Порог дозаказа: %d\n"+
+			"🆔 ID детали: %s",
+		headline,
+		event.Name,
+		event.Category,
+		event.StockQuantity,
+		event.PreviousStockQuantity,
+		event.Threshold,
+		event.PartUUID.String(),
+	)
+
+	err := s.telegramClient.SendMessage(ctx, s.opsChatID, message)
+	if err != nil {
+		logger.Error(ctx, "Failed to send InventoryLowStock notification", zap.Error(err))
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+
+	logger.Info(ctx, "InventoryLowStock notification sent successfully",
+		zap.String("part_uuid", event.PartUUID.String()),
+		zap.Int64("stock_quantity", event.StockQuantity),
+		zap.Int64("threshold", event.Threshold))
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/notification/internal/service/notification"
 )
 
 func (s *NotificationServiceSuite) TestNotifyOrderPaid_Success() {
@@ -148,3 +149,81 @@ func (s *NotificationServiceSuite) TestNotifyShipAssembled_MessageFormat() {
 	s.NoError(err)
 	s.telegramClient.AssertExpectations(s.T())
 }
+
+func (s *NotificationServiceSuite) TestNotifyLowStock_MessageFormat() {
+	// Подготавливаем тестовые данные
+	event := &model.LowStockEvent{
+		EventUUID:             uuid.New(),
+		PartUUID:              uuid.MustParse("550e8400-e29b-41d4-a716-446655440003"),
+		Name:                  "RD-180",
+		Category:              "ENGINE",
+		StockQuantity:         2,
+		PreviousStockQuantity: 4,
+		Threshold:             2,
+	}
+
+	// Настраиваем мок для проверки формата сообщения
+	s.telegramClient.On("SendMessage", mock.Anything, int64(0), mock.MatchedBy(func(message string) bool {
+		return strings.Contains(message, "⚠️ Низкий остаток детали!") &&
+			strings.Contains(message, "RD-180") &&
+			strings.Contains(message, "ENGINE") &&
+			strings.Contains(message, "Остаток: 2 (было 4)") &&
+			strings.Contains(message, "Порог дозаказа: 2") &&
+			strings.Contains(message, "550e8400-e29b-41d4-a716-446655440003")
+	})).Return(nil)
+
+	// Выполняем тест
+	err := s.service.NotifyLowStock(context.Background(), event)
+
+	// Проверяем результат
+	s.NoError(err)
+	s.telegramClient.AssertExpectations(s.T())
+}
+
+func (s *NotificationServiceSuite) TestNotifyLowStock_OutOfStockToOpsChat() {
+	// Подготавливаем тестовые данные
+	event := &model.LowStockEvent{
+		EventUUID:             uuid.New(),
+		PartUUID:              uuid.New(),
+		Name:                  "RD-180",
+		Category:              "ENGINE",
+		StockQuantity:         0,
+		PreviousStockQuantity: 3,
+		Threshold:             2,
+	}
+
+	// Оповещение уходит в чат службы эксплуатации, а не в дефолтный
+	opsService := notification.NewService(s.telegramClient, 42)
+	s.telegramClient.On("SendMessage", mock.Anything, int64(42), mock.MatchedBy(func(message string) bool {
+		return strings.Contains(message, "🚨 Деталь закончилась на складе!")
+	})).Return(nil)
+
+	// Выполняем тест
+	err := opsService.NotifyLowStock(context.Background(), event)
+
+	// Проверяем результат
+	s.NoError(err)
+	s.telegramClient.AssertExpectations(s.T())
+}
+
+func (s *NotificationServiceSuite) TestNotifyLowStock_TelegramError() {
+	// Подготавливаем тестовые данные
+	event := &model.LowStockEvent{
+		EventUUID: uuid.New(),
+		PartUUID:  uuid.New(),
+		Name:      "RD-180",
+		Category:  "ENGINE",
+	}
+
+	// Настраиваем мок для ошибки отправки
+	expectedError := errors.New("telegram error")
+	s.telegramClient.On("SendMessage", mock.Anything, int64(0), mock.AnythingOfType("string")).Return(expectedError)
+
+	// Выполняем тест
+	err := s.service.NotifyLowStock(context.Background(), event)
+
+	// Проверяем результат
+	s.Error(err)
+	s.Contains(err.Error(), "failed to send notification")
+	s.telegramClient.AssertExpectations(s.T())
+}
@@ -22,7 +22,7 @@ func (s *NotificationServiceSuite) SetupSuite() {
 	logger.SetNopLogger()
 
 	s.telegramClient = telegramMocks.NewTelegramClient(s.T())
-	s.service = notification.NewService(s.telegramClient)
+	s.service = notification.NewService(s.telegramClient, 0)
 }
 
 func (s *NotificationServiceSuite) SetupTest() {
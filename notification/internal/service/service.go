@@ -9,6 +9,7 @@ import (
 type NotificationService interface {
 	NotifyOrderPaid(ctx context.Context, event *model.OrderPaidEvent) error
 	NotifyShipAssembled(ctx context.Context, event *model.ShipAssembledEvent) error
+	NotifyLowStock(ctx context.Context, event *model.LowStockEvent) error
 }
 
 type OrderPaidConsumerService interface {
@@ -18,3 +19,7 @@ type OrderPaidConsumerService interface {
 type ShipAssembledConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+type LowStockConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
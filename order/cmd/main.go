@@ -3,9 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -18,6 +18,14 @@ import (
 const configPath = "../deploy/compose/order/.env"
 
 func init() {
+	// Справочник ключей конфигурации: go run ./cmd config-reference
+	if len(os.Args) > 1 && os.Args[1] == "config-reference" {
+		if err := config.WriteReference(os.Stdout); err != nil {
+			panic(fmt.Errorf("failed to write config reference: %w", err))
+		}
+		os.Exit(0)
+	}
+
 	err := config.Load(configPath)
 	if err != nil {
 		// В контейнере .env файла может не быть, используем переменные окружения
@@ -49,10 +57,13 @@ func main() {
 }
 
 func gracefulShutdown() {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), closer.ShutdownTimeout())
 	defer cancel()
 
 	if err := closer.CloseAll(ctx); err != nil {
 		logger.Error(ctx, "❌ Ошибка при завершении работы", zap.Error(err))
 	}
+
+	// Логгер закрываем последним, чтобы в синки попал отчёт о завершении
+	_ = logger.Shutdown(ctx)
 }
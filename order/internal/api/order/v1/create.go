@@ -18,6 +18,9 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest)
 		UserUUID:  uuid.UUID(req.UserUUID),
 		PartUUIDs: req.PartUuids,
 	}
+	if kitUUID, ok := req.KitUUID.Get(); ok {
+		orderDraft.KitUUID = kitUUID
+	}
 
 	createOrder, err := a.orderService.CreateOrder(ctx, &orderDraft)
 	if err != nil {
@@ -29,7 +32,7 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest)
 		if errors.Is(err, model.ErrPartsSpecified) {
 			return &orderV1.BadRequestError{
 				Code:    http.StatusBadRequest,
-				Message: "parts not specified",
+				Message: "parts or kit not specified",
 			}, nil
 		}
 
@@ -40,6 +43,20 @@ func (a *api) CreateOrder(ctx context.Context, req *orderV1.CreateOrderRequest)
 			}, nil
 		}
 
+		if errors.Is(err, model.ErrKitNotFound) {
+			return &orderV1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "kit not found",
+			}, nil
+		}
+
+		if errors.Is(err, model.ErrKitUnavailable) {
+			return &orderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "kit contains parts that are no longer available",
+			}, nil
+		}
+
 		if errors.Is(err, model.ErrConvertFromClient) {
 			return &orderV1.InternalServerError{
 				Code:    http.StatusInternalServerError,
@@ -15,6 +15,7 @@ import (
 
 	customMiddleware "github.com/kont1n/MSA_Rocket_Factory/order/internal/api/middleware"
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/config"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/admin"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 	orderV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/openapi/order/v1"
@@ -23,6 +24,7 @@ import (
 type App struct {
 	diContainer *diContainer
 	httpServer  *http.Server
+	adminServer *admin.Server
 }
 
 // extractDBName извлекает имя базы данных из URI
@@ -145,6 +147,12 @@ func (a *App) Run(ctx context.Context) error {
 		}()
 	}
 
+	go func() {
+		if err := a.runAdminServer(ctx); err != nil {
+			logger.Error(ctx, "❌ Ошибка при работе служебного сервера", zap.Error(err))
+		}
+	}()
+
 	return a.runHTTPServer(ctx)
 }
 
@@ -154,6 +162,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.ensureDatabaseExists, // Проверяем и создаем БД перед инициализацией DI
 		a.initDI,
 		a.initCloser,
+		a.initAdminServer,
 		a.initHTTPServer,
 	}
 
@@ -173,14 +182,50 @@ func (a *App) initDI(_ context.Context) error {
 }
 
 func (a *App) initLogger(_ context.Context) error {
+	cfg := config.AppConfig().Logger
+
 	return logger.Init(
-		config.AppConfig().Logger.Level(),
-		config.AppConfig().Logger.AsJson(),
+		cfg.Level(),
+		cfg.AsJson(),
+		logger.WithServiceName("order"),
+		logger.WithModuleLevels(cfg.ModuleLevels()),
+		logger.WithOutputs(cfg.Outputs()...),
+		logger.WithFile(logger.FileConfig{
+			Path:       cfg.FilePath(),
+			MaxSizeMB:  cfg.FileMaxSizeMB(),
+			MaxBackups: cfg.FileMaxBackups(),
+		}),
+		logger.WithOTLP(cfg.OTLPEndpoint()),
+		logger.WithStacktraceRate(cfg.StacktraceRate()),
 	)
 }
 
+func (a *App) initAdminServer(ctx context.Context) error {
+	a.adminServer = admin.New(
+		config.AppConfig().Admin.Address(),
+		admin.WithServiceName("order"),
+		admin.WithHealth(a.diContainer.HealthRegistry(ctx)),
+		admin.WithConfig(config.AppConfig().Effective),
+	)
+
+	// Служебный сервер останавливаем последним, чтобы health, метрики и pprof были доступны во время завершения
+	closer.AddPhase(closer.PhaseResources, "Admin server", a.adminServer.Stop)
+
+	return nil
+}
+
+func (a *App) runAdminServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🛠️ Admin server listening on %s", config.AppConfig().Admin.Address()))
+
+	return a.adminServer.Start(ctx)
+}
+
 func (a *App) initCloser(_ context.Context) error {
 	closer.SetLogger(logger.Logger())
+	closer.SetTimeouts(
+		config.AppConfig().Shutdown.Timeout(),
+		config.AppConfig().Shutdown.StepTimeout(),
+	)
 	return nil
 }
 
@@ -197,6 +242,8 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Timeout(10 * time.Second))
 	r.Use(customMiddleware.RequestLogger)
+	r.Get("/healthz", a.diContainer.HealthRegistry(ctx).LivenessHandler())
+	r.Get("/readyz", a.diContainer.HealthRegistry(ctx).ReadinessHandler())
 	r.Mount("/", orderServer)
 
 	// Создаем HTTP сервер
@@ -206,7 +253,7 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		ReadHeaderTimeout: time.Duration(config.AppConfig().HTTP.ReadHeaderTimeout()) * time.Second,
 	}
 
-	closer.AddNamed("HTTP server", func(ctx context.Context) error {
+	closer.AddPhase(closer.PhaseServers, "HTTP server", func(ctx context.Context) error {
 		shutdownCtx, cancel := context.WithTimeout(ctx, time.Duration(config.AppConfig().HTTP.ShutdownTimeout())*time.Second)
 		defer cancel()
 
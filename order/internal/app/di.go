@@ -8,6 +8,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	orderV1API "github.com/kont1n/MSA_Rocket_Factory/order/internal/api/order/v1"
@@ -24,10 +25,14 @@ import (
 	orderService "github.com/kont1n/MSA_Rocket_Factory/order/internal/service/order"
 	orderProducer "github.com/kont1n/MSA_Rocket_Factory/order/internal/service/producer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
+	grpcHealth "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/health"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
 	wrappedKafka "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
 	wrappedKafkaConsumer "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/producer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	kafkaMiddleware "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/middleware/kafka"
+	platformTLS "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/tls"
 	orderV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/openapi/order/v1"
 	inventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
 	paymentV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/payment/v1"
@@ -51,6 +56,8 @@ type diContainer struct {
 	consumerGroup              sarama.ConsumerGroup
 	shipAssembledKafkaConsumer wrappedKafka.Consumer
 	shipAssembledDecoder       kafkaConverter.ShipAssembledDecoder
+	healthRegistry             *health.Registry
+	tlsSource                  *platformTLS.Source
 }
 
 func NewDiContainer() *diContainer {
@@ -127,7 +134,7 @@ func (d *diContainer) InventoryGRPCConn(ctx context.Context) *grpc.ClientConn {
 
 		conn, err := grpc.NewClient(
 			config.AppConfig().GRPCClient.InventoryAddress(),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithTransportCredentials(d.ClientCredentials(ctx, "")),
 		)
 		if err != nil {
 			panic(fmt.Sprintf("failed to connect to inventory service: %v", err))
@@ -152,7 +159,7 @@ func (d *diContainer) PaymentGRPCConn(ctx context.Context) *grpc.ClientConn {
 
 		conn, err := grpc.NewClient(
 			config.AppConfig().GRPCClient.PaymentAddress(),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithTransportCredentials(d.ClientCredentials(ctx, "")),
 		)
 		if err != nil {
 			panic(fmt.Sprintf("failed to connect to payment service: %v", err))
@@ -224,7 +231,7 @@ func (d *diContainer) SyncProducer() sarama.SyncProducer {
 		if err != nil {
 			panic(fmt.Sprintf("failed to create sync producer: %s\n", err.Error()))
 		}
-		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+		closer.AddPhase(closer.PhaseProducers, "Kafka sync producer", func(ctx context.Context) error {
 			return p.Close()
 		})
 
@@ -239,7 +246,7 @@ func (d *diContainer) OrderPaidKafkaProducer() wrappedKafka.Producer {
 		d.orderPaidKafkaProducer = wrappedKafkaProducer.NewProducer(
 			d.SyncProducer(),
 			config.AppConfig().OrderPaidProducer.Topic(),
-			logger.Logger(),
+			logger.Named("kafka"),
 		)
 	}
 
@@ -256,7 +263,7 @@ func (d *diContainer) ConsumerGroup() sarama.ConsumerGroup {
 		if err != nil {
 			panic(fmt.Sprintf("failed to create consumer group: %s\n", err.Error()))
 		}
-		closer.AddNamed("Kafka consumer group", func(ctx context.Context) error {
+		closer.AddPhase(closer.PhaseConsumers, "Kafka consumer group", func(ctx context.Context) error {
 			return d.consumerGroup.Close()
 		})
 
@@ -273,7 +280,9 @@ func (d *diContainer) ShipAssembledKafkaConsumer() wrappedKafka.Consumer {
 			[]string{
 				config.AppConfig().ShipAssembledConsumer.Topic(),
 			},
-			logger.Logger(),
+			logger.Named("kafka"),
+			kafkaMiddleware.Recover(logger.Named("kafka")),
+			kafkaMiddleware.Timeout(config.AppConfig().ShipAssembledConsumer.HandlerTimeout()),
 		)
 	}
 
@@ -287,3 +296,63 @@ func (d *diContainer) ShipAssembledDecoder(ctx context.Context) kafkaConverter.S
 
 	return d.shipAssembledDecoder
 }
+
+func (d *diContainer) HealthRegistry(ctx context.Context) *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+		registry.Register("postgres", health.Readiness, health.PingCheck(d.DBPool(ctx)))
+
+		if conn := d.InventoryGRPCConn(ctx); conn != nil {
+			registry.Register("inventory", health.Readiness, health.GRPCCheck(conn, grpcHealth.ReadinessService))
+		}
+		if conn := d.PaymentGRPCConn(ctx); conn != nil {
+			registry.Register("payment", health.Readiness, health.GRPCCheck(conn, grpcHealth.ReadinessService))
+		}
+		if os.Getenv("SKIP_KAFKA_CONSUMER") != "true" {
+			registry.Register("kafka", health.Readiness, health.KafkaCheck(
+				config.AppConfig().Kafka.Brokers(),
+				config.AppConfig().ShipAssembledConsumer.Config(),
+			))
+		}
+
+		watchCtx, cancel := context.WithCancel(context.Background())
+		registry.Start(watchCtx)
+		closer.AddNamed("Health checks", func(ctx context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
+
+// TLSSource возвращает источник сертификатов для mTLS или nil, если TLS выключен
+func (d *diContainer) TLSSource(_ context.Context) *platformTLS.Source {
+	if d.tlsSource == nil && config.AppConfig().TLS.Enabled() {
+		source, err := platformTLS.NewSource(config.AppConfig().TLS, logger.Named("tls"))
+		if err != nil {
+			panic(fmt.Sprintf("failed to load TLS certificates: %v", err))
+		}
+
+		source.Watch(context.Background())
+		closer.AddNamed("TLS certificates reloader", func(ctx context.Context) error {
+			return source.Close()
+		})
+
+		d.tlsSource = source
+	}
+
+	return d.tlsSource
+}
+
+// ClientCredentials возвращает credentials gRPC-клиента: mTLS, если он включён, иначе insecure.
+// Пустой serverName означает проверку сертификата по имени хоста из адреса подключения.
+func (d *diContainer) ClientCredentials(ctx context.Context, serverName string) credentials.TransportCredentials {
+	if source := d.TLSSource(ctx); source != nil {
+		return source.ClientCredentials(serverName)
+	}
+
+	return insecure.NewCredentials()
+}
@@ -31,3 +31,19 @@ func ToModelPart(part *inventoryV1.Part) (*model.Part, error) {
 		Price:       part.Price,
 	}, nil
 }
+
+func ToModelKit(kitUUID uuid.UUID, resp *inventoryV1.ExpandKitResponse) (*model.Kit, error) {
+	lines := make([]model.KitLine, 0, len(resp.GetLines()))
+	for _, line := range resp.GetLines() {
+		part, err := ToModelPart(line.GetPart())
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, model.KitLine{Part: *part, Quantity: line.GetQuantity()})
+	}
+
+	return &model.Kit{
+		KitUUID: kitUUID,
+		Lines:   lines,
+	}, nil
+}
@@ -3,11 +3,14 @@ package grpc
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
 )
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error)
+	ExpandKit(ctx context.Context, kitUUID uuid.UUID) (*model.Kit, error)
 }
 
 type PaymentClient interface {
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/order/internal/client/converter"
+	"github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
+	generaredInventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
+)
+
+func (c inventoryClient) ExpandKit(ctx context.Context, kitUUID uuid.UUID) (*model.Kit, error) {
+	resp, err := c.generatedClient.ExpandKit(ctx, &generaredInventoryV1.ExpandKitRequest{
+		KitUuid: kitUUID.String(),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, model.ErrKitNotFound
+		case codes.FailedPrecondition:
+			return nil, fmt.Errorf("%w: %s", model.ErrKitUnavailable, status.Convert(err).Message())
+		}
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	return converter.ToModelKit(kitUUID, resp)
+}
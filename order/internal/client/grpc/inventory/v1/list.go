@@ -4,20 +4,37 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/client/converter"
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
 	generaredInventoryV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1"
 )
 
 func (c inventoryClient) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
-	parts, err := c.generatedClient.ListParts(ctx, &generaredInventoryV1.ListPartsRequest{
+	req := &generaredInventoryV1.ListPartsRequest{
 		Filter: converter.ToProtoFilter(filter),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+	if !filter.PriceAt.IsZero() {
+		req.PriceAt = timestamppb.New(filter.PriceAt)
+	}
+
+	// Inventory отдаёт детали постранично: собираем все страницы
+	var protoParts []*generaredInventoryV1.Part
+	for {
+		resp, err := c.generatedClient.ListParts(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("gRPC call failed: %w", err)
+		}
+
+		protoParts = append(protoParts, resp.Parts...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
 	}
 
-	modelParts, err := converter.ToModelPartsList(parts.Parts)
+	modelParts, err := converter.ToModelPartsList(protoParts)
 	if err != nil {
 		return nil, err
 	}
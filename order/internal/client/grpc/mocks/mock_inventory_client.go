@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
+
+	uuid "github.com/google/uuid"
 )
 
 // InventoryClient is an autogenerated mock type for the InventoryClient type
@@ -25,6 +27,65 @@ func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
 	return &InventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// ExpandKit provides a mock function with given fields: ctx, kitUUID
+func (_m *InventoryClient) ExpandKit(ctx context.Context, kitUUID uuid.UUID) (*model.Kit, error) {
+	ret := _m.Called(ctx, kitUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandKit")
+	}
+
+	var r0 *model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Kit, error)); ok {
+		return rf(ctx, kitUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Kit); ok {
+		r0 = rf(ctx, kitUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, kitUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_ExpandKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandKit'
+type InventoryClient_ExpandKit_Call struct {
+	*mock.Call
+}
+
+// ExpandKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kitUUID uuid.UUID
+func (_e *InventoryClient_Expecter) ExpandKit(ctx interface{}, kitUUID interface{}) *InventoryClient_ExpandKit_Call {
+	return &InventoryClient_ExpandKit_Call{Call: _e.mock.On("ExpandKit", ctx, kitUUID)}
+}
+
+func (_c *InventoryClient_ExpandKit_Call) Run(run func(ctx context.Context, kitUUID uuid.UUID)) *InventoryClient_ExpandKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ExpandKit_Call) Return(_a0 *model.Kit, _a1 error) *InventoryClient_ExpandKit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_ExpandKit_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*model.Kit, error)) *InventoryClient_ExpandKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *InventoryClient) ListParts(ctx context.Context, filter *model.Filter) (*[]model.Part, error) {
 	ret := _m.Called(ctx, filter)
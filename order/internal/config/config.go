@@ -1,11 +1,12 @@
 package config
 
 import (
+	"errors"
+	"io"
 	"os"
 
-	"github.com/joho/godotenv"
-
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/config/env"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 var appConfig *config
@@ -18,50 +19,84 @@ type config struct {
 	Kafka                 KafkaConfig
 	OrderPaidProducer     OrderPaidProducerConfig
 	ShipAssembledConsumer ShipAssemblyConsumerConfig
+	Shutdown              ShutdownConfig
+	TLS                   TLSConfig
+	Admin                 AdminConfig
+
+	effective map[string]string
 }
 
 func Load(path ...string) error {
-	err := godotenv.Load(path...)
-	if err != nil && !os.IsNotExist(err) {
-		return err
-	}
-
-	loggerCfg, err := env.NewLoggerConfig()
+	loader, err := platformConfig.New(
+		platformConfig.WithFile(os.Getenv(platformConfig.FileEnv)),
+		platformConfig.WithDotEnv(path...),
+	)
 	if err != nil {
 		return err
 	}
 
-	httpCfg, err := env.NewHTTPConfig()
+	cfg, err := load(loader)
 	if err != nil {
 		return err
 	}
 
-	dbCfg, err := env.NewDBConfig()
-	if err != nil {
-		return err
-	}
+	appConfig = cfg
 
-	grpcClientCfg, err := env.NewGRPCClientConfig()
-	if err != nil {
-		return err
-	}
+	return nil
+}
 
-	kafkaCfg, err := env.NewKafkaConfig()
+// WriteReference выводит справочник всех ключей конфигурации сервиса в формате Markdown
+func WriteReference(w io.Writer) error {
+	loader, err := platformConfig.New(platformConfig.WithEnviron(nil))
 	if err != nil {
 		return err
 	}
 
-	orderPaidProducerCfg, err := env.NewOrderPaidProducerConfig()
-	if err != nil {
-		return err
-	}
+	// На пустом окружении обязательные ключи не заполнены — нужны только их описания
+	_, _ = load(loader)
 
-	shipAssembledConsumerCfg, err := env.NewShipAssembledConsumerConfig()
-	if err != nil {
-		return err
+	return platformConfig.WriteReference(w, "Order", loader.Keys())
+}
+
+// load читает все секции конфигурации и возвращает ошибки всех секций разом
+func load(loader *platformConfig.Loader) (*config, error) {
+	var errs []error
+
+	loggerCfg, err := env.NewLoggerConfig(loader)
+	errs = append(errs, err)
+
+	httpCfg, err := env.NewHTTPConfig(loader)
+	errs = append(errs, err)
+
+	dbCfg, err := env.NewDBConfig(loader)
+	errs = append(errs, err)
+
+	grpcClientCfg, err := env.NewGRPCClientConfig(loader)
+	errs = append(errs, err)
+
+	kafkaCfg, err := env.NewKafkaConfig(loader)
+	errs = append(errs, err)
+
+	orderPaidProducerCfg, err := env.NewOrderPaidProducerConfig(loader)
+	errs = append(errs, err)
+
+	shipAssembledConsumerCfg, err := env.NewShipAssembledConsumerConfig(loader)
+	errs = append(errs, err)
+
+	shutdownCfg, err := env.NewShutdownConfig(loader)
+	errs = append(errs, err)
+
+	tlsCfg, err := env.NewTLSConfig(loader)
+	errs = append(errs, err)
+
+	adminCfg, err := env.NewAdminConfig(loader)
+	errs = append(errs, err)
+
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
 	}
 
-	appConfig = &config{
+	return &config{
 		Logger:                loggerCfg,
 		HTTP:                  httpCfg,
 		DB:                    dbCfg,
@@ -69,9 +104,16 @@ func Load(path ...string) error {
 		Kafka:                 kafkaCfg,
 		OrderPaidProducer:     orderPaidProducerCfg,
 		ShipAssembledConsumer: shipAssembledConsumerCfg,
-	}
+		Shutdown:              shutdownCfg,
+		TLS:                   tlsCfg,
+		Admin:                 adminCfg,
+		effective:             loader.Effective(),
+	}, nil
+}
 
-	return nil
+// Effective возвращает итоговые значения всех ключей конфигурации со скрытыми секретами
+func (c *config) Effective() map[string]string {
+	return c.effective
 }
 
 func AppConfig() *config {
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -316,11 +317,81 @@ func (s *ConfigSuite) TestLoad_InvalidHTTPTimeout() {
 	_ = os.Setenv("CONSUMER_GROUP_ID", "order-service")
 
 	err := Load()
-	s.NoError(err) // Невалидные значения заменяются на значения по умолчанию
+	s.Error(err) // Невалидное значение — ошибка конфигурации, а не тихая подстановка значения по умолчанию
+	s.Contains(err.Error(), "HTTP_READ_HEADER_TIMEOUT")
+	s.Nil(AppConfig())
+}
+
+func (s *ConfigSuite) TestLoad_ReportsAllErrors() {
+	// Не задано ни одной обязательной переменной, а порт и брокеры невалидны
+	_ = os.Setenv("HTTP_PORT", "99999")
+	_ = os.Setenv("KAFKA_BROKERS", "kafka")
+
+	err := Load()
+	s.Error(err)
+	// Ошибки всех секций возвращаются разом
+	s.Contains(err.Error(), "LOGGER_LEVEL")
+	s.Contains(err.Error(), "POSTGRES_HOST")
+	s.Contains(err.Error(), "PRODUCER_TOPIC_NAME")
+	s.Contains(err.Error(), "HTTP_PORT")
+	s.Contains(err.Error(), "KAFKA_BROKERS")
+}
+
+func (s *ConfigSuite) TestLoad_FromYAMLFile() {
+	tempDir := s.T().TempDir()
+	yamlFile := filepath.Join(tempDir, "config.yaml")
+
+	yamlContent := `logger:
+  level: warn
+  as_json: true
+http:
+  port: 9191
+postgres:
+  host: yaml-host
+  port: 5432
+  sslmode: disable
+  database: orders
+  user: user
+  password: password
+  migrations_dir: /migrations
+kafka:
+  brokers:
+    - kafka-1:9092
+    - kafka-2:9092
+producer:
+  topic_name: order-paid
+consumer:
+  topic_name: ship-assembled
+  group_id: order-service
+`
+	err := os.WriteFile(yamlFile, []byte(yamlContent), 0o644)
+	s.NoError(err)
+
+	// Переменные окружения имеют приоритет над YAML-файлом
+	_ = os.Setenv("CONFIG_FILE", yamlFile)
+	_ = os.Setenv("LOGGER_LEVEL", "error")
+	defer func() { _ = os.Unsetenv("CONFIG_FILE") }()
+
+	err = Load()
+	s.NoError(err)
 
 	cfg := AppConfig()
 	s.NotNil(cfg)
-	s.Equal(5, cfg.HTTP.ReadHeaderTimeout()) // Значение по умолчанию
+	s.Equal("error", cfg.Logger.Level())
+	s.True(cfg.Logger.AsJson())
+	s.Equal("localhost:9191", cfg.HTTP.Address())
+	s.Contains(cfg.DB.URI(), "yaml-host")
+	s.Equal([]string{"kafka-1:9092", "kafka-2:9092"}, cfg.Kafka.Brokers())
+}
+
+func (s *ConfigSuite) TestWriteReference() {
+	var sb strings.Builder
+
+	err := WriteReference(&sb)
+	s.NoError(err)
+	s.Contains(sb.String(), "`POSTGRES_PASSWORD`")
+	s.Contains(sb.String(), "`HTTP_READ_HEADER_TIMEOUT`")
+	s.Nil(AppConfig())
 }
 
 func (s *ConfigSuite) TestLoad_OverrideEnvFileWithEnvVars() {
@@ -0,0 +1,29 @@
+package env
+
+import (
+	"net"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type adminEnvConfig struct {
+	Host string `env:"ADMIN_HOST" envDefault:"0.0.0.0" desc:"Адрес служебного сервера (pprof, метрики, health, уровень логов)"`
+	Port string `env:"ADMIN_PORT" envDefault:"9103" validate:"port" desc:"Порт служебного сервера"`
+}
+
+type adminConfig struct {
+	raw adminEnvConfig
+}
+
+func NewAdminConfig(loader *platformConfig.Loader) (*adminConfig, error) {
+	var raw adminEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &adminConfig{raw: raw}, nil
+}
+
+func (cfg *adminConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
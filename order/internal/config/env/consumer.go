@@ -1,22 +1,26 @@
 package env
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
-	"github.com/caarlos0/env/v11"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type shipAssembledConsumerEnvConfig struct {
-	Topic   string `env:"CONSUMER_TOPIC_NAME,required"`
-	GroupID string `env:"CONSUMER_GROUP_ID,required"`
+	Topic          string        `env:"CONSUMER_TOPIC_NAME,required" desc:"Топик, из которого читает consumer"`
+	GroupID        string        `env:"CONSUMER_GROUP_ID,required" desc:"Группа потребителей"`
+	HandlerTimeout time.Duration `env:"CONSUMER_HANDLER_TIMEOUT" envDefault:"10s" validate:"min=1s" desc:"Максимальное время обработки одного сообщения"`
 }
 
 type ShipAssembledConsumerConfig struct {
 	raw shipAssembledConsumerEnvConfig
 }
 
-func NewShipAssembledConsumerConfig() (*ShipAssembledConsumerConfig, error) {
+func NewShipAssembledConsumerConfig(loader *platformConfig.Loader) (*ShipAssembledConsumerConfig, error) {
 	var raw shipAssembledConsumerEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -31,6 +35,10 @@ func (cfg *ShipAssembledConsumerConfig) GroupID() string {
 	return cfg.raw.GroupID
 }
 
+func (cfg *ShipAssembledConsumerConfig) HandlerTimeout() time.Duration {
+	return cfg.raw.HandlerTimeout
+}
+
 func (cfg *ShipAssembledConsumerConfig) Config() *sarama.Config {
 	config := sarama.NewConfig()
 	config.Version = sarama.V4_0_0_0
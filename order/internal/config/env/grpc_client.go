@@ -1,49 +1,35 @@
 package env
 
 import (
-	"os"
+	"net"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
-type grpcClientConfig struct {
-	inventoryAddress string
-	paymentAddress   string
+type grpcClientEnvConfig struct {
+	InventoryHost string `env:"INVENTORY_GRPC_HOST" envDefault:"localhost" desc:"Хост gRPC-сервиса Inventory"`
+	InventoryPort string `env:"INVENTORY_GRPC_PORT" envDefault:"50051" validate:"port" desc:"Порт gRPC-сервиса Inventory"`
+	PaymentHost   string `env:"PAYMENT_GRPC_HOST" envDefault:"localhost" desc:"Хост gRPC-сервиса Payment"`
+	PaymentPort   string `env:"PAYMENT_GRPC_PORT" envDefault:"50052" validate:"port" desc:"Порт gRPC-сервиса Payment"`
 }
 
-func NewGRPCClientConfig() (*grpcClientConfig, error) {
-	inventoryHost := os.Getenv("INVENTORY_GRPC_HOST")
-	if inventoryHost == "" {
-		inventoryHost = "localhost"
-	}
-
-	inventoryPort := os.Getenv("INVENTORY_GRPC_PORT")
-	if inventoryPort == "" {
-		inventoryPort = "50051"
-	}
-
-	inventoryAddress := inventoryHost + ":" + inventoryPort
-
-	paymentHost := os.Getenv("PAYMENT_GRPC_HOST")
-	if paymentHost == "" {
-		paymentHost = "localhost"
-	}
+type grpcClientConfig struct {
+	raw grpcClientEnvConfig
+}
 
-	paymentPort := os.Getenv("PAYMENT_GRPC_PORT")
-	if paymentPort == "" {
-		paymentPort = "50052"
+func NewGRPCClientConfig(loader *platformConfig.Loader) (*grpcClientConfig, error) {
+	var raw grpcClientEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
 	}
 
-	paymentAddress := paymentHost + ":" + paymentPort
-
-	return &grpcClientConfig{
-		inventoryAddress: inventoryAddress,
-		paymentAddress:   paymentAddress,
-	}, nil
+	return &grpcClientConfig{raw: raw}, nil
 }
 
 func (c *grpcClientConfig) InventoryAddress() string {
-	return c.inventoryAddress
+	return net.JoinHostPort(c.raw.InventoryHost, c.raw.InventoryPort)
 }
 
 func (c *grpcClientConfig) PaymentAddress() string {
-	return c.paymentAddress
+	return net.JoinHostPort(c.raw.PaymentHost, c.raw.PaymentPort)
 }
@@ -1,56 +1,39 @@
 package env
 
 import (
-	"os"
-	"strconv"
+	"net"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
-type httpConfig struct {
-	address           string
-	readHeaderTimeout int
-	shutdownTimeout   int
+type httpEnvConfig struct {
+	Host              string `env:"HTTP_HOST" envDefault:"localhost" desc:"Адрес, на котором слушает HTTP-сервер"`
+	Port              string `env:"HTTP_PORT" envDefault:"8080" validate:"port" desc:"Порт HTTP-сервера"`
+	ReadHeaderTimeout int    `env:"HTTP_READ_HEADER_TIMEOUT" envDefault:"5" validate:"min=1" desc:"Таймаут чтения заголовков запроса, секунды"`
+	ShutdownTimeout   int    `env:"HTTP_SHUTDOWN_TIMEOUT" envDefault:"10" validate:"min=1" desc:"Таймаут остановки HTTP-сервера, секунды"`
 }
 
-func NewHTTPConfig() (*httpConfig, error) {
-	host := os.Getenv("HTTP_HOST")
-	if host == "" {
-		host = "localhost"
-	}
-
-	port := os.Getenv("HTTP_PORT")
-	if port == "" {
-		port = "8080"
-	}
-
-	address := host + ":" + port
-
-	readHeaderTimeoutStr := os.Getenv("HTTP_READ_HEADER_TIMEOUT")
-	readHeaderTimeout, err := strconv.Atoi(readHeaderTimeoutStr)
-	if err != nil {
-		readHeaderTimeout = 5 // секунды
-	}
+type httpConfig struct {
+	raw httpEnvConfig
+}
 
-	shutdownTimeoutStr := os.Getenv("HTTP_SHUTDOWN_TIMEOUT")
-	shutdownTimeout, err := strconv.Atoi(shutdownTimeoutStr)
-	if err != nil {
-		shutdownTimeout = 10 // секунды
+func NewHTTPConfig(loader *platformConfig.Loader) (*httpConfig, error) {
+	var raw httpEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
 	}
 
-	return &httpConfig{
-		address:           address,
-		readHeaderTimeout: readHeaderTimeout,
-		shutdownTimeout:   shutdownTimeout,
-	}, nil
+	return &httpConfig{raw: raw}, nil
 }
 
 func (c *httpConfig) Address() string {
-	return c.address
+	return net.JoinHostPort(c.raw.Host, c.raw.Port)
 }
 
 func (c *httpConfig) ReadHeaderTimeout() int {
-	return c.readHeaderTimeout
+	return c.raw.ReadHeaderTimeout
 }
 
 func (c *httpConfig) ShutdownTimeout() int {
-	return c.shutdownTimeout
+	return c.raw.ShutdownTimeout
 }
@@ -1,21 +1,26 @@
 package env
 
-import (
-	"github.com/caarlos0/env/v11"
-)
+import platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 
 type loggerEnvConfig struct {
-	Level  string `env:"LOGGER_LEVEL,required"`
-	AsJson bool   `env:"LOGGER_AS_JSON,required"`
+	Level          string            `env:"LOGGER_LEVEL,required" validate:"oneof=debug|info|warn|error" desc:"Уровень логирования"`
+	AsJson         bool              `env:"LOGGER_AS_JSON,required" desc:"Выводить логи в формате JSON"`
+	ModuleLevels   map[string]string `env:"LOGGER_MODULE_LEVELS" envSeparator:"," envKeyValSeparator:":" desc:"Уровни именованных логгеров, например kafka:debug,grpc:info"`
+	Outputs        []string          `env:"LOGGER_OUTPUTS" envSeparator:"," envDefault:"stdout" validate:"min=1,oneof=stdout|file|otlp" desc:"Выходы логов"`
+	FilePath       string            `env:"LOGGER_FILE_PATH" desc:"Путь к файлу логов для выхода file"`
+	FileMaxSizeMB  int               `env:"LOGGER_FILE_MAX_SIZE_MB" envDefault:"100" validate:"min=1" desc:"Размер файла логов до ротации, МБ"`
+	FileMaxBackups int               `env:"LOGGER_FILE_MAX_BACKUPS" envDefault:"5" validate:"min=0" desc:"Число хранимых ротированных файлов"`
+	OTLPEndpoint   string            `env:"LOGGER_OTLP_ENDPOINT" validate:"hostport" desc:"Адрес OTLP gRPC-коллектора для выхода otlp"`
+	StacktraceRate int               `env:"LOGGER_STACKTRACE_RATE" envDefault:"10" validate:"min=0" desc:"Сколько стектрейсов ошибок в секунду попадает в лог"`
 }
 
 type loggerConfig struct {
 	raw loggerEnvConfig
 }
 
-func NewLoggerConfig() (*loggerConfig, error) {
+func NewLoggerConfig(loader *platformConfig.Loader) (*loggerConfig, error) {
 	var raw loggerEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -29,3 +34,31 @@ func (cfg *loggerConfig) Level() string {
 func (cfg *loggerConfig) AsJson() bool {
 	return cfg.raw.AsJson
 }
+
+func (cfg *loggerConfig) ModuleLevels() map[string]string {
+	return cfg.raw.ModuleLevels
+}
+
+func (cfg *loggerConfig) Outputs() []string {
+	return cfg.raw.Outputs
+}
+
+func (cfg *loggerConfig) FilePath() string {
+	return cfg.raw.FilePath
+}
+
+func (cfg *loggerConfig) FileMaxSizeMB() int {
+	return cfg.raw.FileMaxSizeMB
+}
+
+func (cfg *loggerConfig) FileMaxBackups() int {
+	return cfg.raw.FileMaxBackups
+}
+
+func (cfg *loggerConfig) OTLPEndpoint() string {
+	return cfg.raw.OTLPEndpoint
+}
+
+func (cfg *loggerConfig) StacktraceRate() int {
+	return cfg.raw.StacktraceRate
+}
@@ -3,26 +3,26 @@ package env
 import (
 	"fmt"
 
-	"github.com/caarlos0/env/v11"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type postgresEnvConfig struct {
-	Host          string `env:"POSTGRES_HOST,required"`
-	Port          string `env:"POSTGRES_PORT,required"`
-	SslMode       string `env:"POSTGRES_SSLMODE,required"`
-	Database      string `env:"POSTGRES_DATABASE,required"`
-	User          string `env:"POSTGRES_USER,required"`
-	Password      string `env:"POSTGRES_PASSWORD,required"`
-	MigrationsDir string `env:"POSTGRES_MIGRATIONS_DIR,required"`
+	Host          string `env:"POSTGRES_HOST,required" desc:"Хост PostgreSQL"`
+	Port          string `env:"POSTGRES_PORT,required" validate:"port" desc:"Порт PostgreSQL"`
+	SslMode       string `env:"POSTGRES_SSLMODE,required" validate:"oneof=disable|allow|prefer|require|verify-ca|verify-full" desc:"Режим SSL для PostgreSQL"`
+	Database      string `env:"POSTGRES_DATABASE,required" desc:"Имя базы данных PostgreSQL"`
+	User          string `env:"POSTGRES_USER,required" desc:"Пользователь PostgreSQL"`
+	Password      string `env:"POSTGRES_PASSWORD,required" desc:"Пароль пользователя PostgreSQL" secret:"true"`
+	MigrationsDir string `env:"POSTGRES_MIGRATIONS_DIR,required" desc:"Директория с миграциями"`
 }
 
 type postgresConfig struct {
 	raw postgresEnvConfig
 }
 
-func NewDBConfig() (*postgresConfig, error) {
+func NewDBConfig(loader *platformConfig.Loader) (*postgresConfig, error) {
 	var raw postgresEnvConfig
-	err := env.Parse(&raw)
+	err := loader.Parse(&raw)
 	if err != nil {
 		return nil, err
 	}
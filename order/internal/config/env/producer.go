@@ -2,20 +2,21 @@ package env
 
 import (
 	"github.com/IBM/sarama"
-	"github.com/caarlos0/env/v11"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type orderPaidProducerEnvConfig struct {
-	TopicName string `env:"PRODUCER_TOPIC_NAME,required"`
+	TopicName string `env:"PRODUCER_TOPIC_NAME,required" desc:"Топик, в который пишет producer"`
 }
 
 type OrderPaidProducerConfig struct {
 	raw orderPaidProducerEnvConfig
 }
 
-func NewOrderPaidProducerConfig() (*OrderPaidProducerConfig, error) {
+func NewOrderPaidProducerConfig(loader *platformConfig.Loader) (*OrderPaidProducerConfig, error) {
 	var raw orderPaidProducerEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -1,11 +1,22 @@
 package config
 
-import "github.com/IBM/sarama"
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
 
 // LoggerConfig интерфейс для конфигурации логгера
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
+	ModuleLevels() map[string]string
+	Outputs() []string
+	FilePath() string
+	FileMaxSizeMB() int
+	FileMaxBackups() int
+	OTLPEndpoint() string
+	StacktraceRate() int
 }
 
 // HTTPConfig интерфейс для конфигурации HTTP сервера
@@ -42,5 +53,25 @@ type OrderPaidProducerConfig interface {
 type ShipAssemblyConsumerConfig interface {
 	Topic() string
 	GroupID() string
+	HandlerTimeout() time.Duration
 	Config() *sarama.Config
 }
+
+// ShutdownConfig интерфейс для конфигурации graceful shutdown
+type ShutdownConfig interface {
+	Timeout() time.Duration
+	StepTimeout() time.Duration
+}
+
+type TLSConfig interface {
+	Enabled() bool
+	CAFile() string
+	CertFile() string
+	KeyFile() string
+	AllowedSANs() []string
+	ReloadInterval() time.Duration
+}
+
+type AdminConfig interface {
+	Address() string
+}
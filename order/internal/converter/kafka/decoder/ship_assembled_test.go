@@ -0,0 +1,73 @@
+package decoder
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
+	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
+)
+
+func (s *DecoderSuite) shipAssembledPayload(orderUUID uuid.UUID) []byte {
+	payload, err := proto.Marshal(&eventsV1.ShipAssembled{
+		EventUuid:    uuid.NewString(),
+		OrderUuid:    orderUUID.String(),
+		UserUuid:     uuid.NewString(),
+		BuildTimeSec: 10,
+	})
+	s.Require().NoError(err)
+
+	return payload
+}
+
+func (s *DecoderSuite) TestDecode_WithoutHeaders() {
+	// Подготовка: сообщение от продьюсера без заголовков CloudEvents
+	orderUUID := uuid.New()
+	msg := kafka.Message{Value: s.shipAssembledPayload(orderUUID)}
+
+	// Выполнение
+	event, err := NewShipAssembledDecoder().Decode(msg)
+
+	// Проверка
+	s.Require().NoError(err)
+	assert.Equal(s.T(), orderUUID, event.OrderUUID)
+	assert.Equal(s.T(), int64(10), event.BuildTime)
+}
+
+func (s *DecoderSuite) TestDecode_WithHeaders() {
+	// Подготовка
+	orderUUID := uuid.New()
+	meta := kafkaEvent.Meta{
+		ID:            uuid.NewString(),
+		Type:          events.ShipAssembledType,
+		SchemaVersion: events.SchemaVersionV1,
+		Source:        "assembly",
+	}
+	msg := kafka.Message{Value: s.shipAssembledPayload(orderUUID), Headers: meta.Headers()}
+
+	// Выполнение
+	event, err := NewShipAssembledDecoder().Decode(msg)
+
+	// Проверка
+	s.Require().NoError(err)
+	assert.Equal(s.T(), orderUUID, event.OrderUUID)
+}
+
+func (s *DecoderSuite) TestDecode_UnknownVersion() {
+	// Подготовка
+	meta := kafkaEvent.Meta{
+		Type:          events.ShipAssembledType,
+		SchemaVersion: "v99",
+	}
+	msg := kafka.Message{Value: s.shipAssembledPayload(uuid.New()), Headers: meta.Headers()}
+
+	// Выполнение
+	event, err := NewShipAssembledDecoder().Decode(msg)
+
+	// Проверка
+	assert.ErrorIs(s.T(), err, kafkaEvent.ErrUnknownEvent)
+	assert.Nil(s.T(), event)
+}
@@ -0,0 +1,17 @@
+package decoder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// DecoderSuite общий suite для тестов декодеров
+type DecoderSuite struct {
+	suite.Suite
+}
+
+// TestDecoderSuite запускает все тесты декодеров
+func TestDecoderSuite(t *testing.T) {
+	suite.Run(t, new(DecoderSuite))
+}
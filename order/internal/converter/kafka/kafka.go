@@ -1,7 +1,10 @@
 package kafka
 
-import "github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
+import (
+	"github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+)
 
 type ShipAssembledDecoder interface {
-	Decode(data []byte) (*model.ShipAssembledEvent, error)
+	Decode(msg kafka.Message) (*model.ShipAssembledEvent, error)
 }
@@ -11,6 +11,8 @@ var (
 	ErrPaid                = errors.New("order status is paid")
 	ErrCancelled           = errors.New("order status is cancelled")
 	ErrPartsSpecified      = errors.New("parts not specified")
+	ErrKitNotFound         = errors.New("kit not found")
+	ErrKitUnavailable      = errors.New("kit is unavailable")
 	ErrOrderNotFound       = errors.New("order not found")
 	ErrFailedToBuildQuery  = errors.New("failed to build query")
 	ErrFailedToInsertOrder = errors.New("failed to insert order")
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Filter struct {
 	PartUUIDs             []uuid.UUID
@@ -8,4 +12,6 @@ type Filter struct {
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	// PriceAt — момент, на который нужны цены деталей. Нулевой — текущие цены
+	PriceAt time.Time
 }
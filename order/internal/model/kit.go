@@ -0,0 +1,15 @@
+package model
+
+import "github.com/google/uuid"
+
+// Kit — набор деталей из inventory, развёрнутый в позиции по текущим ценам
+type Kit struct {
+	KitUUID uuid.UUID
+	Lines   []KitLine
+}
+
+// KitLine — деталь набора и её количество
+type KitLine struct {
+	Part     Part
+	Quantity int64
+}
@@ -3,9 +3,11 @@ package model
 import "github.com/google/uuid"
 
 type Order struct {
-	OrderUUID       uuid.UUID
-	UserUUID        uuid.UUID
-	PartUUIDs       []uuid.UUID
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	PartUUIDs []uuid.UUID
+	// KitUUID — набор из inventory, который при создании заказа разворачивается в PartUUIDs
+	KitUUID         uuid.UUID
 	TotalPrice      float32
 	TransactionUUID uuid.UUID
 	PaymentMethod   string
@@ -17,7 +17,7 @@ type service struct {
 }
 
 type ShipAssembledDecoder interface {
-	Decode(data []byte) (*model.ShipAssembledEvent, error)
+	Decode(msg kafka.Message) (*model.ShipAssembledEvent, error)
 }
 
 type OrderService interface {
@@ -45,7 +45,7 @@ func (s *service) RunConsumer(ctx context.Context) error {
 }
 
 func (s *service) ShipAssembledHandler(ctx context.Context, msg kafka.Message) error {
-	event, err := s.shipAssembledDecoder.Decode(msg.Value)
+	event, err := s.shipAssembledDecoder.Decode(msg)
 	if err != nil {
 		logger.Error(ctx, "Failed to decode ShipAssembled", zap.Error(err))
 		return err
@@ -3,41 +3,78 @@ package order
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
 )
 
 func (s service) CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
-	// Проверяем что детали указаны и заполняем фильтр
-	if len(order.PartUUIDs) == 0 {
+	// Проверяем что указаны детали или набор
+	if len(order.PartUUIDs) == 0 && order.KitUUID == uuid.Nil {
 		return nil, model.ErrPartsSpecified
 	}
+
+	// Считаем общую стоимость заказа
+	totalPrice := 0.0
+	if len(order.PartUUIDs) > 0 {
+		partsPrice, err := s.partsPrice(ctx, order.PartUUIDs)
+		if err != nil {
+			return nil, err
+		}
+		totalPrice += partsPrice
+	}
+
+	// Набор разворачивается в позиции заказа: деталь повторяется столько раз,
+	// сколько её в наборе, и оплачивается за каждую единицу
+	if order.KitUUID != uuid.Nil {
+		kit, err := s.inventoryClient.ExpandKit(ctx, order.KitUUID)
+		if err != nil {
+			return nil, fmt.Errorf("service: failed to expand kit in inventory client: %w", err)
+		}
+
+		for _, line := range kit.Lines {
+			for range line.Quantity {
+				order.PartUUIDs = append(order.PartUUIDs, line.Part.PartUUID)
+			}
+			totalPrice += line.Part.Price * float64(line.Quantity)
+		}
+	}
+	order.TotalPrice = float32(totalPrice)
+
+	order.Status = model.StatusPendingPayment
+
+	// Сохраняем заказ в хранилище
+	order, err := s.orderRepository.CreateOrder(ctx, order)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to create order in repository: %w", err)
+	}
+	return order, nil
+}
+
+// partsPrice возвращает стоимость деталей заказа
+func (s service) partsPrice(ctx context.Context, partUUIDs []uuid.UUID) (float64, error) {
+	// Цены берутся на момент оформления: изменение, запланированное в inventory
+	// на более позднее время, не влияет на стоимость заказа
 	uuidFilter := model.Filter{
-		PartUUIDs: order.PartUUIDs,
+		PartUUIDs: partUUIDs,
+		PriceAt:   time.Now().UTC(),
 	}
 
 	// Выполняем запрос к API инвентаря для получения деталей заказа
 	parts, err := s.inventoryClient.ListParts(ctx, &uuidFilter)
 	if err != nil {
-		return nil, fmt.Errorf("service: failed to get list parts from inventory client: %w", err)
+		return 0, fmt.Errorf("service: failed to get list parts from inventory client: %w", err)
 	}
-	if len(*parts) != len(order.PartUUIDs) {
-		return nil, model.ErrPartsListNotFound
+	if len(*parts) != len(partUUIDs) {
+		return 0, model.ErrPartsListNotFound
 	}
 
-	// Считаем общую стоимость заказа
 	totalPrice := 0.0
 	for _, part := range *parts {
 		totalPrice += part.Price
 	}
-	order.TotalPrice = float32(totalPrice)
 
-	order.Status = model.StatusPendingPayment
-
-	// Сохраняем заказ в хранилище
-	order, err = s.orderRepository.CreateOrder(ctx, order)
-	if err != nil {
-		return nil, fmt.Errorf("service: failed to create order in repository: %w", err)
-	}
-	return order, nil
+	return totalPrice, nil
 }
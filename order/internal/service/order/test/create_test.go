@@ -2,6 +2,7 @@ package order_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -34,9 +35,11 @@ func (s *ServiceSuite) TestCreateOrder_Success() {
 		Status:     model.StatusPendingPayment,
 	}
 
-	// Настройка моков
-	s.inventoryClient.On("ListParts", mock.Anything, mock.AnythingOfType("*model.Filter")).
-		Return(&parts, nil)
+	// Настройка моков: цены запрашиваются на момент оформления заказа
+	before := time.Now()
+	s.inventoryClient.On("ListParts", mock.Anything, mock.MatchedBy(func(filter *model.Filter) bool {
+		return !filter.PriceAt.Before(before) && !filter.PriceAt.After(time.Now())
+	})).Return(&parts, nil)
 	s.orderRepository.On("CreateOrder", mock.Anything, mock.AnythingOfType("*model.Order")).
 		Return(expectedOrder, nil)
 
@@ -154,3 +157,70 @@ func (s *ServiceSuite) TestCreateOrder_CreateError() {
 	s.inventoryClient.AssertExpectations(s.T())
 	s.orderRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestCreateOrder_KitExpandedIntoParts() {
+	// Тестовые данные: набор из двух позиций и отдельная деталь
+	kitUUID := uuid.New()
+	partUUID := uuid.New()
+	engine := model.Part{PartUUID: uuid.New(), Price: 100.0}
+	control := model.Part{PartUUID: uuid.New(), Price: 50.0}
+
+	order := &model.Order{
+		UserUUID:  uuid.New(),
+		PartUUIDs: []uuid.UUID{partUUID},
+		KitUUID:   kitUUID,
+	}
+
+	parts := []model.Part{{PartUUID: partUUID, Price: 10.0}}
+	kit := &model.Kit{KitUUID: kitUUID, Lines: []model.KitLine{
+		{Part: engine, Quantity: 3},
+		{Part: control, Quantity: 1},
+	}}
+
+	s.inventoryClient.On("ListParts", mock.Anything, mock.AnythingOfType("*model.Filter")).Return(&parts, nil)
+	s.inventoryClient.On("ExpandKit", mock.Anything, kitUUID).Return(kit, nil)
+	s.orderRepository.On("CreateOrder", mock.Anything, mock.AnythingOfType("*model.Order")).
+		Return(func(_ context.Context, order *model.Order) (*model.Order, error) { return order, nil })
+
+	// Вызов метода
+	result, err := s.service.CreateOrder(context.Background(), order)
+
+	// Проверка результата: деталь набора повторяется по количеству
+	s.Require().NoError(err)
+	s.Require().Equal([]uuid.UUID{partUUID, engine.PartUUID, engine.PartUUID, engine.PartUUID, control.PartUUID}, result.PartUUIDs)
+	s.Require().Equal(float32(360.0), result.TotalPrice)
+	s.Require().Equal(model.StatusPendingPayment, result.Status)
+
+	s.inventoryClient.AssertExpectations(s.T())
+	s.orderRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCreateOrder_KitOnly() {
+	kitUUID := uuid.New()
+	engine := model.Part{PartUUID: uuid.New(), Price: 100.0}
+
+	order := &model.Order{UserUUID: uuid.New(), KitUUID: kitUUID}
+
+	s.inventoryClient.On("ExpandKit", mock.Anything, kitUUID).
+		Return(&model.Kit{KitUUID: kitUUID, Lines: []model.KitLine{{Part: engine, Quantity: 2}}}, nil)
+	s.orderRepository.On("CreateOrder", mock.Anything, mock.AnythingOfType("*model.Order")).
+		Return(func(_ context.Context, order *model.Order) (*model.Order, error) { return order, nil })
+
+	result, err := s.service.CreateOrder(context.Background(), order)
+
+	s.Require().NoError(err)
+	s.Require().Equal([]uuid.UUID{engine.PartUUID, engine.PartUUID}, result.PartUUIDs)
+	s.Require().Equal(float32(200.0), result.TotalPrice)
+}
+
+func (s *ServiceSuite) TestCreateOrder_KitNotFound() {
+	kitUUID := uuid.New()
+	order := &model.Order{UserUUID: uuid.New(), KitUUID: kitUUID}
+
+	s.inventoryClient.On("ExpandKit", mock.Anything, kitUUID).Return(nil, model.ErrKitNotFound)
+
+	result, err := s.service.CreateOrder(context.Background(), order)
+
+	s.Require().Empty(result)
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+}
@@ -2,16 +2,22 @@ package producer
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/kont1n/MSA_Rocket_Factory/order/internal/model"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	kafkaEvent "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/event"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	"github.com/kont1n/MSA_Rocket_Factory/shared/pkg/events"
 	eventsV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1"
 )
 
+// eventSource — источник событий в заголовке ce_source.
+const eventSource = "order"
+
 type service struct {
 	orderPaidProducer kafka.Producer
 }
@@ -37,7 +43,16 @@ func (p *service) ProduceOrderPaid(ctx context.Context, event model.OrderPaidEve
 		return err
 	}
 
-	err = p.orderPaidProducer.Send(ctx, []byte(event.EventUUID.String()), payload)
+	meta := kafkaEvent.Meta{
+		OccurredAt:    time.Now(),
+		ID:            event.EventUUID.String(),
+		Type:          events.OrderPaidType,
+		SchemaVersion: events.SchemaVersionV1,
+		Source:        eventSource,
+		CorrelationID: event.OrderUUID.String(),
+	}
+
+	err = p.orderPaidProducer.SendWithHeaders(ctx, []byte(event.OrderUUID.String()), payload, meta.Headers())
 	if err != nil {
 		logger.Error(ctx, "Failed to send OrderPaid event to Kafka", zap.Error(err))
 		return err
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/kont1n/MSA_Rocket_Factory/payment/internal/config"
+	grpcHealth "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/health"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/interceptors"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 	paymentV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/payment/v1"
 )
@@ -18,19 +22,32 @@ import (
 type Gateway struct {
 	mux    *runtime.ServeMux
 	server *http.Server
+	health *health.Registry
+	creds  credentials.TransportCredentials
 }
 
-func NewGateway() *Gateway {
+func NewGateway(healthRegistry *health.Registry, creds credentials.TransportCredentials) *Gateway {
 	return &Gateway{
-		mux: runtime.NewServeMux(),
+		mux:    runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher)),
+		health: healthRegistry,
+		creds:  creds,
 	}
 }
 
+// headerMatcher пробрасывает X-Request-Id в метаданные gRPC в дополнение к стандартным заголовкам
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, interceptors.RequestIDHeader) {
+		return interceptors.RequestIDHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (g *Gateway) RegisterHandlers(ctx context.Context) error {
 	// Создаем подключение к gRPC серверу
 	conn, err := grpc.NewClient(
 		config.AppConfig().GRPC.Address(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(g.creds),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to connect to gRPC server: %w", err)
@@ -42,6 +59,23 @@ func (g *Gateway) RegisterHandlers(ctx context.Context) error {
 		return fmt.Errorf("failed to register payment service handler: %w", err)
 	}
 
+	// Gateway готов принимать трафик, только пока доступен gRPC сервер
+	g.health.Register("grpc", health.Readiness, health.GRPCCheck(conn, grpcHealth.LivenessService))
+
+	err = g.mux.HandlePath(http.MethodGet, "/healthz", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		g.health.LivenessHandler()(w, r)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register liveness handler: %w", err)
+	}
+
+	err = g.mux.HandlePath(http.MethodGet, "/readyz", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		g.health.ReadinessHandler()(w, r)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register readiness handler: %w", err)
+	}
+
 	return nil
 }
 
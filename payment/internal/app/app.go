@@ -7,13 +7,15 @@ import (
 	"net"
 	"sync"
 
+	"buf.build/go/protovalidate"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/kont1n/MSA_Rocket_Factory/payment/internal/config"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/admin"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/health"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/grpc/interceptors"
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 	paymentV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/payment/v1"
 )
@@ -22,6 +24,7 @@ type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
 	listener    net.Listener
+	adminServer *admin.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -44,6 +47,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initAdminServer,
 		a.initListener,
 		a.initGRPCServer,
 		a.initGateway,
@@ -65,14 +69,50 @@ func (a *App) initDI(_ context.Context) error {
 }
 
 func (a *App) initLogger(_ context.Context) error {
+	cfg := config.AppConfig().Logger
+
 	return logger.Init(
-		config.AppConfig().Logger.Level(),
-		config.AppConfig().Logger.AsJson(),
+		cfg.Level(),
+		cfg.AsJson(),
+		logger.WithServiceName("payment"),
+		logger.WithModuleLevels(cfg.ModuleLevels()),
+		logger.WithOutputs(cfg.Outputs()...),
+		logger.WithFile(logger.FileConfig{
+			Path:       cfg.FilePath(),
+			MaxSizeMB:  cfg.FileMaxSizeMB(),
+			MaxBackups: cfg.FileMaxBackups(),
+		}),
+		logger.WithOTLP(cfg.OTLPEndpoint()),
+		logger.WithStacktraceRate(cfg.StacktraceRate()),
 	)
 }
 
+func (a *App) initAdminServer(ctx context.Context) error {
+	a.adminServer = admin.New(
+		config.AppConfig().Admin.Address(),
+		admin.WithServiceName("payment"),
+		admin.WithHealth(a.diContainer.HealthRegistry(ctx)),
+		admin.WithConfig(config.AppConfig().Effective),
+	)
+
+	// Служебный сервер останавливаем последним, чтобы health, метрики и pprof были доступны во время завершения
+	closer.AddPhase(closer.PhaseResources, "Admin server", a.adminServer.Stop)
+
+	return nil
+}
+
+func (a *App) runAdminServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🛠️ Admin server listening on %s", config.AppConfig().Admin.Address()))
+
+	return a.adminServer.Start(ctx)
+}
+
 func (a *App) initCloser(_ context.Context) error {
 	closer.SetLogger(logger.Logger())
+	closer.SetTimeouts(
+		config.AppConfig().Shutdown.Timeout(),
+		config.AppConfig().Shutdown.StepTimeout(),
+	)
 	return nil
 }
 
@@ -96,8 +136,16 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
-	closer.AddNamed("gRPC server", func(ctx context.Context) error {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return fmt.Errorf("failed to create request validator: %w", err)
+	}
+
+	a.grpcServer = grpc.NewServer(append(
+		[]grpc.ServerOption{grpc.Creds(a.diContainer.ServerCredentials(ctx))},
+		interceptors.ServerOptions(logger.Named("grpc"), interceptors.WithValidation(validator))...,
+	)...)
+	closer.AddPhase(closer.PhaseServers, "gRPC server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
 		return nil
 	})
@@ -105,7 +153,7 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	reflection.Register(a.grpcServer)
 
 	// Регистрируем health service для проверки работоспособности
-	health.RegisterService(a.grpcServer)
+	health.RegisterService(a.grpcServer, a.diContainer.HealthRegistry(ctx))
 
 	paymentV1.RegisterPaymentServiceServer(a.grpcServer, a.diContainer.PaymentV1API(ctx))
 
@@ -116,7 +164,7 @@ func (a *App) initGateway(ctx context.Context) error {
 	gateway := a.diContainer.Gateway(ctx)
 
 	// Добавляем gateway в closer для корректного завершения
-	closer.AddNamed("HTTP Gateway", func(ctx context.Context) error {
+	closer.AddPhase(closer.PhaseServers, "HTTP Gateway", func(ctx context.Context) error {
 		return gateway.Stop(ctx)
 	})
 
@@ -125,7 +173,7 @@ func (a *App) initGateway(ctx context.Context) error {
 
 func (a *App) runServers(ctx context.Context) error {
 	var wg sync.WaitGroup
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 3)
 
 	// Запускаем gRPC сервер
 	wg.Add(1)
@@ -151,6 +199,17 @@ func (a *App) runServers(ctx context.Context) error {
 		}
 	}()
 
+	// Запускаем служебный сервер
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		err := a.runAdminServer(ctx)
+		if err != nil {
+			errCh <- fmt.Errorf("admin server error: %w", err)
+		}
+	}()
+
 	// Ждем завершения или ошибки
 	go func() {
 		wg.Wait()
@@ -2,11 +2,20 @@ package app
 
 import (
 	"context"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
 	gatewayV1 "github.com/kont1n/MSA_Rocket_Factory/payment/internal/api/gateway/v1"
 	paymentV1API "github.com/kont1n/MSA_Rocket_Factory/payment/internal/api/payment/v1"
+	"github.com/kont1n/MSA_Rocket_Factory/payment/internal/config"
 	"github.com/kont1n/MSA_Rocket_Factory/payment/internal/service"
 	paymentService "github.com/kont1n/MSA_Rocket_Factory/payment/internal/service/payment"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+	platformTLS "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/tls"
 	paymentV1 "github.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/payment/v1"
 )
 
@@ -14,6 +23,8 @@ type diContainer struct {
 	paymentAPIv1   paymentV1.PaymentServiceServer
 	paymentService service.PaymentService
 	gateway        *gatewayV1.Gateway
+	healthRegistry *health.Registry
+	tlsSource      *platformTLS.Source
 }
 
 func NewDiContainer() *diContainer {
@@ -36,7 +47,66 @@ func (d *diContainer) PaymentService(ctx context.Context) service.PaymentService
 
 func (d *diContainer) Gateway(ctx context.Context) *gatewayV1.Gateway {
 	if d.gateway == nil {
-		d.gateway = gatewayV1.NewGateway()
+		d.gateway = gatewayV1.NewGateway(
+			d.HealthRegistry(ctx),
+			// Gateway обращается к gRPC-серверу этого же сервиса через loopback
+			d.ClientCredentials(ctx, "localhost"),
+		)
 	}
 	return d.gateway
 }
+
+func (d *diContainer) HealthRegistry(_ context.Context) *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+
+		watchCtx, cancel := context.WithCancel(context.Background())
+		registry.Start(watchCtx)
+		closer.AddNamed("Health checks", func(ctx context.Context) error {
+			cancel()
+			return nil
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
+
+// TLSSource возвращает источник сертификатов для mTLS или nil, если TLS выключен
+func (d *diContainer) TLSSource(_ context.Context) *platformTLS.Source {
+	if d.tlsSource == nil && config.AppConfig().TLS.Enabled() {
+		source, err := platformTLS.NewSource(config.AppConfig().TLS, logger.Named("tls"))
+		if err != nil {
+			panic(fmt.Sprintf("failed to load TLS certificates: %v", err))
+		}
+
+		source.Watch(context.Background())
+		closer.AddNamed("TLS certificates reloader", func(ctx context.Context) error {
+			return source.Close()
+		})
+
+		d.tlsSource = source
+	}
+
+	return d.tlsSource
+}
+
+// ServerCredentials возвращает credentials gRPC-сервера: mTLS, если он включён, иначе insecure
+func (d *diContainer) ServerCredentials(ctx context.Context) credentials.TransportCredentials {
+	if source := d.TLSSource(ctx); source != nil {
+		return source.ServerCredentials()
+	}
+
+	return insecure.NewCredentials()
+}
+
+// ClientCredentials возвращает credentials gRPC-клиента: mTLS, если он включён, иначе insecure.
+// Пустой serverName означает проверку сертификата по имени хоста из адреса подключения.
+func (d *diContainer) ClientCredentials(ctx context.Context, serverName string) credentials.TransportCredentials {
+	if source := d.TLSSource(ctx); source != nil {
+		return source.ClientCredentials(serverName)
+	}
+
+	return insecure.NewCredentials()
+}
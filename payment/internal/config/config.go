@@ -1,49 +1,99 @@
 package config
 
 import (
+	"errors"
+	"io"
 	"os"
 
-	"github.com/joho/godotenv"
-
 	"github.com/kont1n/MSA_Rocket_Factory/payment/internal/config/env"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 var appConfig *config
 
 type config struct {
-	Logger LoggerConfig
-	GRPC   GRPCConfig
-	Http   HttpConfig
+	Logger   LoggerConfig
+	GRPC     GRPCConfig
+	Http     HttpConfig
+	Shutdown ShutdownConfig
+	TLS      TLSConfig
+	Admin    AdminConfig
+
+	effective map[string]string
 }
 
 func Load(path ...string) error {
-	err := godotenv.Load(path...)
-	if err != nil && !os.IsNotExist(err) {
-		return err
-	}
-
-	loggerCfg, err := env.NewLoggerConfig()
+	loader, err := platformConfig.New(
+		platformConfig.WithFile(os.Getenv(platformConfig.FileEnv)),
+		platformConfig.WithDotEnv(path...),
+	)
 	if err != nil {
 		return err
 	}
 
-	GRPCCfg, err := env.NewGRPCConfig()
+	cfg, err := load(loader)
 	if err != nil {
 		return err
 	}
 
-	HttpCfg, err := env.NewHttpConfig()
+	appConfig = cfg
+
+	return nil
+}
+
+// WriteReference выводит справочник всех ключей конфигурации сервиса в формате Markdown
+func WriteReference(w io.Writer) error {
+	loader, err := platformConfig.New(platformConfig.WithEnviron(nil))
 	if err != nil {
 		return err
 	}
 
-	appConfig = &config{
-		Logger: loggerCfg,
-		GRPC:   GRPCCfg,
-		Http:   HttpCfg,
+	// На пустом окружении обязательные ключи не заполнены — нужны только их описания
+	_, _ = load(loader)
+
+	return platformConfig.WriteReference(w, "Payment", loader.Keys())
+}
+
+// load читает все секции конфигурации и возвращает ошибки всех секций разом
+func load(loader *platformConfig.Loader) (*config, error) {
+	var errs []error
+
+	loggerCfg, err := env.NewLoggerConfig(loader)
+	errs = append(errs, err)
+
+	GRPCCfg, err := env.NewGRPCConfig(loader)
+	errs = append(errs, err)
+
+	HttpCfg, err := env.NewHttpConfig(loader)
+	errs = append(errs, err)
+
+	shutdownCfg, err := env.NewShutdownConfig(loader)
+	errs = append(errs, err)
+
+	tlsCfg, err := env.NewTLSConfig(loader)
+	errs = append(errs, err)
+
+	adminCfg, err := env.NewAdminConfig(loader)
+	errs = append(errs, err)
+
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &config{
+		Logger:    loggerCfg,
+		GRPC:      GRPCCfg,
+		Http:      HttpCfg,
+		Shutdown:  shutdownCfg,
+		TLS:       tlsCfg,
+		Admin:     adminCfg,
+		effective: loader.Effective(),
+	}, nil
+}
+
+// Effective возвращает итоговые значения всех ключей конфигурации со скрытыми секретами
+func (c *config) Effective() map[string]string {
+	return c.effective
 }
 
 func AppConfig() *config {
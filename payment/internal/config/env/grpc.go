@@ -3,21 +3,21 @@ package env
 import (
 	"net"
 
-	"github.com/caarlos0/env/v11"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type GRPCEnvConfig struct {
-	Host string `env:"GRPC_HOST,required"`
-	Port string `env:"GRPC_PORT,required"`
+	Host string `env:"GRPC_HOST,required" desc:"Адрес, на котором слушает gRPC-сервер"`
+	Port string `env:"GRPC_PORT,required" validate:"port" desc:"Порт gRPC-сервера"`
 }
 
 type GRPCConfig struct {
 	raw GRPCEnvConfig
 }
 
-func NewGRPCConfig() (*GRPCConfig, error) {
+func NewGRPCConfig(loader *platformConfig.Loader) (*GRPCConfig, error) {
 	var raw GRPCEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -3,21 +3,21 @@ package env
 import (
 	"net"
 
-	"github.com/caarlos0/env/v11"
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
 )
 
 type HttpEnvConfig struct {
-	Host string `env:"HTTP_HOST,required"`
-	Port string `env:"HTTP_PORT,required"`
+	Host string `env:"HTTP_HOST,required" desc:"Адрес, на котором слушает HTTP-сервер"`
+	Port string `env:"HTTP_PORT,required" validate:"port" desc:"Порт HTTP-сервера"`
 }
 
 type HttpConfig struct {
 	raw HttpEnvConfig
 }
 
-func NewHttpConfig() (*HttpConfig, error) {
+func NewHttpConfig(loader *platformConfig.Loader) (*HttpConfig, error) {
 	var raw HttpEnvConfig
-	if err := env.Parse(&raw); err != nil {
+	if err := loader.Parse(&raw); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"time"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type shutdownEnvConfig struct {
+	Timeout     time.Duration `env:"SHUTDOWN_TIMEOUT" envDefault:"15s" validate:"min=1s" desc:"Общий таймаут graceful shutdown"`
+	StepTimeout time.Duration `env:"SHUTDOWN_STEP_TIMEOUT" envDefault:"5s" validate:"min=100ms" desc:"Таймаут закрытия одного ресурса"`
+}
+
+type shutdownConfig struct {
+	raw shutdownEnvConfig
+}
+
+func NewShutdownConfig(loader *platformConfig.Loader) (*shutdownConfig, error) {
+	var raw shutdownEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &shutdownConfig{raw: raw}, nil
+}
+
+func (cfg *shutdownConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
+
+func (cfg *shutdownConfig) StepTimeout() time.Duration {
+	return cfg.raw.StepTimeout
+}
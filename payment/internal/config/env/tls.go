@@ -0,0 +1,53 @@
+package env
+
+import (
+	"time"
+
+	platformConfig "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/config"
+)
+
+type tlsEnvConfig struct {
+	Enabled        bool          `env:"TLS_ENABLED" envDefault:"false" desc:"Включить mTLS для gRPC"`
+	CAFile         string        `env:"TLS_CA_FILE" desc:"Путь к сертификату CA"`
+	CertFile       string        `env:"TLS_CERT_FILE" desc:"Путь к сертификату сервиса"`
+	KeyFile        string        `env:"TLS_KEY_FILE" desc:"Путь к приватному ключу сервиса"`
+	AllowedSANs    []string      `env:"TLS_ALLOWED_SANS" envSeparator:"," desc:"Разрешённые SAN собеседников (пусто — любой сертификат от CA)"`
+	ReloadInterval time.Duration `env:"TLS_RELOAD_INTERVAL" envDefault:"30s" desc:"Период проверки файлов сертификатов на изменение"`
+}
+
+type tlsConfig struct {
+	raw tlsEnvConfig
+}
+
+func NewTLSConfig(loader *platformConfig.Loader) (*tlsConfig, error) {
+	var raw tlsEnvConfig
+	if err := loader.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &tlsConfig{raw: raw}, nil
+}
+
+func (cfg *tlsConfig) Enabled() bool {
+	return cfg.raw.Enabled
+}
+
+func (cfg *tlsConfig) CAFile() string {
+	return cfg.raw.CAFile
+}
+
+func (cfg *tlsConfig) CertFile() string {
+	return cfg.raw.CertFile
+}
+
+func (cfg *tlsConfig) KeyFile() string {
+	return cfg.raw.KeyFile
+}
+
+func (cfg *tlsConfig) AllowedSANs() []string {
+	return cfg.raw.AllowedSANs
+}
+
+func (cfg *tlsConfig) ReloadInterval() time.Duration {
+	return cfg.raw.ReloadInterval
+}
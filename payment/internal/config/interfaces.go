@@ -1,8 +1,17 @@
 package config
 
+import "time"
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
+	ModuleLevels() map[string]string
+	Outputs() []string
+	FilePath() string
+	FileMaxSizeMB() int
+	FileMaxBackups() int
+	OTLPEndpoint() string
+	StacktraceRate() int
 }
 
 type GRPCConfig interface {
@@ -12,3 +21,21 @@ type GRPCConfig interface {
 type HttpConfig interface {
 	Address() string
 }
+
+type ShutdownConfig interface {
+	Timeout() time.Duration
+	StepTimeout() time.Duration
+}
+
+type TLSConfig interface {
+	Enabled() bool
+	CAFile() string
+	CertFile() string
+	KeyFile() string
+	AllowedSANs() []string
+	ReloadInterval() time.Duration
+}
+
+type AdminConfig interface {
+	Address() string
+}
@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/buildinfo"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+const readHeaderTimeout = 5 * time.Second
+
+// Server — служебный HTTP-сервер сервиса. Слушает отдельный порт и не должен быть доступен извне кластера.
+//
+//	/debug/pprof/  — профилирование
+//	/metrics       — метрики Prometheus
+//	/healthz       — liveness
+//	/readyz        — readiness
+//	/loglevel      — просмотр и смена уровня логирования
+//	/buildinfo     — версия, коммит и время сборки
+//	/config        — итоговая конфигурация без секретов
+type Server struct {
+	mux      *http.ServeMux
+	server   *http.Server
+	registry *prometheus.Registry
+}
+
+type Option func(*options)
+
+type options struct {
+	service string
+	health  *health.Registry
+	config  func() map[string]string
+}
+
+// WithServiceName задаёт имя сервиса для метрики build_info.
+func WithServiceName(name string) Option {
+	return func(o *options) {
+		o.service = name
+	}
+}
+
+// WithHealth подключает реестр проверок для /healthz и /readyz.
+// Без реестра оба эндпоинта всегда отвечают 200.
+func WithHealth(registry *health.Registry) Option {
+	return func(o *options) {
+		o.health = registry
+	}
+}
+
+// WithConfig задаёт источник итоговой конфигурации для /config.
+// Функция должна возвращать значения с уже скрытыми секретами.
+func WithConfig(values func() map[string]string) Option {
+	return func(o *options) {
+		o.config = values
+	}
+}
+
+// New создаёт служебный сервер на адресе addr.
+func New(addr string, opts ...Option) *Server {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	s := &Server{
+		mux:      http.NewServeMux(),
+		registry: prometheus.NewRegistry(),
+	}
+
+	s.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		buildInfoCollector(o.service),
+	)
+
+	s.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	s.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	s.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	s.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	s.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	s.mux.Handle("/metrics", promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{}))
+	s.mux.Handle("/loglevel", logger.LevelHandler())
+	s.mux.HandleFunc("GET /buildinfo", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, buildinfo.Get())
+	})
+
+	if o.health != nil {
+		s.mux.HandleFunc("GET /healthz", o.health.LivenessHandler())
+		s.mux.HandleFunc("GET /readyz", o.health.ReadinessHandler())
+	} else {
+		s.mux.HandleFunc("GET /healthz", ok)
+		s.mux.HandleFunc("GET /readyz", ok)
+	}
+
+	config := o.config
+	if config == nil {
+		config = func() map[string]string { return map[string]string{} }
+	}
+	s.mux.HandleFunc("GET /config", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, config())
+	})
+
+	s.server = &http.Server{
+		Addr:              addr,
+		Handler:           s.mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return s
+}
+
+// Handle регистрирует дополнительный обработчик на служебном сервере.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Registry возвращает реестр Prometheus для регистрации метрик сервиса.
+func (s *Server) Registry() *prometheus.Registry {
+	return s.registry
+}
+
+// Addr возвращает адрес, на котором слушает сервер.
+func (s *Server) Addr() string {
+	return s.server.Addr
+}
+
+// Start запускает сервер и блокируется до его остановки.
+func (s *Server) Start(_ context.Context) error {
+	err := s.server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Stop корректно останавливает сервер.
+func (s *Server) Stop(ctx context.Context) error {
+	err := s.server.Shutdown(ctx)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func buildInfoCollector(service string) prometheus.Collector {
+	info := buildinfo.Get()
+
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "build_info",
+		Help: "Информация о сборке сервиса. Значение всегда равно 1.",
+	}, []string{"service", "version", "commit", "build_time", "go_version"})
+	gauge.WithLabelValues(service, info.Version, info.Commit, info.BuildTime, info.GoVersion).Set(1)
+
+	return gauge
+}
+
+func ok(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]string{"status": string(health.StatusUp)})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,53 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Значения задаются при сборке через ldflags:
+//
+//	go build -ldflags "-X github.com/kont1n/MSA_Rocket_Factory/platform/pkg/buildinfo.Version=v1.2.3 \
+//	  -X github.com/kont1n/MSA_Rocket_Factory/platform/pkg/buildinfo.Commit=abc123 \
+//	  -X github.com/kont1n/MSA_Rocket_Factory/platform/pkg/buildinfo.BuildTime=2024-01-01T00:00:00Z"
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info — информация о сборке сервиса.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get возвращает информацию о сборке. Если коммит и время сборки не заданы через ldflags,
+// они берутся из данных системы контроля версий, встроенных компилятором.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = s.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = s.Value
+				}
+			}
+		}
+	}
+
+	return info
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,8 +15,65 @@ import (
 	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
 )
 
-// shutdownTimeout по умолчанию, можно сделать параметром
-const shutdownTimeout = 5 * time.Second
+// Таймауты по умолчанию, переопределяются через SetTimeouts
+const (
+	defaultShutdownTimeout = 15 * time.Second
+	defaultStepTimeout     = 5 * time.Second
+)
+
+// Phase — фаза завершения работы. Фазы выполняются строго по порядку,
+// функции внутри одной фазы — параллельно.
+type Phase int
+
+const (
+	// PhaseServers — прекращаем приём входящих HTTP/gRPC запросов
+	PhaseServers Phase = iota
+	// PhaseConsumers — останавливаем и дренируем Kafka consumer'ы
+	PhaseConsumers
+	// PhaseProducers — сбрасываем и закрываем Kafka producer'ы
+	PhaseProducers
+	// PhaseResources — закрываем пулы БД, клиенты и прочие ресурсы
+	PhaseResources
+)
+
+var phaseNames = map[Phase]string{
+	PhaseServers:   "servers",
+	PhaseConsumers: "consumers",
+	PhaseProducers: "producers",
+	PhaseResources: "resources",
+}
+
+func (p Phase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("phase-%d", int(p))
+}
+
+// StepReport — результат выполнения одной функции закрытия
+type StepReport struct {
+	Phase    Phase
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// StepOption — опция функции закрытия
+type StepOption func(*step)
+
+// WithTimeout задаёт собственный таймаут функции закрытия
+func WithTimeout(d time.Duration) StepOption {
+	return func(s *step) {
+		s.timeout = d
+	}
+}
+
+type step struct {
+	phase   Phase
+	name    string
+	timeout time.Duration
+	f       func(context.Context) error
+}
 
 type Logger interface {
 	Info(ctx context.Context, msg string, fields ...zap.Field)
@@ -24,11 +82,13 @@ type Logger interface {
 
 // Closer управляет процессом graceful shutdown приложения
 type Closer struct {
-	mu     sync.Mutex                    // Защита от гонки при добавлении функций
-	once   sync.Once                     // Гарантия однократного вызова CloseAll
-	done   chan struct{}                 // Канал для оповещения о завершении
-	funcs  []func(context.Context) error // Зарегистрированные функции закрытия
-	logger Logger                        // Используемый логгер
+	mu              sync.Mutex    // Защита от гонки при добавлении функций
+	once            sync.Once     // Гарантия однократного вызова CloseAll
+	done            chan struct{} // Канал для оповещения о завершении
+	steps           []step        // Зарегистрированные функции закрытия
+	logger          Logger        // Используемый логгер
+	shutdownTimeout time.Duration // Общий таймаут завершения при получении сигнала
+	stepTimeout     time.Duration // Таймаут одной функции закрытия по умолчанию
 }
 
 // Глобальный экземпляр для использования по всему приложению
@@ -39,6 +99,16 @@ func AddNamed(name string, f func(context.Context) error) {
 	globalCloser.AddNamed(name, f)
 }
 
+// AddPhase добавляет именованную функцию закрытия в указанную фазу глобального closer'а
+func AddPhase(phase Phase, name string, f func(context.Context) error, opts ...StepOption) {
+	globalCloser.AddPhase(phase, name, f, opts...)
+}
+
+// SetTimeouts задаёт общий таймаут завершения и таймаут одной функции для глобального closer'а
+func SetTimeouts(shutdown, step time.Duration) {
+	globalCloser.SetTimeouts(shutdown, step)
+}
+
 // Add добавляет функции закрытия в глобальный closer
 func Add(f ...func(context.Context) error) {
 	globalCloser.Add(f...)
@@ -68,8 +138,10 @@ func New(signals ...os.Signal) *Closer {
 // Если переданы сигналы, Closer начнёт их слушать и вызовет CloseAll при получении.
 func NewWithLogger(logger Logger, signals ...os.Signal) *Closer {
 	c := &Closer{
-		done:   make(chan struct{}),
-		logger: logger,
+		done:            make(chan struct{}),
+		logger:          logger,
+		shutdownTimeout: defaultShutdownTimeout,
+		stepTimeout:     defaultStepTimeout,
 	}
 
 	if len(signals) > 0 {
@@ -84,6 +156,33 @@ func (c *Closer) SetLogger(l Logger) {
 	c.logger = l
 }
 
+// SetTimeouts задаёт общий таймаут завершения и таймаут одной функции закрытия.
+// Нулевые значения не меняют текущие настройки.
+func (c *Closer) SetTimeouts(shutdown, step time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if shutdown > 0 {
+		c.shutdownTimeout = shutdown
+	}
+	if step > 0 {
+		c.stepTimeout = step
+	}
+}
+
+// ShutdownTimeout возвращает общий таймаут завершения
+func (c *Closer) ShutdownTimeout() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.shutdownTimeout
+}
+
+// ShutdownTimeout возвращает общий таймаут завершения глобального closer'а
+func ShutdownTimeout() time.Duration {
+	return globalCloser.ShutdownTimeout()
+}
+
 // handleSignals обрабатывает системные сигналы и вызывает CloseAll с fresh shutdown context
 func (c *Closer) handleSignals(signals ...os.Signal) {
 	ch := make(chan os.Signal, 1)
@@ -94,7 +193,7 @@ func (c *Closer) handleSignals(signals ...os.Signal) {
 	case <-ch:
 		c.logger.Info(context.Background(), "🛑 Получен системный сигнал, начинаем graceful shutdown...")
 
-		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), c.ShutdownTimeout())
 		defer shutdownCancel()
 
 		if err := c.CloseAll(shutdownCtx); err != nil {
@@ -106,33 +205,33 @@ func (c *Closer) handleSignals(signals ...os.Signal) {
 	}
 }
 
-// AddNamed добавляет функцию закрытия с именем зависимости для логирования
+// AddNamed добавляет функцию закрытия с именем зависимости в последнюю фазу (PhaseResources)
 func (c *Closer) AddNamed(name string, f func(context.Context) error) {
-	c.Add(func(ctx context.Context) error {
-		start := time.Now()
-		c.logger.Info(ctx, fmt.Sprintf("🧩 Закрываем %s...", name))
-
-		err := f(ctx)
-
-		duration := time.Since(start)
-		if err != nil {
-			c.logger.Error(ctx, fmt.Sprintf("❌ Ошибка при закрытии %s: %v (заняло %s)", name, err, duration))
-		} else {
-			c.logger.Info(ctx, fmt.Sprintf("✅ %s успешно закрыт за %s", name, duration))
-		}
-		return err
-	})
+	c.AddPhase(PhaseResources, name, f)
 }
 
-// Add добавляет одну или несколько функций закрытия
+// Add добавляет одну или несколько функций закрытия в последнюю фазу (PhaseResources)
 func (c *Closer) Add(f ...func(context.Context) error) {
+	for _, fn := range f {
+		c.AddPhase(PhaseResources, "unnamed", fn)
+	}
+}
+
+// AddPhase добавляет именованную функцию закрытия в указанную фазу
+func (c *Closer) AddPhase(phase Phase, name string, f func(context.Context) error, opts ...StepOption) {
+	s := step{phase: phase, name: name, f: f}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.funcs = append(c.funcs, f...)
+	c.steps = append(c.steps, s)
 }
 
-// CloseAll вызывает все зарегистрированные функции закрытия.
-// Возвращает первую возникшую ошибку, если таковая была.
+// CloseAll вызывает все зарегистрированные функции закрытия по фазам.
+// Фазы выполняются по порядку, функции внутри фазы — параллельно, каждая со своим таймаутом.
+// В конце логируется отчёт о завершении. Возвращает объединение всех возникших ошибок.
 func (c *Closer) CloseAll(ctx context.Context) error {
 	var result error
 
@@ -140,68 +239,136 @@ func (c *Closer) CloseAll(ctx context.Context) error {
 		defer close(c.done)
 
 		c.mu.Lock()
-		funcs := c.funcs
-		c.funcs = nil // освободим память
+		steps := c.steps
+		c.steps = nil // освободим память
+		stepTimeout := c.stepTimeout
 		c.mu.Unlock()
 
-		if len(funcs) == 0 {
+		if len(steps) == 0 {
 			c.logger.Info(ctx, "ℹ️ Нет функций для закрытия.")
 			return
 		}
 
 		c.logger.Info(ctx, "🚦 Начинаем процесс graceful shutdown...")
+		start := time.Now()
 
-		errCh := make(chan error, len(funcs))
-		var wg sync.WaitGroup
-
-		// Выполняем в обратном порядке добавления
-		for i := len(funcs) - 1; i >= 0; i-- {
-			f := funcs[i]
-			wg.Add(1)
-			go func(f func(context.Context) error) {
-				defer wg.Done()
-
-				// Защита от паники
-				defer func() {
-					if r := recover(); r != nil {
-						errCh <- errors.New("panic recovered in closer")
-						c.logger.Error(ctx, "⚠️ Panic в функции закрытия", zap.Any("error", r))
-					}
-				}()
-
-				if err := f(ctx); err != nil {
-					errCh <- err
-				}
-			}(f)
-		}
+		phases := groupByPhase(steps)
+		report := make([]StepReport, 0, len(steps))
 
-		// Закрываем канал ошибок, когда все функции завершатся
-		go func() {
-			wg.Wait()
-			close(errCh)
-		}()
+		for _, phase := range sortedPhases(phases) {
+			c.logger.Info(ctx, fmt.Sprintf("⏩ Фаза завершения: %s", phase))
+			report = append(report, c.runPhase(ctx, phases[phase], stepTimeout)...)
+		}
 
-		// Читаем ошибки или отмену контекста
-		for {
-			select {
-			case <-ctx.Done():
-				c.logger.Info(ctx, "⚠️ Контекст отменён во время закрытия", zap.Error(ctx.Err()))
-				if result == nil {
-					result = ctx.Err()
-				}
-				return
-			case err, ok := <-errCh:
-				if !ok {
-					c.logger.Info(ctx, "✅ Все ресурсы успешно закрыты")
-					return
-				}
-				c.logger.Error(ctx, "❌ Ошибка при закрытии", zap.Error(err))
-				if result == nil {
-					result = err
-				}
+		errs := make([]error, 0)
+		for _, r := range report {
+			if r.Err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", r.Name, r.Err))
 			}
 		}
+		result = errors.Join(errs...)
+
+		c.logReport(ctx, report, time.Since(start))
 	})
 
 	return result
 }
+
+// runPhase параллельно выполняет функции одной фазы и возвращает отчёт в порядке регистрации
+func (c *Closer) runPhase(ctx context.Context, steps []step, defaultTimeout time.Duration) []StepReport {
+	report := make([]StepReport, len(steps))
+
+	var wg sync.WaitGroup
+	for i, s := range steps {
+		wg.Add(1)
+		go func(i int, s step) {
+			defer wg.Done()
+			report[i] = c.runStep(ctx, s, defaultTimeout)
+		}(i, s)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// runStep выполняет одну функцию закрытия с таймаутом и защитой от паники
+func (c *Closer) runStep(ctx context.Context, s step, defaultTimeout time.Duration) StepReport {
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	stepCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	c.logger.Info(ctx, fmt.Sprintf("🧩 Закрываем %s...", s.name))
+	start := time.Now()
+
+	errCh := make(chan error, 1)
+	go func() {
+		// Защита от паники
+		defer func() {
+			if r := recover(); r != nil {
+				c.logger.Error(ctx, "⚠️ Panic в функции закрытия", zap.String("name", s.name), zap.Any("error", r))
+				errCh <- errors.New("panic recovered in closer")
+			}
+		}()
+
+		errCh <- s.f(stepCtx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-stepCtx.Done():
+		err = fmt.Errorf("timeout after %s: %w", timeout, stepCtx.Err())
+	}
+
+	duration := time.Since(start)
+	if err != nil {
+		c.logger.Error(ctx, fmt.Sprintf("❌ Ошибка при закрытии %s: %v (заняло %s)", s.name, err, duration))
+	} else {
+		c.logger.Info(ctx, fmt.Sprintf("✅ %s успешно закрыт за %s", s.name, duration))
+	}
+
+	return StepReport{Phase: s.phase, Name: s.name, Duration: duration, Err: err}
+}
+
+// logReport логирует итоговый отчёт о завершении работы
+func (c *Closer) logReport(ctx context.Context, report []StepReport, total time.Duration) {
+	failed := 0
+	fields := make([]zap.Field, 0, len(report)+2)
+	for _, r := range report {
+		status := "ok"
+		if r.Err != nil {
+			status = r.Err.Error()
+			failed++
+		}
+		fields = append(fields, zap.String(fmt.Sprintf("%s/%s", r.Phase, r.Name), fmt.Sprintf("%s (%s)", status, r.Duration)))
+	}
+	fields = append(fields, zap.Duration("total", total), zap.Int("failed", failed))
+
+	if failed > 0 {
+		c.logger.Error(ctx, "📋 Отчёт о завершении: есть ошибки", fields...)
+		return
+	}
+
+	c.logger.Info(ctx, "📋 Отчёт о завершении: все ресурсы успешно закрыты", fields...)
+}
+
+func groupByPhase(steps []step) map[Phase][]step {
+	phases := make(map[Phase][]step)
+	for _, s := range steps {
+		phases[s.phase] = append(phases[s.phase], s)
+	}
+	return phases
+}
+
+func sortedPhases(phases map[Phase][]step) []Phase {
+	result := make([]Phase, 0, len(phases))
+	for p := range phases {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/joho/godotenv"
+)
+
+// FileEnv — переменная окружения с путём к YAML-файлу конфигурации.
+const FileEnv = "CONFIG_FILE"
+
+// Loader собирает значения конфигурации из YAML-файла, .env-файлов и переменных окружения
+// и заполняет ими структуры с тегами env (github.com/caarlos0/env).
+// Источники применяются в порядке YAML → .env → окружение: каждый следующий переопределяет предыдущий.
+type Loader struct {
+	environment map[string]string
+	keys        []Key
+}
+
+type Option func(*options)
+
+type options struct {
+	file       string
+	dotEnv     []string
+	environ    []string
+	environSet bool
+}
+
+// WithFile задаёт YAML-файл конфигурации. Пустой путь игнорируется.
+// Вложенные ключи объединяются через "_" и приводятся к верхнему регистру:
+// grpc.host превращается в GRPC_HOST.
+func WithFile(path string) Option {
+	return func(o *options) {
+		o.file = path
+	}
+}
+
+// WithDotEnv задаёт .env-файлы. Отсутствующие файлы пропускаются,
+// при совпадении ключей приоритет у файла, указанного раньше.
+func WithDotEnv(paths ...string) Option {
+	return func(o *options) {
+		o.dotEnv = append(o.dotEnv, paths...)
+	}
+}
+
+// WithEnviron подменяет переменные окружения процесса (формат KEY=VALUE).
+// Используется в тестах и при построении справочника ключей.
+func WithEnviron(environ []string) Option {
+	return func(o *options) {
+		o.environ = environ
+		o.environSet = true
+	}
+}
+
+// New читает все источники конфигурации.
+func New(opts ...Option) (*Loader, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	environment := make(map[string]string)
+
+	if o.file != "" {
+		values, err := readYAML(o.file)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range values {
+			environment[k] = v
+		}
+	}
+
+	dotEnv := make(map[string]string)
+	for _, path := range o.dotEnv {
+		values, err := godotenv.Read(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		for k, v := range values {
+			if _, ok := dotEnv[k]; !ok {
+				dotEnv[k] = v
+			}
+		}
+	}
+	for k, v := range dotEnv {
+		environment[k] = v
+	}
+
+	environ := o.environ
+	if !o.environSet {
+		environ = os.Environ()
+	}
+	for k, v := range env.ToMap(environ) {
+		environment[k] = v
+	}
+
+	return &Loader{environment: environment}, nil
+}
+
+// Parse заполняет структуру dst и проверяет правила из тегов validate.
+// Возвращает все найденные ошибки сразу.
+func (l *Loader) Parse(dst any) error {
+	keys, err := describe(dst)
+	if err != nil {
+		return err
+	}
+	l.keys = append(l.keys, keys...)
+
+	var errs []error
+
+	if err = env.ParseWithOptions(dst, env.Options{Environment: l.environment}); err != nil {
+		var aggregate env.AggregateError
+		if errors.As(err, &aggregate) {
+			errs = append(errs, aggregate.Errors...)
+		} else {
+			errs = append(errs, err)
+		}
+	}
+
+	errs = append(errs, validate(dst)...)
+
+	return errors.Join(errs...)
+}
+
+// Lookup возвращает итоговое значение ключа с учётом всех источников.
+func (l *Loader) Lookup(key string) (string, bool) {
+	value, ok := l.environment[key]
+	return value, ok
+}
+
+// Keys возвращает описание всех ключей, прочитанных через Parse.
+func (l *Loader) Keys() []Key {
+	return l.keys
+}
+
+// Redacted — значение, которым в Effective заменяются секреты.
+const Redacted = "***"
+
+// Effective возвращает итоговые значения всех ключей, прочитанных через Parse.
+// Для ключей без значения подставляется значение по умолчанию, секреты заменяются на Redacted.
+func (l *Loader) Effective() map[string]string {
+	values := make(map[string]string, len(l.keys))
+	for _, key := range l.keys {
+		value, ok := l.environment[key.Name]
+		if !ok {
+			value = key.Default
+		}
+		if key.Secret && value != "" {
+			value = Redacted
+		}
+		values[key.Name] = value
+	}
+
+	return values
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Key описывает один ключ конфигурации.
+type Key struct {
+	Name        string
+	Type        string
+	Default     string
+	Required    bool
+	Rules       string
+	Description string
+	Secret      bool
+}
+
+// WriteReference выводит справочник ключей конфигурации в формате Markdown.
+func WriteReference(w io.Writer, title string, keys []Key) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Конфигурация %s\n\n", title)
+	sb.WriteString("Значения читаются из YAML-файла (`" + FileEnv + "`), .env-файла и переменных окружения; ")
+	sb.WriteString("каждый следующий источник переопределяет предыдущий.\n\n")
+	sb.WriteString("| Ключ | Тип | По умолчанию | Обязательный | Правила | Описание |\n")
+	sb.WriteString("|------|-----|--------------|--------------|---------|----------|\n")
+
+	for _, key := range keys {
+		required := ""
+		if key.Required {
+			required = "да"
+		}
+
+		description := key.Description
+		if key.Secret {
+			description += " (секрет)"
+		}
+
+		fmt.Fprintf(&sb, "| `%s` | %s | %s | %s | %s | %s |\n",
+			key.Name, key.Type, code(key.Default), required, code(key.Rules), description)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func code(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	// Вертикальная черта внутри ячейки ломает таблицу Markdown
+	return "`" + strings.ReplaceAll(s, "|", "\\|") + "`"
+}
+
+// describe строит описание ключей по тегам структуры.
+func describe(dst any) ([]Key, error) {
+	t := reflect.TypeOf(dst)
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("config: destination must be a pointer to struct")
+	}
+	t = t.Elem()
+
+	keys := make([]Key, 0, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		name, opts := parseEnvTag(field.Tag.Get("env"))
+		if name == "" {
+			continue
+		}
+
+		defaultValue, hasDefault := field.Tag.Lookup("envDefault")
+
+		keys = append(keys, Key{
+			Name:        name,
+			Type:        typeName(field.Type),
+			Default:     defaultValue,
+			Required:    !hasDefault && (opts["required"] || opts["notEmpty"]),
+			Rules:       field.Tag.Get("validate"),
+			Description: field.Tag.Get("desc"),
+			Secret:      field.Tag.Get("secret") == "true",
+		})
+	}
+
+	return keys, nil
+}
+
+func parseEnvTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+	opts := make(map[string]bool, len(parts)-1)
+	for _, opt := range parts[1:] {
+		opts[opt] = true
+	}
+
+	return parts[0], opts
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func typeName(t reflect.Type) string {
+	switch {
+	case t == durationType:
+		return "duration"
+	case t.Kind() == reflect.Slice:
+		return "list of " + typeName(t.Elem())
+	case t.Kind() == reflect.Map:
+		return "map of " + typeName(t.Key()) + " to " + typeName(t.Elem())
+	default:
+		return t.Kind().String()
+	}
+}
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// validate проверяет поля структуры по тегу validate. Правила перечисляются через запятую:
+//
+//	min=N, max=N — нижняя и верхняя граница числа, длительности, длины строки или списка;
+//	oneof=a|b|c  — значение из перечисленных;
+//	url          — абсолютный URL со схемой и хостом;
+//	hostport     — адрес вида host:port;
+//	port         — номер порта 1..65535.
+//
+// Для списков oneof, url, hostport и port применяются к каждому элементу.
+// Незаполненные обязательные поля пропускаются: о них уже сообщил парсер.
+func validate(dst any) []error {
+	v := reflect.ValueOf(dst).Elem()
+	t := v.Type()
+
+	var errs []error
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		rules := field.Tag.Get("validate")
+		if rules == "" {
+			continue
+		}
+
+		name, opts := parseEnvTag(field.Tag.Get("env"))
+		value := v.Field(i)
+		if value.IsZero() && (opts["required"] || opts["notEmpty"]) {
+			continue
+		}
+
+		for _, rule := range strings.Split(rules, ",") {
+			if err := checkRule(value, rule); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+func checkRule(value reflect.Value, rule string) error {
+	name, arg, _ := strings.Cut(rule, "=")
+
+	switch name {
+	case "min", "max":
+		return checkBound(value, name, arg)
+	case "oneof", "url", "hostport", "port":
+		if value.Kind() == reflect.Slice {
+			for i := range value.Len() {
+				if err := checkString(value.Index(i).String(), name, arg); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return checkString(value.String(), name, arg)
+	default:
+		return fmt.Errorf("unknown validation rule %q", rule)
+	}
+}
+
+func checkBound(value reflect.Value, rule, arg string) error {
+	var actual, limit float64
+
+	switch {
+	case value.Type() == durationType:
+		d, err := time.ParseDuration(arg)
+		if err != nil {
+			return fmt.Errorf("invalid %s argument %q: %w", rule, arg, err)
+		}
+		actual, limit = float64(value.Int()), float64(d)
+	default:
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s argument %q: %w", rule, arg, err)
+		}
+		limit = n
+
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			actual = float64(value.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			actual = float64(value.Uint())
+		case reflect.Float32, reflect.Float64:
+			actual = value.Float()
+		case reflect.String, reflect.Slice, reflect.Map:
+			actual = float64(value.Len())
+		default:
+			return fmt.Errorf("rule %s is not supported for %s", rule, value.Kind())
+		}
+	}
+
+	if rule == "min" && actual < limit {
+		return fmt.Errorf("must be at least %s", arg)
+	}
+	if rule == "max" && actual > limit {
+		return fmt.Errorf("must be at most %s", arg)
+	}
+
+	return nil
+}
+
+func checkString(s, rule, arg string) error {
+	// Форматные правила не применяются к незаданным необязательным значениям
+	if s == "" && rule != "oneof" {
+		return nil
+	}
+
+	switch rule {
+	case "oneof":
+		allowed := strings.Split(arg, "|")
+		if !slices.Contains(allowed, s) {
+			return fmt.Errorf("must be one of %s, got %q", strings.Join(allowed, ", "), s)
+		}
+	case "url":
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("must be an absolute URL, got %q", s)
+		}
+	case "hostport":
+		if _, port, err := net.SplitHostPort(s); err != nil || checkPort(port) != nil {
+			return fmt.Errorf("must be host:port, got %q", s)
+		}
+	case "port":
+		return checkPort(s)
+	}
+
+	return nil
+}
+
+func checkPort(s string) error {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("must be a port number 1..65535, got %q", s)
+	}
+
+	return nil
+}
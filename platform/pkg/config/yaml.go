@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// readYAML читает YAML-файл и раскладывает его в плоский набор ключей в стиле переменных окружения.
+func readYAML(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // путь задаёт оператор сервиса
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var doc map[string]any
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	flatten("", doc, values)
+
+	return values, nil
+}
+
+func flatten(prefix string, node any, values map[string]string) {
+	switch v := node.(type) {
+	case map[string]any:
+		for k, child := range v {
+			key := strings.ToUpper(k)
+			if prefix != "" {
+				key = prefix + "_" + key
+			}
+			flatten(key, child, values)
+		}
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, fmt.Sprint(item))
+		}
+		values[prefix] = strings.Join(items, ",")
+	case nil:
+		values[prefix] = ""
+	default:
+		values[prefix] = fmt.Sprint(v)
+	}
+}
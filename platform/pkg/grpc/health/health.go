@@ -4,29 +4,102 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
+)
+
+// Имена сервисов в запросах Health v1. Пустое имя соответствует readiness всего сервера.
+const (
+	LivenessService  = "liveness"
+	ReadinessService = "readiness"
 )
 
 // Server implements the gRPC Health Checking Protocol (GRPC Health v1)
 type Server struct {
 	grpc_health_v1.UnimplementedHealthServer
+
+	registry *health.Registry
+}
+
+// NewServer creates a health server backed by the given registry
+func NewServer(registry *health.Registry) *Server {
+	return &Server{registry: registry}
 }
 
 // Check implements the standard grpc health check protocol
 func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	kind, ok := kindByService(req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
 	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+		Status: toServingStatus(s.registry.Report(ctx, kind).Status),
 	}, nil
 }
 
-// Watch implements the standard grpc health check protocol
+// Watch implements the standard grpc health check protocol: it sends the current
+// status and then a new message every time the status changes. For an unknown service
+// it sends SERVICE_UNKNOWN and, as the protocol requires, keeps the call open until the client leaves
 func (s *Server) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
-	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+	kind, ok := kindByService(req.GetService())
+	if !ok {
+		err := stream.Send(&grpc_health_v1.HealthCheckResponse{
+			Status: grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN,
+		})
+		if err != nil {
+			return err
+		}
+
+		<-stream.Context().Done()
+		return status.FromContextError(stream.Context().Err()).Err()
+	}
+
+	updates, unsubscribe := s.registry.Subscribe(stream.Context(), kind)
+	defer unsubscribe()
+
+	var last grpc_health_v1.HealthCheckResponse_ServingStatus = -1
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case st := <-updates:
+			current := toServingStatus(st)
+			if current == last {
+				continue
+			}
+			last = current
+
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 // RegisterService registers the health service with the gRPC server
-func RegisterService(s *grpc.Server) {
-	grpc_health_v1.RegisterHealthServer(s, &Server{})
+func RegisterService(s *grpc.Server, registry *health.Registry) {
+	grpc_health_v1.RegisterHealthServer(s, NewServer(registry))
+}
+
+func kindByService(service string) (health.Kind, bool) {
+	switch service {
+	case "", ReadinessService:
+		return health.Readiness, true
+	case LivenessService:
+		return health.Liveness, true
+	default:
+		return 0, false
+	}
+}
+
+func toServingStatus(st health.Status) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if st == health.StatusUp {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
 }
@@ -0,0 +1,144 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/health"
+)
+
+// newClient поднимает health-сервер на bufconn и возвращает клиент к нему.
+func newClient(t *testing.T, registry *health.Registry) grpc_health_v1.HealthClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	RegisterService(server, registry)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestCheck(t *testing.T) {
+	registry := health.NewRegistry()
+	registry.Register("process", health.Liveness, func(context.Context) error { return nil })
+	registry.Register("mongo", health.Readiness, func(context.Context) error { return errors.New("down") })
+
+	client := newClient(t, registry)
+	ctx := context.Background()
+
+	tests := []struct {
+		service  string
+		expected grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{service: "", expected: grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{service: ReadinessService, expected: grpc_health_v1.HealthCheckResponse_NOT_SERVING},
+		{service: LivenessService, expected: grpc_health_v1.HealthCheckResponse_SERVING},
+	}
+	for _, tt := range tests {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: tt.service})
+		if err != nil {
+			t.Fatalf("check %q: %v", tt.service, err)
+		}
+		if resp.GetStatus() != tt.expected {
+			t.Fatalf("check %q: expected %s, got %s", tt.service, tt.expected, resp.GetStatus())
+		}
+	}
+
+	_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "payment"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for unknown service, got %v", err)
+	}
+}
+
+func TestWatch_StatusChanges(t *testing.T) {
+	var down atomic.Bool
+	registry := health.NewRegistry(health.WithInterval(10 * time.Millisecond))
+	registry.Register("mongo", health.Readiness, func(context.Context) error {
+		if down.Load() {
+			return errors.New("down")
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	registry.Start(ctx)
+
+	stream, err := newClient(t, registry).Watch(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil || resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING first, got %v, %v", resp.GetStatus(), err)
+	}
+
+	down.Store(true)
+	resp, err = stream.Recv()
+	if err != nil || resp.GetStatus() != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING after the check failed, got %v, %v", resp.GetStatus(), err)
+	}
+}
+
+func TestWatch_UnknownServiceKeepsStreamOpen(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := newClient(t, health.NewRegistry()).Watch(ctx, &grpc_health_v1.HealthCheckRequest{Service: "payment"})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil || resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+		t.Fatalf("expected SERVICE_UNKNOWN, got %v, %v", resp.GetStatus(), err)
+	}
+
+	// Сервер не завершает вызов: клиент увидит сервис, если тот появится позже
+	received := make(chan error, 1)
+	go func() {
+		_, err := stream.Recv()
+		received <- err
+	}()
+
+	select {
+	case err := <-received:
+		t.Fatalf("stream ended for unknown service: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case err := <-received:
+		if status.Code(err) != codes.Canceled {
+			t.Fatalf("expected Canceled after the client left, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end after the client left")
+	}
+}
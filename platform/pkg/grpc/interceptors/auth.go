@@ -0,0 +1,49 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthFunc проверяет права на вызов метода. Может обогатить контекст (например, данными пользователя).
+// Ошибка без gRPC-статуса превращается в codes.Unauthenticated.
+type AuthFunc func(ctx context.Context, fullMethod string) (context.Context, error)
+
+// UnaryAuth вызывает AuthFunc перед обработчиком.
+func UnaryAuth(fn AuthFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, fn, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth — stream-вариант UnaryAuth.
+func StreamAuth(fn AuthFunc) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), fn, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, wrapStream(ss, ctx))
+	}
+}
+
+func authenticate(ctx context.Context, fn AuthFunc, method string) (context.Context, error) {
+	newCtx, err := fn(ctx, method)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return newCtx, nil
+}
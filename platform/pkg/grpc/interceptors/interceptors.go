@@ -0,0 +1,90 @@
+package interceptors
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+type Logger interface {
+	Info(ctx context.Context, msg string, fields ...zap.Field)
+	Error(ctx context.Context, msg string, fields ...zap.Field)
+}
+
+// Option — опция набора серверных интерцепторов.
+type Option func(*options)
+
+type options struct {
+	auth   AuthFunc
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+// WithAuth подключает проверку аутентификации.
+func WithAuth(fn AuthFunc) Option {
+	return func(o *options) {
+		o.auth = fn
+	}
+}
+
+// WithUnary добавляет unary-интерцепторы в конец цепочки.
+func WithUnary(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(o *options) {
+		o.unary = append(o.unary, interceptors...)
+	}
+}
+
+// WithStream добавляет stream-интерцепторы в конец цепочки.
+func WithStream(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(o *options) {
+		o.stream = append(o.stream, interceptors...)
+	}
+}
+
+// ServerOptions возвращает опции gRPC сервера с цепочкой интерцепторов:
+// request ID -> логирование -> восстановление после паники -> аутентификация -> дополнительные.
+func ServerOptions(logger Logger, opts ...Option) []grpc.ServerOption {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	unary := []grpc.UnaryServerInterceptor{
+		UnaryRequestID(),
+		UnaryLogging(logger),
+		UnaryRecovery(logger),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		StreamRequestID(),
+		StreamLogging(logger),
+		StreamRecovery(logger),
+	}
+
+	if o.auth != nil {
+		unary = append(unary, UnaryAuth(o.auth))
+		stream = append(stream, StreamAuth(o.auth))
+	}
+
+	unary = append(unary, o.unary...)
+	stream = append(stream, o.stream...)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+}
+
+// serverStream подменяет контекст у grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func wrapStream(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &serverStream{ServerStream: ss, ctx: ctx}
+}
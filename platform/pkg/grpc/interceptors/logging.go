@@ -0,0 +1,51 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryLogging логирует каждый unary-вызов: метод, код ответа и длительность.
+func UnaryLogging(logger Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, logger, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamLogging логирует каждый stream-вызов после его завершения.
+func StreamLogging(logger Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(ss.Context(), logger, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func logCall(ctx context.Context, logger Logger, method string, start time.Time, err error) {
+	fields := []zap.Field{
+		zap.String("grpc.method", method),
+		zap.String("grpc.code", status.Code(err).String()),
+		zap.Duration("duration", time.Since(start)),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+
+	if err != nil {
+		logger.Error(ctx, "gRPC request failed", append(fields, zap.Error(err))...)
+		return
+	}
+
+	logger.Info(ctx, "gRPC request", fields...)
+}
@@ -0,0 +1,47 @@
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryRecovery перехватывает панику в обработчике и возвращает codes.Internal.
+func UnaryRecovery(logger Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ctx, logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery — stream-вариант UnaryRecovery.
+func StreamRecovery(logger Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ss.Context(), logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(ctx context.Context, logger Logger, method string, r any) error {
+	logger.Error(ctx, "gRPC handler panic recovered",
+		zap.String("grpc.method", method),
+		zap.Any("panic", r),
+		zap.ByteString("stack", debug.Stack()),
+	)
+
+	return status.Error(codes.Internal, "internal error")
+}
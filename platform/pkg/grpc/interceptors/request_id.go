@@ -0,0 +1,46 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/logger"
+)
+
+// RequestIDHeader — ключ метаданных с идентификатором запроса.
+const RequestIDHeader = "x-request-id"
+
+// UnaryRequestID берёт request ID из входящих метаданных (или генерирует новый),
+// кладёт его в контекст и возвращает клиенту в заголовках ответа.
+func UnaryRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+// StreamRequestID — stream-вариант UnaryRequestID.
+func StreamRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, wrapStream(ss, withRequestID(ss.Context())))
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	// Ошибка возможна только вне контекста gRPC-вызова
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	return logger.ContextWithRequestID(ctx, requestID)
+}
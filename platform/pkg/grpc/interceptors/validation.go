@@ -0,0 +1,85 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryValidation проверяет входящий запрос по аннотациям buf protovalidate.
+// Невалидный запрос отклоняется с codes.InvalidArgument и деталями BadRequest по каждому полю.
+func UnaryValidation(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validate(validator, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidation проверяет каждое сообщение, полученное от клиента в stream-вызове.
+func StreamValidation(validator protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, validator: validator})
+	}
+}
+
+// WithValidation подключает валидацию запросов к набору интерцепторов.
+func WithValidation(validator protovalidate.Validator) Option {
+	return func(o *options) {
+		o.unary = append(o.unary, UnaryValidation(validator))
+		o.stream = append(o.stream, StreamValidation(validator))
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	validator protovalidate.Validator
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(s.validator, m)
+}
+
+func validate(validator protovalidate.Validator, req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := validator.Validate(msg)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range validationErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       protovalidate.FieldPathString(v.Proto.GetField()),
+			Description: v.Proto.GetMessage(),
+		})
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, "invalid request").WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, validationErr.Error())
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,75 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger — зависимость, поддерживающая Ping (например, *pgxpool.Pool).
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// PingCheck проверяет зависимость через Ping.
+func PingCheck(p Pinger) Check {
+	return p.Ping
+}
+
+// MongoCheck проверяет доступность MongoDB.
+func MongoCheck(client *mongo.Client) Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
+
+// KafkaCheck проверяет доступность Kafka-брокеров запросом метаданных кластера.
+func KafkaCheck(brokers []string, config *sarama.Config) Check {
+	return func(ctx context.Context) error {
+		cfg := *config
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout := time.Until(deadline)
+			cfg.Net.DialTimeout = timeout
+			cfg.Net.ReadTimeout = timeout
+			cfg.Metadata.Retry.Max = 0
+		}
+
+		client, err := sarama.NewClient(brokers, &cfg)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = client.Close()
+		}()
+
+		if len(client.Brokers()) == 0 {
+			return fmt.Errorf("no kafka brokers available")
+		}
+
+		return nil
+	}
+}
+
+// GRPCCheck проверяет downstream-сервис через gRPC Health v1.
+func GRPCCheck(conn *grpc.ClientConn, service string) Check {
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+
+		if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %s", resp.GetStatus())
+		}
+
+		return nil
+	}
+}
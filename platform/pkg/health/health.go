@@ -0,0 +1,181 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCheckTimeout = 2 * time.Second
+	defaultInterval     = 5 * time.Second
+)
+
+// Status — состояние проверки.
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// Kind — вид проверки.
+type Kind int
+
+const (
+	// Liveness — процесс жив и может продолжать работу (без внешних зависимостей).
+	Liveness Kind = iota
+	// Readiness — сервис готов принимать трафик (зависимости доступны).
+	Readiness
+)
+
+// Check — проверка состояния зависимости. Возвращает ошибку, если зависимость недоступна.
+type Check func(ctx context.Context) error
+
+// CheckResult — результат одной проверки.
+type CheckResult struct {
+	Name      string  `json:"name"`
+	Status    Status  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report — сводный результат проверок.
+type Report struct {
+	Status Status        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+type namedCheck struct {
+	name  string
+	kind  Kind
+	check Check
+}
+
+// Option — опция реестра.
+type Option func(*Registry)
+
+// WithCheckTimeout задаёт таймаут одной проверки.
+func WithCheckTimeout(d time.Duration) Option {
+	return func(r *Registry) {
+		r.checkTimeout = d
+	}
+}
+
+// WithInterval задаёт период фоновой проверки готовности для подписчиков Watch.
+func WithInterval(d time.Duration) Option {
+	return func(r *Registry) {
+		r.interval = d
+	}
+}
+
+// Registry — реестр именованных проверок состояния сервиса.
+type Registry struct {
+	mu           sync.RWMutex
+	checks       []namedCheck
+	checkTimeout time.Duration
+	interval     time.Duration
+
+	subMu       sync.Mutex
+	subscribers map[chan Status]Kind
+	last        map[Kind]Status
+}
+
+// NewRegistry создаёт пустой реестр проверок.
+func NewRegistry(opts ...Option) *Registry {
+	r := &Registry{
+		checkTimeout: defaultCheckTimeout,
+		interval:     defaultInterval,
+		subscribers:  make(map[chan Status]Kind),
+		last:         make(map[Kind]Status),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Register добавляет именованную проверку. Liveness-проверки входят и в readiness.
+func (r *Registry) Register(name string, kind Kind, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks = append(r.checks, namedCheck{name: name, kind: kind, check: check})
+}
+
+// Liveness выполняет liveness-проверки.
+func (r *Registry) Liveness(ctx context.Context) Report {
+	return r.run(ctx, Liveness)
+}
+
+// Readiness выполняет все проверки.
+func (r *Registry) Readiness(ctx context.Context) Report {
+	return r.run(ctx, Readiness)
+}
+
+// Report выполняет проверки указанного вида.
+func (r *Registry) Report(ctx context.Context, kind Kind) Report {
+	return r.run(ctx, kind)
+}
+
+func (r *Registry) run(ctx context.Context, kind Kind) Report {
+	r.mu.RLock()
+	checks := make([]namedCheck, 0, len(r.checks))
+	for _, c := range r.checks {
+		if c.kind <= kind {
+			checks = append(checks, c)
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]CheckResult, len(checks))
+	wg := sync.WaitGroup{}
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c namedCheck) {
+			defer wg.Done()
+			results[i] = r.runCheck(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	report := Report{Status: StatusUp, Checks: results}
+	for _, res := range results {
+		if res.Status != StatusUp {
+			report.Status = StatusDown
+			break
+		}
+	}
+
+	return report
+}
+
+func (r *Registry) runCheck(ctx context.Context, c namedCheck) (result CheckResult) {
+	ctx, cancel := context.WithTimeout(ctx, r.checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result = CheckResult{Name: c.name, Status: StatusUp}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			result.Status = StatusDown
+			result.Error = "check panicked"
+		}
+		result.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+	}()
+
+	if err := c.check(ctx); err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
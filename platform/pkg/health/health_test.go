@@ -0,0 +1,88 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReport(t *testing.T) {
+	registry := NewRegistry(WithCheckTimeout(20 * time.Millisecond))
+	registry.Register("process", Liveness, func(context.Context) error { return nil })
+	registry.Register("mongo", Readiness, func(context.Context) error { return errors.New("connection refused") })
+	registry.Register("kafka", Readiness, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	registry.Register("redis", Readiness, func(context.Context) error { panic("boom") })
+
+	// В liveness входят только liveness-проверки, внешние зависимости не учитываются
+	liveness := registry.Liveness(context.Background())
+	if liveness.Status != StatusUp || len(liveness.Checks) != 1 {
+		t.Fatalf("expected liveness up with one check, got %+v", liveness)
+	}
+
+	readiness := registry.Readiness(context.Background())
+	if readiness.Status != StatusDown {
+		t.Fatalf("expected readiness down, got %s", readiness.Status)
+	}
+
+	expected := map[string]struct {
+		status Status
+		err    string
+	}{
+		"kafka":   {StatusDown, context.DeadlineExceeded.Error()},
+		"mongo":   {StatusDown, "connection refused"},
+		"process": {StatusUp, ""},
+		"redis":   {StatusDown, "check panicked"},
+	}
+	if len(readiness.Checks) != len(expected) {
+		t.Fatalf("expected %d checks, got %+v", len(expected), readiness.Checks)
+	}
+	for i, check := range readiness.Checks {
+		if i > 0 && readiness.Checks[i-1].Name > check.Name {
+			t.Fatalf("checks must be sorted by name, got %+v", readiness.Checks)
+		}
+		want := expected[check.Name]
+		if check.Status != want.status || check.Error != want.err {
+			t.Fatalf("check %s: expected %s %q, got %s %q", check.Name, want.status, want.err, check.Status, check.Error)
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	var down atomic.Bool
+	registry := NewRegistry(WithInterval(10 * time.Millisecond))
+	registry.Register("mongo", Readiness, func(context.Context) error {
+		if down.Load() {
+			return errors.New("down")
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	registry.Start(ctx)
+
+	updates, unsubscribe := registry.Subscribe(ctx, Readiness)
+	defer unsubscribe()
+
+	if status := <-updates; status != StatusUp {
+		t.Fatalf("expected current status up, got %s", status)
+	}
+
+	// Первая фоновая проверка может повторить текущий статус, поэтому ждём именно смены
+	down.Store(true)
+	for {
+		select {
+		case status := <-updates:
+			if status == StatusDown {
+				return
+			}
+		case <-ctx.Done():
+			t.Fatal("status change was not delivered")
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LivenessHandler — HTTP-обработчик /healthz.
+func (r *Registry) LivenessHandler() http.HandlerFunc {
+	return r.handler(Liveness)
+}
+
+// ReadinessHandler — HTTP-обработчик /readyz.
+func (r *Registry) ReadinessHandler() http.HandlerFunc {
+	return r.handler(Readiness)
+}
+
+func (r *Registry) handler(kind Kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		report := r.run(req.Context(), kind)
+
+		w.Header().Set("Content-Type", "application/json")
+		if report.Status != StatusUp {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+
+		_ = json.NewEncoder(w).Encode(report)
+	}
+}
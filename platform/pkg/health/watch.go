@@ -0,0 +1,81 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// Subscribe подписывает на изменения статуса проверок указанного вида.
+// Текущий статус отправляется в канал сразу, далее — только при изменении.
+// Возвращаемая функция отменяет подписку.
+func (r *Registry) Subscribe(ctx context.Context, kind Kind) (<-chan Status, func()) {
+	ch := make(chan Status, 1)
+	ch <- r.run(ctx, kind).Status
+
+	r.subMu.Lock()
+	r.subscribers[ch] = kind
+	r.subMu.Unlock()
+
+	return ch, func() {
+		r.subMu.Lock()
+		delete(r.subscribers, ch)
+		r.subMu.Unlock()
+	}
+}
+
+// Start запускает фоновую проверку состояния и рассылку изменений подписчикам до отмены ctx.
+func (r *Registry) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.refresh(ctx)
+			}
+		}
+	}()
+}
+
+func (r *Registry) refresh(ctx context.Context) {
+	for _, kind := range []Kind{Liveness, Readiness} {
+		if !r.hasSubscribers(kind) {
+			continue
+		}
+
+		status := r.run(ctx, kind).Status
+
+		r.subMu.Lock()
+		if r.last[kind] != status {
+			r.last[kind] = status
+			for ch, k := range r.subscribers {
+				if k != kind {
+					continue
+				}
+				// Если подписчик не успел прочитать прошлое значение, заменяем его актуальным
+				select {
+				case <-ch:
+				default:
+				}
+				ch <- status
+			}
+		}
+		r.subMu.Unlock()
+	}
+}
+
+func (r *Registry) hasSubscribers(kind Kind) bool {
+	r.subMu.Lock()
+	defer r.subMu.Unlock()
+
+	for _, k := range r.subscribers {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -19,22 +19,31 @@ type consumer struct {
 	group       sarama.ConsumerGroup
 	topics      []string
 	logger      Logger
+	workers     int
 	middlewares []Middleware
 }
 
 // NewConsumer — создаёт новый consumer.
 func NewConsumer(group sarama.ConsumerGroup, topics []string, logger Logger, middlewares ...Middleware) *consumer {
+	return NewConcurrentConsumer(group, topics, logger, 1, middlewares...)
+}
+
+// NewConcurrentConsumer — создаёт consumer, который обрабатывает сообщения каждой партиции
+// пулом из workers обработчиков. Сообщения с одинаковым ключом обрабатываются строго по порядку,
+// сообщения с разными ключами — параллельно. При workers <= 1 поведение совпадает с NewConsumer.
+func NewConcurrentConsumer(group sarama.ConsumerGroup, topics []string, logger Logger, workers int, middlewares ...Middleware) *consumer {
 	return &consumer{
 		group:       group,
 		topics:      topics,
 		logger:      logger,
+		workers:     workers,
 		middlewares: middlewares,
 	}
 }
 
 // Consume запускает консьюмер для списка топиков.
 func (c *consumer) Consume(ctx context.Context, handler kafka.MessageHandler) error {
-	newGroupHandler := NewGroupHandler(handler, c.logger, c.middlewares...)
+	newGroupHandler := NewConcurrentGroupHandler(handler, c.logger, c.workers, c.middlewares...)
 
 	for {
 		if err := c.group.Consume(ctx, c.topics, newGroupHandler); err != nil {
@@ -14,10 +14,16 @@ type Middleware func(next kafka.MessageHandler) kafka.MessageHandler
 type groupHandler struct {
 	handler kafka.MessageHandler
 	logger  Logger
+	workers int
 }
 
 // NewGroupHandler создаёт новый groupHandler с middleware цепочкой.
 func NewGroupHandler(handler kafka.MessageHandler, logger Logger, middlewares ...Middleware) *groupHandler {
+	return NewConcurrentGroupHandler(handler, logger, 1, middlewares...)
+}
+
+// NewConcurrentGroupHandler создаёт groupHandler, обрабатывающий сообщения партиции пулом из workers обработчиков.
+func NewConcurrentGroupHandler(handler kafka.MessageHandler, logger Logger, workers int, middlewares ...Middleware) *groupHandler {
 	// Применяем middleware цепочку
 	for i := len(middlewares) - 1; i >= 0; i-- {
 		handler = middlewares[i](handler)
@@ -26,6 +32,7 @@ func NewGroupHandler(handler kafka.MessageHandler, logger Logger, middlewares ..
 	return &groupHandler{
 		handler: handler,
 		logger:  logger,
+		workers: workers,
 	}
 }
 
@@ -38,6 +45,10 @@ func (g *groupHandler) Cleanup(sarama.ConsumerGroupSession) error {
 }
 
 func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	if g.workers > 1 {
+		return g.consumeClaimConcurrently(session, claim)
+	}
+
 	for {
 		select {
 		case message, ok := <-claim.Messages():
@@ -46,18 +57,7 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 				return nil
 			}
 
-			msg := kafka.Message{
-				Key:            message.Key,
-				Value:          message.Value,
-				Topic:          message.Topic,
-				Partition:      message.Partition,
-				Offset:         message.Offset,
-				Timestamp:      message.Timestamp,
-				BlockTimestamp: message.BlockTimestamp,
-				Headers:        extractHeaders(message.Headers),
-			}
-
-			if err := g.handler(session.Context(), msg); err != nil {
+			if err := g.handler(session.Context(), toMessage(message)); err != nil {
 				g.logger.Error(session.Context(), "Kafka handler error", zap.Error(err))
 				continue
 			}
@@ -71,6 +71,19 @@ func (g *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 	}
 }
 
+func toMessage(message *sarama.ConsumerMessage) kafka.Message {
+	return kafka.Message{
+		Key:            message.Key,
+		Value:          message.Value,
+		Topic:          message.Topic,
+		Partition:      message.Partition,
+		Offset:         message.Offset,
+		Timestamp:      message.Timestamp,
+		BlockTimestamp: message.BlockTimestamp,
+		Headers:        extractHeaders(message.Headers),
+	}
+}
+
 func extractHeaders(headers []*sarama.RecordHeader) map[string][]byte {
 	result := make(map[string][]byte)
 	for _, h := range headers {
@@ -0,0 +1,129 @@
+package consumer
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+)
+
+// workerQueueSize — размер буфера очереди каждого обработчика.
+const workerQueueSize = 64
+
+// consumeClaimConcurrently распределяет сообщения партиции по пулу обработчиков.
+// Сообщения с одинаковым ключом всегда попадают к одному обработчику и выполняются по порядку.
+// Offset фиксируется только после того, как обработаны все предыдущие сообщения партиции.
+func (g *groupHandler) consumeClaimConcurrently(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	tracker := newOffsetTracker(session)
+
+	queues := make([]chan *sarama.ConsumerMessage, g.workers)
+	wg := sync.WaitGroup{}
+	for i := range queues {
+		queues[i] = make(chan *sarama.ConsumerMessage, workerQueueSize)
+
+		wg.Add(1)
+		go func(queue <-chan *sarama.ConsumerMessage) {
+			defer wg.Done()
+			for message := range queue {
+				if err := g.handler(session.Context(), toMessage(message)); err != nil {
+					g.logger.Error(session.Context(), "Kafka handler error", zap.Error(err))
+				}
+				tracker.done(message)
+			}
+		}(queues[i])
+	}
+
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+		wg.Wait()
+	}()
+
+	next := 0
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				g.logger.Info(session.Context(), "Kafka message channel closed")
+				return nil
+			}
+
+			idx := next
+			if len(message.Key) > 0 {
+				idx = workerIndex(message.Key, g.workers)
+			} else {
+				next = (next + 1) % g.workers
+			}
+
+			tracker.add(message)
+
+			select {
+			case queues[idx] <- message:
+			case <-session.Context().Done():
+				g.logger.Info(session.Context(), "Kafka session context done")
+				return nil
+			}
+
+		case <-session.Context().Done():
+			g.logger.Info(session.Context(), "Kafka session context done")
+			return nil
+		}
+	}
+}
+
+// workerIndex возвращает номер обработчика для ключа сообщения.
+func workerIndex(key []byte, workers int) int {
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	return int(h.Sum32() % uint32(workers)) //nolint:gosec // workers > 1
+}
+
+// offsetTracker следит за завершением сообщений партиции и фиксирует
+// наибольший offset, до которого все сообщения уже обработаны.
+type offsetTracker struct {
+	mu        sync.Mutex
+	session   sarama.ConsumerGroupSession
+	pending   []*sarama.ConsumerMessage
+	completed map[int64]struct{}
+}
+
+func newOffsetTracker(session sarama.ConsumerGroupSession) *offsetTracker {
+	return &offsetTracker{
+		session:   session,
+		completed: make(map[int64]struct{}),
+	}
+}
+
+// add регистрирует сообщение в порядке чтения из партиции.
+func (t *offsetTracker) add(message *sarama.ConsumerMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pending = append(t.pending, message)
+}
+
+// done отмечает сообщение обработанным и фиксирует непрерывный префикс завершённых сообщений.
+func (t *offsetTracker) done(message *sarama.ConsumerMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.completed[message.Offset] = struct{}{}
+
+	var last *sarama.ConsumerMessage
+	for len(t.pending) > 0 {
+		head := t.pending[0]
+		if _, ok := t.completed[head.Offset]; !ok {
+			break
+		}
+
+		delete(t.completed, head.Offset)
+		t.pending = t.pending[1:]
+		last = head
+	}
+
+	if last != nil {
+		t.session.MarkMessage(last, "")
+	}
+}
@@ -0,0 +1,247 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+)
+
+// fakeSession — сессия группы, которая запоминает отмеченные offset'ы.
+type fakeSession struct {
+	ctx    context.Context
+	mu     sync.Mutex
+	marked []int64
+}
+
+func newFakeSession(ctx context.Context) *fakeSession {
+	return &fakeSession{ctx: ctx}
+}
+
+func (s *fakeSession) Claims() map[string][]int32               { return nil }
+func (s *fakeSession) MemberID() string                         { return "member" }
+func (s *fakeSession) GenerationID() int32                      { return 1 }
+func (s *fakeSession) MarkOffset(string, int32, int64, string)  {}
+func (s *fakeSession) Commit()                                  {}
+func (s *fakeSession) ResetOffset(string, int32, int64, string) {}
+func (s *fakeSession) Context() context.Context                 { return s.ctx }
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marked = append(s.marked, msg.Offset)
+}
+
+func (s *fakeSession) markedOffsets() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.marked)
+}
+
+// fakeClaim — партиция, сообщения которой тест передаёт через messages.
+type fakeClaim struct {
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Topic() string                            { return "orders" }
+func (c *fakeClaim) Partition() int32                         { return 0 }
+func (c *fakeClaim) InitialOffset() int64                     { return 0 }
+func (c *fakeClaim) HighWaterMarkOffset() int64               { return 0 }
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+type nopLogger struct{}
+
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+
+func newMessage(offset int64, key string) *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{Topic: "orders", Offset: offset, Key: []byte(key)}
+}
+
+func TestOffsetTracker_MarksContiguousPrefix(t *testing.T) {
+	session := newFakeSession(context.Background())
+	tracker := newOffsetTracker(session)
+
+	messages := make([]*sarama.ConsumerMessage, 4)
+	for i := range messages {
+		messages[i] = newMessage(int64(i), "")
+		tracker.add(messages[i])
+	}
+
+	// Сообщения завершаются не по порядку: offset фиксируется только за непрерывным префиксом
+	tracker.done(messages[2])
+	if marked := session.markedOffsets(); len(marked) != 0 {
+		t.Fatalf("expected nothing marked before offset 0 is done, got %v", marked)
+	}
+
+	tracker.done(messages[0])
+	tracker.done(messages[1])
+	tracker.done(messages[3])
+
+	if marked := session.markedOffsets(); !slices.Equal(marked, []int64{0, 2, 3}) {
+		t.Fatalf("expected marks [0 2 3], got %v", marked)
+	}
+}
+
+func TestWorkerIndex(t *testing.T) {
+	const workers = 4
+
+	used := make(map[int]bool)
+	for i := range 100 {
+		key := []byte(fmt.Sprintf("order-%d", i))
+
+		idx := workerIndex(key, workers)
+		if idx < 0 || idx >= workers {
+			t.Fatalf("worker index %d out of range for key %s", idx, key)
+		}
+		if again := workerIndex(key, workers); again != idx {
+			t.Fatalf("key %s mapped to workers %d and %d", key, idx, again)
+		}
+		used[idx] = true
+	}
+
+	if len(used) != workers {
+		t.Fatalf("expected keys spread over %d workers, got %d", workers, len(used))
+	}
+}
+
+func TestConsumeClaimConcurrently_SameKeyInOrder(t *testing.T) {
+	const (
+		keys     = 5
+		messages = 200
+	)
+
+	var (
+		mu      sync.Mutex
+		seen    = make(map[string][]int64)
+		running = make(map[string]bool)
+	)
+	handler := func(_ context.Context, msg kafka.Message) error {
+		key := string(msg.Key)
+
+		mu.Lock()
+		if running[key] {
+			mu.Unlock()
+			t.Errorf("messages with key %s handled concurrently", key)
+			return nil
+		}
+		running[key] = true
+		mu.Unlock()
+
+		time.Sleep(time.Duration(rand.IntN(200)) * time.Microsecond)
+
+		mu.Lock()
+		running[key] = false
+		seen[key] = append(seen[key], msg.Offset)
+		mu.Unlock()
+
+		return nil
+	}
+
+	session := newFakeSession(context.Background())
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, messages)}
+	for i := range messages {
+		claim.messages <- newMessage(int64(i), fmt.Sprintf("key-%d", i%keys))
+	}
+	close(claim.messages)
+
+	g := NewConcurrentGroupHandler(handler, nopLogger{}, 4)
+	if err := g.consumeClaimConcurrently(session, claim); err != nil {
+		t.Fatalf("consume claim: %v", err)
+	}
+
+	for key, offsets := range seen {
+		if !slices.IsSorted(offsets) {
+			t.Fatalf("messages with key %s handled out of order: %v", key, offsets)
+		}
+	}
+
+	// Закрытие партиции дожидается всех обработчиков, последний offset зафиксирован
+	marked := session.markedOffsets()
+	if len(marked) == 0 || marked[len(marked)-1] != messages-1 {
+		t.Fatalf("expected last marked offset %d, got %v", messages-1, marked)
+	}
+	if !slices.IsSorted(marked) {
+		t.Fatalf("marked offsets must only grow, got %v", marked)
+	}
+}
+
+func TestConsumeClaimConcurrently_ShutdownDrainsQueues(t *testing.T) {
+	const queued = 5
+
+	release := make(chan struct{})
+	started := make(chan struct{}, queued+1)
+
+	var (
+		mu      sync.Mutex
+		handled []int64
+	)
+	handler := func(_ context.Context, msg kafka.Message) error {
+		started <- struct{}{}
+		<-release
+
+		mu.Lock()
+		handled = append(handled, msg.Offset)
+		mu.Unlock()
+
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session := newFakeSession(ctx)
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage)}
+
+	g := NewConcurrentGroupHandler(handler, nopLogger{}, 2)
+	result := make(chan error, 1)
+	go func() { result <- g.consumeClaimConcurrently(session, claim) }()
+
+	// Все сообщения с одним ключом стоят в очереди одного обработчика.
+	// Следующее сообщение принимается только после того, как предыдущее поставлено в очередь
+	for i := range queued {
+		claim.messages <- newMessage(int64(i), "order")
+	}
+	claim.messages <- newMessage(queued, "other")
+	<-started
+
+	cancel()
+
+	select {
+	case <-result:
+		t.Fatal("consume claim returned before the queued messages were handled")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Fatalf("consume claim: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("consume claim did not return after the handlers finished")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := range int64(queued) {
+		if !slices.Contains(handled, i) {
+			t.Fatalf("queued message %d was dropped on shutdown, handled %v", i, handled)
+		}
+	}
+
+	marked := session.markedOffsets()
+	if len(marked) == 0 || marked[len(marked)-1] < queued-1 {
+		t.Fatalf("expected offset %d marked after drain, got %v", queued-1, marked)
+	}
+}
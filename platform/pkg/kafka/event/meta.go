@@ -0,0 +1,77 @@
+package event
+
+import "time"
+
+// Заголовки CloudEvents в binary content mode (Kafka protocol binding).
+// Полезная нагрузка сообщения остаётся «голым» protobuf, а метаданные события передаются в заголовках.
+const (
+	HeaderSpecVersion   = "ce_specversion"
+	HeaderID            = "ce_id"
+	HeaderType          = "ce_type"
+	HeaderSource        = "ce_source"
+	HeaderTime          = "ce_time"
+	HeaderSchemaVersion = "ce_schemaversion"
+	HeaderCorrelationID = "ce_correlationid"
+	HeaderContentType   = "content-type"
+
+	SpecVersion         = "1.0"
+	ProtobufContentType = "application/protobuf"
+)
+
+// Meta — метаданные события.
+type Meta struct {
+	OccurredAt    time.Time
+	ID            string
+	Type          string
+	SchemaVersion string
+	Source        string
+	CorrelationID string
+}
+
+// Headers возвращает метаданные в виде заголовков Kafka-сообщения.
+func (m Meta) Headers() map[string][]byte {
+	headers := map[string][]byte{
+		HeaderSpecVersion: []byte(SpecVersion),
+		HeaderContentType: []byte(ProtobufContentType),
+		HeaderID:          []byte(m.ID),
+		HeaderType:        []byte(m.Type),
+		HeaderSource:      []byte(m.Source),
+	}
+
+	if m.SchemaVersion != "" {
+		headers[HeaderSchemaVersion] = []byte(m.SchemaVersion)
+	}
+	if !m.OccurredAt.IsZero() {
+		headers[HeaderTime] = []byte(m.OccurredAt.UTC().Format(time.RFC3339Nano))
+	}
+	if m.CorrelationID != "" {
+		headers[HeaderCorrelationID] = []byte(m.CorrelationID)
+	}
+
+	return headers
+}
+
+// MetaFromHeaders извлекает метаданные события из заголовков.
+// Возвращает false, если сообщение отправлено без заголовков CloudEvents.
+func MetaFromHeaders(headers map[string][]byte) (Meta, bool) {
+	eventType, ok := headers[HeaderType]
+	if !ok || len(eventType) == 0 {
+		return Meta{}, false
+	}
+
+	meta := Meta{
+		ID:            string(headers[HeaderID]),
+		Type:          string(eventType),
+		SchemaVersion: string(headers[HeaderSchemaVersion]),
+		Source:        string(headers[HeaderSource]),
+		CorrelationID: string(headers[HeaderCorrelationID]),
+	}
+
+	if raw, ok := headers[HeaderTime]; ok {
+		if occurredAt, err := time.Parse(time.RFC3339Nano, string(raw)); err == nil {
+			meta.OccurredAt = occurredAt
+		}
+	}
+
+	return meta, true
+}
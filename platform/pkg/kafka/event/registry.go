@@ -0,0 +1,62 @@
+package event
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+)
+
+// ErrUnknownEvent — для типа и версии события не зарегистрирован декодер.
+var ErrUnknownEvent = errors.New("unknown event type or schema version")
+
+// DecodeFunc — функция декодирования полезной нагрузки конкретной версии события.
+type DecodeFunc[T any] func(data []byte) (T, error)
+
+// Registry — реестр декодеров по типу и версии схемы события.
+// Позволяет нескольким версиям одного события сосуществовать на время миграции.
+type Registry[T any] struct {
+	decoders       map[string]DecodeFunc[T]
+	defaultType    string
+	defaultVersion string
+}
+
+// NewRegistry создаёт реестр. defaultType и defaultVersion используются для сообщений
+// без заголовков CloudEvents, отправленных до появления метаданных.
+func NewRegistry[T any](defaultType, defaultVersion string) *Registry[T] {
+	return &Registry[T]{
+		decoders:       make(map[string]DecodeFunc[T]),
+		defaultType:    defaultType,
+		defaultVersion: defaultVersion,
+	}
+}
+
+// Register регистрирует декодер для типа и версии события.
+func (r *Registry[T]) Register(eventType, version string, decode DecodeFunc[T]) *Registry[T] {
+	r.decoders[registryKey(eventType, version)] = decode
+	return r
+}
+
+// Decode выбирает декодер по заголовкам сообщения и декодирует полезную нагрузку.
+func (r *Registry[T]) Decode(msg kafka.Message) (T, error) {
+	eventType, version := r.defaultType, r.defaultVersion
+	if meta, ok := MetaFromHeaders(msg.Headers); ok {
+		eventType = meta.Type
+		if meta.SchemaVersion != "" {
+			version = meta.SchemaVersion
+		}
+	}
+
+	decode, ok := r.decoders[registryKey(eventType, version)]
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("%w: %s/%s", ErrUnknownEvent, eventType, version)
+	}
+
+	return decode(msg.Value)
+}
+
+func registryKey(eventType, version string) string {
+	return eventType + "/" + version
+}
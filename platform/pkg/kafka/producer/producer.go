@@ -27,10 +27,21 @@ func NewProducer(syncProducer sarama.SyncProducer, topic string, logger Logger)
 }
 
 func (p *producer) Send(ctx context.Context, key, value []byte) error {
+	return p.SendWithHeaders(ctx, key, value, nil)
+}
+
+// SendWithHeaders отправляет сообщение с заголовками.
+func (p *producer) SendWithHeaders(ctx context.Context, key, value []byte, headers map[string][]byte) error {
+	recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+	for k, v := range headers {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{Key: []byte(k), Value: v})
+	}
+
 	partition, offset, err := p.syncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: p.topic,
-		Key:   sarama.ByteEncoder(key),
-		Value: sarama.ByteEncoder(value),
+		Topic:   p.topic,
+		Key:     sarama.ByteEncoder(key),
+		Value:   sarama.ByteEncoder(value),
+		Headers: recordHeaders,
 	})
 	if err != nil {
 		p.logger.Error(ctx, "Failed to send message", zap.Error(err))
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// levelRequest — тело запроса на смену уровня. Пустой module меняет общий уровень,
+// пустой level для модуля возвращает его к общему уровню.
+type levelRequest struct {
+	Module string `json:"module,omitempty"`
+	Level  string `json:"level"`
+}
+
+type levelResponse struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+// LevelHandler возвращает HTTP-обработчик для просмотра (GET) и смены (PUT/POST) уровня логирования.
+//
+//	GET  → {"level":"info","modules":{"kafka":"debug"}}
+//	PUT  {"level":"debug"}                   — общий уровень
+//	PUT  {"module":"kafka","level":"warn"}   — уровень модуля
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var req levelRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request body", http.StatusBadRequest)
+				return
+			}
+
+			if req.Level != "" && !validLevel(req.Level) {
+				http.Error(w, "unknown level: "+req.Level, http.StatusBadRequest)
+				return
+			}
+
+			switch {
+			case req.Module != "":
+				SetModuleLevel(req.Module, req.Level)
+			case req.Level != "":
+				SetLevel(req.Level)
+			default:
+				http.Error(w, "level is required", http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelResponse{
+			Level:   Level(),
+			Modules: ModuleLevels(),
+		})
+	})
+}
+
+func validLevel(levelStr string) bool {
+	switch strings.ToLower(levelStr) {
+	case "debug", "info", "warn", "warning", "error":
+		return true
+	default:
+		return false
+	}
+}
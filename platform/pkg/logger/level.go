@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// moduleLevel — уровень именованного логгера. Пока уровень не задан явно,
+// модуль следует общему уровню.
+type moduleLevel struct {
+	explicit atomic.Bool
+	level    zap.AtomicLevel
+}
+
+var (
+	modulesMu sync.RWMutex
+	modules   = make(map[string]*moduleLevel)
+)
+
+func moduleLevelFor(name string) *moduleLevel {
+	modulesMu.RLock()
+	m, ok := modules[name]
+	modulesMu.RUnlock()
+	if ok {
+		return m
+	}
+
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+
+	if m, ok = modules[name]; ok {
+		return m
+	}
+
+	m = &moduleLevel{level: zap.NewAtomicLevel()}
+	modules[name] = m
+
+	return m
+}
+
+// set задаёт уровень модуля. Пустая строка возвращает модуль к общему уровню.
+func (m *moduleLevel) set(levelStr string) {
+	if levelStr == "" {
+		m.explicit.Store(false)
+		return
+	}
+
+	m.level.SetLevel(parseLevel(levelStr))
+	m.explicit.Store(true)
+}
+
+func (m *moduleLevel) Enabled(l zapcore.Level) bool {
+	if m.explicit.Load() {
+		return m.level.Enabled(l)
+	}
+
+	return dynamicLevel.Enabled(l)
+}
+
+// Named возвращает дочерний логгер модуля со своим уровнем (см. SetModuleLevel).
+// Имя попадает в поле logger каждой записи.
+func Named(name string) *logger {
+	if globalLogger == nil || baseCore == nil {
+		return &logger{zapLogger: zap.NewNop()}
+	}
+
+	core := newStackCore(newLevelCore(baseCore, moduleLevelFor(name)), sampler, namedCallerSkip)
+
+	return &logger{
+		zapLogger: zap.New(core, zap.AddCaller(), zap.AddCallerSkip(namedCallerSkip)).Named(name),
+	}
+}
+
+// SetModuleLevel меняет уровень именованного логгера на лету.
+// Пустой уровень возвращает модуль к общему уровню.
+func SetModuleLevel(name, levelStr string) {
+	moduleLevelFor(name).set(levelStr)
+}
+
+// ModuleLevels возвращает явно заданные уровни модулей.
+func ModuleLevels() map[string]string {
+	modulesMu.RLock()
+	defer modulesMu.RUnlock()
+
+	levels := make(map[string]string, len(modules))
+	for name, m := range modules {
+		if m.explicit.Load() {
+			levels[name] = m.level.Level().String()
+		}
+	}
+
+	return levels
+}
+
+// levelCore фильтрует записи базового ядра по собственному уровню.
+type levelCore struct {
+	zapcore.Core
+	level zapcore.LevelEnabler
+}
+
+func newLevelCore(core zapcore.Core, level zapcore.LevelEnabler) zapcore.Core {
+	return &levelCore{Core: core, level: level}
+}
+
+func (c *levelCore) Enabled(l zapcore.Level) bool {
+	return c.level.Enabled(l)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+
+	return ce
+}
@@ -2,7 +2,6 @@ package logger
 
 import (
 	"context"
-	"os"
 	"strings"
 	"sync"
 
@@ -13,15 +12,28 @@ import (
 type Key string
 
 const (
-	traceIDKey Key = "trace_id"
-	userIDKey  Key = "user_id"
+	traceIDKey   Key = "trace_id"
+	userIDKey    Key = "user_id"
+	requestIDKey Key = "request_id"
+)
+
+// Caller skip логгеров пакета: корневой вызывается через функцию пакета и метод logger.<Level>,
+// именованный — только через метод
+const (
+	rootCallerSkip  = 2
+	namedCallerSkip = 1
 )
 
 // Глобальный singleton логгер
 var (
 	globalLogger *logger
 	initOnce     sync.Once
+	initErr      error
 	dynamicLevel zap.AtomicLevel
+	// baseCore — все синки без фильтра по уровню; уровень применяется поверх него,
+	// чтобы корневой и именованные логгеры могли иметь разные уровни
+	baseCore zapcore.Core
+	sampler  *stackSampler
 )
 
 // logger обёртка над zap.Logger с enrich поддержкой контекста
@@ -30,9 +42,21 @@ type logger struct {
 }
 
 // Init инициализирует глобальный логгер.
-func Init(levelStr string, asJSON bool) error {
+// Без опций логи пишутся в stdout, уровень модулей совпадает с общим.
+func Init(levelStr string, asJSON bool, opts ...Option) error {
 	initOnce.Do(func() {
+		o := &options{
+			outputs:        []string{OutputStdout},
+			stacktraceRate: defaultStacktraceRate,
+		}
+		for _, opt := range opts {
+			opt(o)
+		}
+
 		dynamicLevel = zap.NewAtomicLevelAt(parseLevel(levelStr))
+		for module, level := range o.moduleLevels {
+			moduleLevelFor(module).set(level)
+		}
 
 		encoderCfg := buildProductionEncoderConfig()
 
@@ -43,20 +67,28 @@ func Init(levelStr string, asJSON bool) error {
 			encoder = zapcore.NewConsoleEncoder(encoderCfg)
 		}
 
-		core := zapcore.NewCore(
-			encoder,
-			zapcore.AddSync(os.Stdout),
-			dynamicLevel,
-		)
+		cores, err := buildSinks(o, encoder)
+		if err != nil {
+			initErr = err
+			return
+		}
 
-		zapLogger := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(2))
+		baseCore = zapcore.NewTee(cores...)
+		sampler = newStackSampler(o.stacktraceRate)
 
 		globalLogger = &logger{
-			zapLogger: zapLogger,
+			zapLogger: newRootLogger(),
 		}
 	})
 
-	return nil
+	return initErr
+}
+
+// newRootLogger собирает корневой zap-логгер поверх baseCore с общим уровнем
+func newRootLogger() *zap.Logger {
+	core := newStackCore(newLevelCore(baseCore, dynamicLevel), sampler, rootCallerSkip)
+
+	return zap.New(core, zap.AddCaller(), zap.AddCallerSkip(rootCallerSkip))
 }
 
 func buildProductionEncoderConfig() zapcore.EncoderConfig {
@@ -76,7 +108,7 @@ func buildProductionEncoderConfig() zapcore.EncoderConfig {
 	}
 }
 
-// SetLevel динамически меняет уровень логирования
+// SetLevel динамически меняет общий уровень логирования
 func SetLevel(levelStr string) {
 	if dynamicLevel == (zap.AtomicLevel{}) {
 		return
@@ -85,6 +117,15 @@ func SetLevel(levelStr string) {
 	dynamicLevel.SetLevel(parseLevel(levelStr))
 }
 
+// Level возвращает текущий общий уровень логирования
+func Level() string {
+	if dynamicLevel == (zap.AtomicLevel{}) {
+		return ""
+	}
+
+	return dynamicLevel.Level().String()
+}
+
 func InitForBenchmark() {
 	core := zapcore.NewNopCore()
 
@@ -115,6 +156,14 @@ func Sync() error {
 	return nil
 }
 
+// Shutdown сбрасывает буферы и закрывает синки (файл, OTLP-экспортер)
+func Shutdown(ctx context.Context) error {
+	// Ошибку Sync не возвращаем: для stdout/stderr она типична и ничего не значит
+	_ = Sync()
+
+	return closeSinks(ctx)
+}
+
 // With создает новый enrich-aware логгер с дополнительными полями
 func With(fields ...zap.Field) *logger {
 	if globalLogger == nil {
@@ -205,6 +254,17 @@ func parseLevel(levelStr string) zapcore.Level {
 	}
 }
 
+// ContextWithRequestID возвращает контекст с request ID, который попадает во все записи лога
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext возвращает request ID из контекста
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
 // fieldsFromContext вытаскивает enrich-поля из контекста
 func fieldsFromContext(ctx context.Context) []zap.Field {
 	fields := make([]zap.Field, 0)
@@ -217,5 +277,9 @@ func fieldsFromContext(ctx context.Context) []zap.Field {
 		fields = append(fields, zap.String(string(userIDKey), userID))
 	}
 
+	if requestID, ok := ctx.Value(requestIDKey).(string); ok && requestID != "" {
+		fields = append(fields, zap.String(string(requestIDKey), requestID))
+	}
+
 	return fields
 }
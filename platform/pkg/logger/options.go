@@ -0,0 +1,75 @@
+package logger
+
+// Названия выходов логов для WithOutputs.
+const (
+	OutputStdout = "stdout"
+	OutputFile   = "file"
+	OutputOTLP   = "otlp"
+)
+
+// defaultStacktraceRate — сколько стектрейсов в секунду прикладывается к ошибкам по умолчанию.
+const defaultStacktraceRate = 10
+
+type Option func(*options)
+
+type options struct {
+	serviceName    string
+	outputs        []string
+	file           FileConfig
+	otlpEndpoint   string
+	moduleLevels   map[string]string
+	stacktraceRate int
+}
+
+// FileConfig описывает файловый выход с ротацией по размеру.
+type FileConfig struct {
+	Path       string
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+}
+
+// WithServiceName задаёт имя сервиса для OTLP-ресурса.
+func WithServiceName(name string) Option {
+	return func(o *options) {
+		o.serviceName = name
+	}
+}
+
+// WithOutputs выбирает выходы логов: stdout, file, otlp. Пустой список означает stdout.
+func WithOutputs(outputs ...string) Option {
+	return func(o *options) {
+		if len(outputs) > 0 {
+			o.outputs = outputs
+		}
+	}
+}
+
+// WithFile настраивает файловый выход.
+func WithFile(cfg FileConfig) Option {
+	return func(o *options) {
+		o.file = cfg
+	}
+}
+
+// WithOTLP задаёт адрес OTLP gRPC-коллектора для выхода otlp.
+func WithOTLP(endpoint string) Option {
+	return func(o *options) {
+		o.otlpEndpoint = endpoint
+	}
+}
+
+// WithModuleLevels задаёт начальные уровни именованных логгеров, например {"kafka": "debug"}.
+func WithModuleLevels(levels map[string]string) Option {
+	return func(o *options) {
+		o.moduleLevels = levels
+	}
+}
+
+// WithStacktraceRate ограничивает число стектрейсов, прикладываемых к записям уровня error
+// и выше, до rate в секунду. Ноль отключает стектрейсы.
+func WithStacktraceRate(rate int) Option {
+	return func(o *options) {
+		o.stacktraceRate = rate
+	}
+}
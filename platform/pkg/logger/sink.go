@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/contrib/bridges/otelzap"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var (
+	closersMu sync.Mutex
+	closers   []func(ctx context.Context) error
+)
+
+// buildSinks создаёт ядра для выбранных выходов. Фильтр по уровню на них не ставится —
+// его применяют корневой и именованные логгеры.
+func buildSinks(o *options, encoder zapcore.Encoder) ([]zapcore.Core, error) {
+	cores := make([]zapcore.Core, 0, len(o.outputs))
+
+	for _, output := range o.outputs {
+		switch output {
+		case OutputStdout:
+			cores = append(cores, zapcore.NewCore(encoder, zapcore.AddSync(os.Stdout), zapcore.DebugLevel))
+
+		case OutputFile:
+			if o.file.Path == "" {
+				return nil, errors.New("logger: file output requires a path")
+			}
+
+			writer := &lumberjack.Logger{
+				Filename:   o.file.Path,
+				MaxSize:    o.file.MaxSizeMB,
+				MaxBackups: o.file.MaxBackups,
+				MaxAge:     o.file.MaxAgeDays,
+			}
+			addCloser(func(context.Context) error { return writer.Close() })
+
+			cores = append(cores, zapcore.NewCore(encoder.Clone(), zapcore.AddSync(writer), zapcore.DebugLevel))
+
+		case OutputOTLP:
+			core, err := otlpCore(o)
+			if err != nil {
+				return nil, err
+			}
+
+			cores = append(cores, core)
+
+		default:
+			return nil, fmt.Errorf("logger: unknown output %q", output)
+		}
+	}
+
+	return cores, nil
+}
+
+func otlpCore(o *options) (zapcore.Core, error) {
+	if o.otlpEndpoint == "" {
+		return nil, errors.New("logger: otlp output requires an endpoint")
+	}
+
+	exporter, err := otlploggrpc.New(
+		context.Background(),
+		otlploggrpc.WithEndpoint(o.otlpEndpoint),
+		otlploggrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("logger: failed to create otlp exporter: %w", err)
+	}
+
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithResource(resource.NewSchemaless(attribute.String("service.name", o.serviceName))),
+	)
+	addCloser(provider.Shutdown)
+
+	return otelzap.NewCore(o.serviceName, otelzap.WithLoggerProvider(provider)), nil
+}
+
+func addCloser(f func(ctx context.Context) error) {
+	closersMu.Lock()
+	defer closersMu.Unlock()
+
+	closers = append(closers, f)
+}
+
+func closeSinks(ctx context.Context) error {
+	closersMu.Lock()
+	defer closersMu.Unlock()
+
+	var errs []error
+	for _, f := range closers {
+		errs = append(errs, f(ctx))
+	}
+	closers = nil
+
+	return errors.Join(errs...)
+}
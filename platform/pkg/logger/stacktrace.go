@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// zapFrames — кадры zap над обёртками логгера: stackCore.Write, CheckedEntry.Write и zap.Logger.<Level>.
+// Стектрейс пропускает их и столько же кадров обёрток, сколько caller skip логгера,
+// поэтому начинается с того же места, что и поле caller.
+const zapFrames = 3
+
+// stackSampler пропускает не больше rate стектрейсов в секунду,
+// чтобы поток однотипных ошибок не раздувал логи.
+type stackSampler struct {
+	rate int
+
+	mu     sync.Mutex
+	window int64
+	count  int
+}
+
+func newStackSampler(rate int) *stackSampler {
+	return &stackSampler{rate: rate}
+}
+
+func (s *stackSampler) allow(t time.Time) bool {
+	if s == nil || s.rate <= 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sec := t.Unix(); sec != s.window {
+		s.window = sec
+		s.count = 0
+	}
+
+	s.count++
+
+	return s.count <= s.rate
+}
+
+// stackCore прикладывает стектрейс к записям уровня error и выше с учётом сэмплирования.
+type stackCore struct {
+	zapcore.Core
+	sampler *stackSampler
+	skip    int
+}
+
+// newStackCore создаёт ядро для логгера с заданным caller skip.
+func newStackCore(core zapcore.Core, sampler *stackSampler, callerSkip int) zapcore.Core {
+	return &stackCore{Core: core, sampler: sampler, skip: zapFrames + callerSkip}
+}
+
+func (c *stackCore) With(fields []zapcore.Field) zapcore.Core {
+	return &stackCore{Core: c.Core.With(fields), sampler: c.sampler, skip: c.skip}
+}
+
+func (c *stackCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+
+	return ce
+}
+
+func (c *stackCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if ent.Level >= zapcore.ErrorLevel && ent.Stack == "" && c.sampler.allow(ent.Time) {
+		fields = append(fields, zap.StackSkip("stacktrace", c.skip))
+	}
+
+	return c.Core.Write(ent, fields)
+}
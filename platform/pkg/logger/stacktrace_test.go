@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// useBufferLogger подменяет ядро пакета на JSON в буфер и возвращает буфер.
+func useBufferLogger(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	prevLogger, prevCore, prevSampler, prevLevel := globalLogger, baseCore, sampler, dynamicLevel
+	t.Cleanup(func() {
+		globalLogger, baseCore, sampler, dynamicLevel = prevLogger, prevCore, prevSampler, prevLevel
+	})
+
+	buf := &bytes.Buffer{}
+	baseCore = zapcore.NewCore(zapcore.NewJSONEncoder(buildProductionEncoderConfig()), zapcore.AddSync(buf), zapcore.DebugLevel)
+	sampler = newStackSampler(100)
+	dynamicLevel = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+	globalLogger = &logger{zapLogger: newRootLogger()}
+
+	return buf
+}
+
+// topFrame возвращает функцию верхнего кадра стектрейса единственной записи в буфере.
+func topFrame(t *testing.T, buf *bytes.Buffer) string {
+	t.Helper()
+
+	var entry struct {
+		Stacktrace string `json:"stacktrace"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("decode log entry %q: %v", buf.String(), err)
+	}
+	if entry.Stacktrace == "" {
+		t.Fatalf("log entry has no stacktrace: %s", buf.String())
+	}
+
+	frame, _, _ := strings.Cut(entry.Stacktrace, "\n")
+	return frame
+}
+
+func TestStacktrace_StartsAtCaller(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      func()
+		expected string
+	}{
+		{
+			name:     "Корневой логгер",
+			log:      func() { Error(context.Background(), "failed") },
+			expected: "logger.TestStacktrace_StartsAtCaller.func1",
+		},
+		{
+			name:     "Именованный логгер",
+			log:      func() { Named("orders").Error(context.Background(), "failed") },
+			expected: "logger.TestStacktrace_StartsAtCaller.func2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := useBufferLogger(t)
+
+			tt.log()
+
+			if frame := topFrame(t, buf); !strings.HasSuffix(frame, tt.expected) {
+				t.Fatalf("expected stacktrace to start at %s, got %s", tt.expected, frame)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 type Logger interface {
 	Info(ctx context.Context, msg string, fields ...zap.Field)
+	Error(ctx context.Context, msg string, fields ...zap.Field)
 }
 
 func Logging(logger Logger) consumer.Middleware {
@@ -0,0 +1,47 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/consumer"
+)
+
+// PanicError — ошибка, в которую превращается паника обработчика сообщения.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("kafka handler panic: %v", e.Value)
+}
+
+// Recover перехватывает панику в обработчике и возвращает её как *PanicError со стеком вызовов.
+// Должен стоять первым в цепочке, чтобы покрывать остальные middleware.
+// Паники внутри Timeout перехватываются самим Timeout и тоже возвращаются как *PanicError.
+func Recover(logger Logger) consumer.Middleware {
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					panicErr := &PanicError{Value: r, Stack: debug.Stack()}
+					logger.Error(ctx, "Kafka handler panic recovered",
+						zap.String("topic", msg.Topic),
+						zap.Int32("partition", msg.Partition),
+						zap.Int64("offset", msg.Offset),
+						zap.Any("panic", r),
+						zap.ByteString("stack", panicErr.Stack),
+					)
+					err = panicErr
+				}
+			}()
+
+			return next(ctx, msg)
+		}
+	}
+}
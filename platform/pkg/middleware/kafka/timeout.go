@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/closer"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka/consumer"
+)
+
+// TimeoutOption — опция middleware Timeout.
+type TimeoutOption func(*timeoutConfig)
+
+type timeoutConfig struct {
+	topics map[string]time.Duration
+	grace  time.Duration
+}
+
+// WithTopicTimeout задаёт отдельный дедлайн обработки для топика.
+func WithTopicTimeout(topic string, d time.Duration) TimeoutOption {
+	return func(cfg *timeoutConfig) {
+		cfg.topics[topic] = d
+	}
+}
+
+// WithGracePeriod задаёт, сколько ждать завершения обработчика после отмены его контекста.
+// По умолчанию — общий таймаут завершения приложения (closer.ShutdownTimeout).
+func WithGracePeriod(d time.Duration) TimeoutOption {
+	return func(cfg *timeoutConfig) {
+		cfg.grace = d
+	}
+}
+
+// Timeout ограничивает время обработки сообщения дедлайном d (или дедлайном топика).
+// Контекст обработчика отменяется по истечении дедлайна, после чего middleware ждёт, пока
+// обработчик вернёт управление: иначе consumer с пулом обработчиков (NewConcurrentConsumer)
+// начал бы следующее сообщение того же ключа параллельно с незавершённым и зафиксировал offset.
+// Ожидание ограничено периодом WithGracePeriod; обработчик, который не реагирует на отмену
+// дольше него, продолжит работу в фоне и порядок по ключу не гарантируется. Поэтому обработчики
+// под этим middleware должны завершаться по отмене контекста. Значение d <= 0 отключает ограничение.
+func Timeout(d time.Duration, opts ...TimeoutOption) consumer.Middleware {
+	cfg := &timeoutConfig{topics: make(map[string]time.Duration)}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(next kafka.MessageHandler) kafka.MessageHandler {
+		return func(ctx context.Context, msg kafka.Message) error {
+			timeout := d
+			if topicTimeout, ok := cfg.topics[msg.Topic]; ok {
+				timeout = topicTimeout
+			}
+			if timeout <= 0 {
+				return next(ctx, msg)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			done := make(chan error, 1)
+			go func() {
+				// Паника в отдельной горутине не перехватывается внешним Recover
+				defer func() {
+					if r := recover(); r != nil {
+						done <- &PanicError{Value: r, Stack: debug.Stack()}
+					}
+				}()
+
+				done <- next(ctx, msg)
+			}()
+
+			select {
+			case err := <-done:
+				return err
+			case <-ctx.Done():
+			}
+
+			grace := cfg.grace
+			if grace <= 0 {
+				grace = closer.ShutdownTimeout()
+			}
+			timer := time.NewTimer(grace)
+			defer timer.Stop()
+
+			select {
+			case err := <-done:
+				// Обработчик успел завершиться после отмены: результат его работы и есть результат сообщения
+				if err != nil {
+					return fmt.Errorf("kafka handler timeout after %s (topic %s, offset %d): %w", timeout, msg.Topic, msg.Offset, err)
+				}
+				return nil
+			case <-timer.C:
+				return fmt.Errorf("kafka handler did not stop within %s after timeout %s (topic %s, offset %d): %w",
+					grace, timeout, msg.Topic, msg.Offset, ctx.Err())
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/kafka"
+)
+
+func TestTimeout_WaitsForHandlerAfterCancel(t *testing.T) {
+	var finished atomic.Bool
+	handler := Timeout(10*time.Millisecond, WithGracePeriod(time.Second))(func(ctx context.Context, _ kafka.Message) error {
+		<-ctx.Done()
+		// Обработчик освобождает ресурсы уже после отмены
+		time.Sleep(20 * time.Millisecond)
+		finished.Store(true)
+		return ctx.Err()
+	})
+
+	err := handler(context.Background(), kafka.Message{Topic: "orders"})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	// Следующее сообщение партиции не должно начаться, пока этот обработчик работает
+	if !finished.Load() {
+		t.Fatal("middleware returned before the handler finished")
+	}
+}
+
+func TestTimeout_HandlerFinishesAfterCancel(t *testing.T) {
+	handler := Timeout(10*time.Millisecond, WithGracePeriod(time.Second))(func(ctx context.Context, _ kafka.Message) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	if err := handler(context.Background(), kafka.Message{Topic: "orders"}); err != nil {
+		t.Fatalf("expected handler result, got %v", err)
+	}
+}
+
+func TestTimeout_GracePeriodExpires(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	handler := Timeout(10*time.Millisecond, WithGracePeriod(20*time.Millisecond))(func(context.Context, kafka.Message) error {
+		// Обработчик не реагирует на отмену контекста
+		<-release
+		return nil
+	})
+
+	start := time.Now()
+	err := handler(context.Background(), kafka.Message{Topic: "orders"})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("expected return after timeout and grace period, got %s", elapsed)
+	}
+}
+
+func TestTimeout_TopicTimeout(t *testing.T) {
+	handler := Timeout(time.Hour, WithTopicTimeout("orders", 10*time.Millisecond))(func(ctx context.Context, _ kafka.Message) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if err := handler(context.Background(), kafka.Message{Topic: "orders"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
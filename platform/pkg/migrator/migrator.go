@@ -0,0 +1,266 @@
+// Package migrator применяет версионированные миграции MongoDB, написанные на Go.
+// Применённые версии записываются в коллекцию schema_migrations — так же,
+// как goose ведёт таблицу goose_db_version для PostgreSQL.
+package migrator
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CollectionName — коллекция с журналом применённых миграций
+const CollectionName = "schema_migrations"
+
+const (
+	// lockID — документ журнала, который держит экземпляр, применяющий миграции
+	lockID = "lock"
+	// lockTTL — через это время без продления блокировка считается брошенной
+	lockTTL = 10 * time.Minute
+	// lockRefreshInterval — как часто владелец продлевает блокировку, пока идут миграции
+	lockRefreshInterval = lockTTL / 4
+	// lockPollInterval — как часто ждущий экземпляр проверяет блокировку
+	lockPollInterval = time.Second
+)
+
+// Migration — шаг схемы. Up должен быть идемпотентным: если экземпляр упадёт
+// после Up, но до записи в журнал, миграция выполнится повторно.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+}
+
+// Status — состояние миграции; AppliedAt нулевой, если миграция ещё не применена
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+// record — запись журнала schema_migrations
+type record struct {
+	Version   int64     `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// errLockLost — блокировку перехватил другой экземпляр, миграции останавливаются
+var errLockLost = errors.New("migration lock lost")
+
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+
+	lockTTL             time.Duration
+	lockRefreshInterval time.Duration
+	lockPollInterval    time.Duration
+}
+
+// New проверяет список миграций: версии должны быть положительными и уникальными
+func New(db *mongo.Database, migrations []Migration) (*Migrator, error) {
+	sorted := slices.Clone(migrations)
+	slices.SortFunc(sorted, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	for i, migration := range sorted {
+		if migration.Version <= 0 {
+			return nil, fmt.Errorf("migration %q: version must be positive", migration.Name)
+		}
+		if migration.Up == nil {
+			return nil, fmt.Errorf("migration %d: Up is not set", migration.Version)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("migration %d: duplicate version", migration.Version)
+		}
+	}
+
+	return &Migrator{
+		db:                  db,
+		migrations:          sorted,
+		lockTTL:             lockTTL,
+		lockRefreshInterval: lockRefreshInterval,
+		lockPollInterval:    lockPollInterval,
+	}, nil
+}
+
+// Up применяет неприменённые миграции по возрастанию версий и возвращает применённые.
+// Несколько экземпляров сервиса не выполняют миграции одновременно: остальные ждут блокировку.
+// Пока миграции идут, блокировка продлевается; если её всё же перехватили, Up прерывается.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	owner := uuid.NewString()
+	if err := m.lock(ctx, owner); err != nil {
+		return nil, err
+	}
+	defer m.unlock(owner)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	refreshDone := make(chan struct{})
+	defer func() { <-refreshDone }()
+	go func() {
+		defer close(refreshDone)
+		m.keepLocked(ctx, owner, cancel)
+	}()
+	defer cancel(nil)
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		if err = migration.Up(ctx, m.db); err != nil {
+			return done, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, lockError(ctx, err))
+		}
+
+		_, err = m.collection().InsertOne(ctx, record{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %d_%s: failed to record: %w", migration.Version, migration.Name, lockError(ctx, err))
+		}
+
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Status возвращает состояние всех известных миграций по возрастанию версий
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		statuses = append(statuses, Status{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: applied[migration.Version],
+		})
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) collection() *mongo.Collection {
+	return m.db.Collection(CollectionName)
+}
+
+// applied читает журнал: версия → время применения
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	cursor, err := m.collection().Find(ctx, bson.M{"_id": bson.M{"$type": "long"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", CollectionName, err)
+	}
+
+	var records []record
+	if err = cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", CollectionName, err)
+	}
+
+	applied := make(map[int64]time.Time, len(records))
+	for _, r := range records {
+		applied[r.Version] = r.AppliedAt
+	}
+
+	return applied, nil
+}
+
+// lock захватывает блокировку журнала для owner, перехватывая брошенную по истечении lockTTL
+func (m *Migrator) lock(ctx context.Context, owner string) error {
+	ticker := time.NewTicker(m.lockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now().UTC()
+
+		_, err := m.collection().InsertOne(ctx, bson.M{"_id": lockID, "owner": owner, "locked_at": now})
+		if err == nil {
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to lock %s: %w", CollectionName, err)
+		}
+
+		result, err := m.collection().UpdateOne(ctx,
+			bson.M{"_id": lockID, "locked_at": bson.M{"$lt": now.Add(-m.lockTTL)}},
+			bson.M{"$set": bson.M{"owner": owner, "locked_at": now}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to lock %s: %w", CollectionName, err)
+		}
+		if result.ModifiedCount == 1 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to lock %s: %w", CollectionName, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// keepLocked продлевает блокировку owner до отмены ctx. Если блокировка уже
+// принадлежит другому экземпляру, отменяет миграции с причиной errLockLost
+func (m *Migrator) keepLocked(ctx context.Context, owner string, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(m.lockRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result, err := m.collection().UpdateOne(ctx,
+			bson.M{"_id": lockID, "owner": owner},
+			bson.M{"$set": bson.M{"locked_at": time.Now().UTC()}},
+		)
+		// Временную ошибку переживаем до следующей попытки: запас до lockTTL большой
+		if err != nil {
+			continue
+		}
+		if result.MatchedCount == 0 {
+			cancel(errLockLost)
+			return
+		}
+	}
+}
+
+// lockError сообщает о потере блокировки вместо отмены контекста, которую она вызвала
+func lockError(ctx context.Context, err error) error {
+	if cause := context.Cause(ctx); errors.Is(cause, errLockLost) {
+		return fmt.Errorf("%w: %v", errLockLost, err)
+	}
+
+	return err
+}
+
+// unlock снимает блокировку owner даже после отмены контекста Up.
+// Чужую блокировку, перехваченную после lockTTL, не трогает
+func (m *Migrator) unlock(owner string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, _ = m.collection().DeleteOne(ctx, bson.M{"_id": lockID, "owner": owner})
+}
@@ -0,0 +1,278 @@
+//go:build integration
+
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	tcmongo "github.com/kont1n/MSA_Rocket_Factory/platform/pkg/testcontainers/mongo"
+	"github.com/kont1n/MSA_Rocket_Factory/platform/pkg/testcontainers/network"
+)
+
+var client *mongo.Client
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	net, err := network.NewNetwork(ctx, "migrator")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	container, err := tcmongo.NewContainer(ctx,
+		tcmongo.WithNetworkName(net.Name()),
+		tcmongo.WithContainerName("migrator-mongo"),
+	)
+	if err != nil {
+		_ = net.Remove(ctx)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	client = container.Client()
+
+	code := m.Run()
+
+	_ = container.Terminate(ctx)
+	_ = net.Remove(ctx)
+	os.Exit(code)
+}
+
+// newDatabase возвращает пустую базу, отдельную для каждого теста
+func newDatabase(t *testing.T) *mongo.Database {
+	t.Helper()
+
+	db := client.Database(strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	t.Cleanup(func() { _ = db.Drop(context.Background()) })
+
+	return db
+}
+
+// newMigrator создаёт мигратор с короткими интервалами блокировки
+func newMigrator(t *testing.T, db *mongo.Database, migrations ...Migration) *Migrator {
+	t.Helper()
+
+	m, err := New(db, migrations)
+	if err != nil {
+		t.Fatalf("new migrator: %v", err)
+	}
+	m.lockTTL = time.Second
+	m.lockRefreshInterval = 20 * time.Millisecond
+	m.lockPollInterval = 10 * time.Millisecond
+
+	return m
+}
+
+// readLock возвращает документ блокировки; ok = false, если блокировки нет
+func readLock(t *testing.T, db *mongo.Database) (lock bson.M, ok bool) {
+	t.Helper()
+
+	err := db.Collection(CollectionName).FindOne(context.Background(), bson.M{"_id": lockID}).Decode(&lock)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, false
+	}
+	if err != nil {
+		t.Fatalf("read lock: %v", err)
+	}
+
+	return lock, true
+}
+
+func noop(context.Context, *mongo.Database) error { return nil }
+
+func versions(migrations []Migration) []int64 {
+	result := make([]int64, 0, len(migrations))
+	for _, migration := range migrations {
+		result = append(result, migration.Version)
+	}
+
+	return result
+}
+
+func TestUp_Journal(t *testing.T) {
+	ctx := context.Background()
+	db := newDatabase(t)
+
+	var calls atomic.Int32
+	count := func(context.Context, *mongo.Database) error {
+		calls.Add(1)
+		return nil
+	}
+
+	first := []Migration{{Version: 2, Name: "second", Up: count}, {Version: 1, Name: "first", Up: count}}
+	applied, err := newMigrator(t, db, first...).Up(ctx)
+	if err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if got := versions(applied); fmt.Sprint(got) != "[1 2]" {
+		t.Fatalf("expected versions [1 2] applied in order, got %v", got)
+	}
+
+	// Повторный запуск применяет только новые миграции
+	next := append(first, Migration{Version: 3, Name: "third", Up: count})
+	m := newMigrator(t, db, next...)
+	applied, err = m.Up(ctx)
+	if err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if got := versions(applied); fmt.Sprint(got) != "[3]" {
+		t.Fatalf("expected only version 3 applied, got %v", got)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("expected each migration to run once, got %d runs", calls.Load())
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	for _, status := range statuses {
+		if status.AppliedAt.IsZero() {
+			t.Fatalf("migration %d is not recorded in the journal", status.Version)
+		}
+	}
+
+	if _, ok := readLock(t, db); ok {
+		t.Fatal("lock was not released after Up")
+	}
+}
+
+func TestUp_FailedMigrationIsNotRecorded(t *testing.T) {
+	ctx := context.Background()
+	db := newDatabase(t)
+
+	m := newMigrator(t, db,
+		Migration{Version: 1, Name: "ok", Up: noop},
+		Migration{Version: 2, Name: "broken", Up: func(context.Context, *mongo.Database) error {
+			return errors.New("boom")
+		}},
+	)
+
+	applied, err := m.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "2_broken") {
+		t.Fatalf("expected error of migration 2_broken, got %v", err)
+	}
+	if got := versions(applied); fmt.Sprint(got) != "[1]" {
+		t.Fatalf("expected version 1 applied before the failure, got %v", got)
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if statuses[0].AppliedAt.IsZero() || !statuses[1].AppliedAt.IsZero() {
+		t.Fatalf("expected only version 1 in the journal, got %+v", statuses)
+	}
+
+	if _, ok := readLock(t, db); ok {
+		t.Fatal("lock was not released after a failed migration")
+	}
+}
+
+func TestUp_WaitsForLock(t *testing.T) {
+	db := newDatabase(t)
+	m := newMigrator(t, db, Migration{Version: 1, Name: "first", Up: noop})
+
+	_, err := db.Collection(CollectionName).InsertOne(context.Background(),
+		bson.M{"_id": lockID, "owner": "other", "locked_at": time.Now().UTC()})
+	if err != nil {
+		t.Fatalf("insert lock: %v", err)
+	}
+
+	// Живую чужую блокировку не перехватываем
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = m.Up(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected Up to wait for the lock, got %v", err)
+	}
+	if lock, ok := readLock(t, db); !ok || lock["owner"] != "other" {
+		t.Fatalf("foreign lock must stay untouched, got %v", lock)
+	}
+
+	// Брошенная блокировка перехватывается после lockTTL
+	applied, err := m.Up(context.Background())
+	if err != nil {
+		t.Fatalf("up after lock expired: %v", err)
+	}
+	if len(applied) != 1 {
+		t.Fatalf("expected migration applied after takeover, got %v", versions(applied))
+	}
+	if _, ok := readLock(t, db); ok {
+		t.Fatal("lock was not released after takeover")
+	}
+}
+
+func TestUp_RefreshesLock(t *testing.T) {
+	db := newDatabase(t)
+
+	var (
+		owner         any
+		first, second time.Time
+	)
+	m := newMigrator(t, db, Migration{Version: 1, Name: "slow", Up: func(context.Context, *mongo.Database) error {
+		lock, _ := readLock(t, db)
+		owner, first = lock["owner"], lock["locked_at"].(primitive.DateTime).Time()
+
+		// Миграция идёт дольше lockTTL, но блокировку никто не перехватывает
+		time.Sleep(2 * time.Second)
+
+		lock, _ = readLock(t, db)
+		if lock["owner"] != owner {
+			return fmt.Errorf("lock owner changed from %v to %v", owner, lock["owner"])
+		}
+		second = lock["locked_at"].(primitive.DateTime).Time()
+
+		return nil
+	}})
+
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if owner == nil || owner == "" {
+		t.Fatal("lock has no owner")
+	}
+	if !second.After(first) {
+		t.Fatalf("expected locked_at refreshed during Up, got %s then %s", first, second)
+	}
+}
+
+func TestUp_LockLost(t *testing.T) {
+	db := newDatabase(t)
+
+	m := newMigrator(t, db, Migration{Version: 1, Name: "taken-over", Up: func(ctx context.Context, db *mongo.Database) error {
+		// Блокировку перехватил другой экземпляр
+		_, err := db.Collection(CollectionName).UpdateOne(ctx,
+			bson.M{"_id": lockID},
+			bson.M{"$set": bson.M{"owner": "other"}},
+		)
+		if err != nil {
+			return err
+		}
+
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := m.Up(ctx); !errors.Is(err, errLockLost) {
+		t.Fatalf("expected Up to stop with errLockLost, got %v", err)
+	}
+
+	// Чужую блокировку снимать нельзя
+	if lock, ok := readLock(t, db); !ok || lock["owner"] != "other" {
+		t.Fatalf("foreign lock must stay after Up, got %v", lock)
+	}
+}
@@ -22,7 +22,10 @@ type Config struct {
 	Username      string
 	Password      string
 	AuthDB        string
-	Logger        Logger
+	// ReplicaSet — имя одноузлового replica set. Пустое — обычный standalone.
+	// Replica set нужен для транзакций и change streams
+	ReplicaSet string
+	Logger     Logger
 
 	Host string
 	Port string
@@ -3,10 +3,13 @@ package mongo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func startMongoContainer(ctx context.Context, cfg *Config) (testcontainers.Container, error) {
@@ -22,6 +25,15 @@ func startMongoContainer(ctx context.Context, cfg *Config) (testcontainers.Conta
 		HostConfigModifier: defaultHostConfig(),
 	}
 
+	// Узлам replica set с авторизацией нужен общий keyFile: генерируем его при старте контейнера
+	if cfg.ReplicaSet != "" {
+		req.Entrypoint = []string{"bash", "-c", fmt.Sprintf(
+			"head -c 756 /dev/urandom | base64 > %[1]s && chmod 400 %[1]s && chown 999:999 %[1]s && "+
+				"exec docker-entrypoint.sh mongod --replSet %[2]s --bind_ip_all --keyFile %[1]s",
+			mongoKeyFile, cfg.ReplicaSet,
+		)}
+	}
+
 	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: req,
 		Started:          true,
@@ -47,8 +59,58 @@ func getContainerHostPort(ctx context.Context, container testcontainers.Containe
 	return host, port.Port(), nil
 }
 
+// initiateReplicaSet инициализирует одноузловой replica set и ждёт, пока узел станет primary
+func initiateReplicaSet(ctx context.Context, container testcontainers.Container, cfg *Config) error {
+	script := fmt.Sprintf(
+		"try { rs.status() } catch (e) { rs.initiate({_id: '%s', members: [{_id: 0, host: 'localhost:%s'}]}) }",
+		cfg.ReplicaSet, mongoPort,
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, mongoStartupTimeout)
+	defer cancel()
+
+	// Порт открывается ещё временным mongod из docker-entrypoint, поэтому первые попытки могут не пройти
+	for {
+		code, _, err := container.Exec(ctx, []string{
+			"mongosh", "--quiet",
+			"-u", cfg.Username, "-p", cfg.Password, "--authenticationDatabase", cfg.AuthDB,
+			"--eval", script,
+		})
+		if err == nil && code == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("failed to initiate replica set: exit code %d, error %v", code, err)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func waitForPrimary(ctx context.Context, client *mongo.Client) error {
+	ctx, cancel := context.WithTimeout(ctx, mongoStartupTimeout)
+	defer cancel()
+
+	for {
+		var hello struct {
+			IsWritablePrimary bool `bson:"isWritablePrimary"`
+		}
+		err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+		if err == nil && hello.IsWritablePrimary {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("replica set has no primary: %v", ctx.Err())
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
 func buildMongoURI(cfg *Config) string {
-	return fmt.Sprintf(
+	uri := fmt.Sprintf(
 		"mongodb://%s:%s@%s:%s/%s?authSource=%s",
 		cfg.Username,
 		cfg.Password,
@@ -57,4 +119,11 @@ func buildMongoURI(cfg *Config) string {
 		cfg.Database,
 		cfg.AuthDB,
 	)
+
+	// Адрес узла внутри replica set недоступен с хоста: подключаемся к нему напрямую
+	if cfg.ReplicaSet != "" {
+		uri += "&directConnection=true"
+	}
+
+	return uri
 }
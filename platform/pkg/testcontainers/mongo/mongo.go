@@ -15,6 +15,8 @@ const (
 
 	mongoEnvUsernameKey = "MONGO_INITDB_ROOT_USERNAME"
 	mongoEnvPasswordKey = "MONGO_INITDB_ROOT_PASSWORD" //nolint:gosec
+
+	mongoKeyFile = "/tmp/mongo-keyfile"
 )
 
 type Container struct {
@@ -45,6 +47,12 @@ func NewContainer(ctx context.Context, opts ...Option) (*Container, error) {
 		return nil, err
 	}
 
+	if cfg.ReplicaSet != "" {
+		if err = initiateReplicaSet(ctx, container, cfg); err != nil {
+			return nil, err
+		}
+	}
+
 	uri := buildMongoURI(cfg)
 
 	client, err := connectMongoClient(ctx, uri)
@@ -52,6 +60,12 @@ func NewContainer(ctx context.Context, opts ...Option) (*Container, error) {
 		return nil, err
 	}
 
+	if cfg.ReplicaSet != "" {
+		if err = waitForPrimary(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
 	cfg.Logger.Info(ctx, "Mongo container started", zap.String("uri", uri))
 	success = true
 
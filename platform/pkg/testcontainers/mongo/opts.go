@@ -39,6 +39,12 @@ func WithAuthDB(authDB string) Option {
 	}
 }
 
+func WithReplicaSet(name string) Option {
+	return func(c *Config) {
+		c.ReplicaSet = name
+	}
+}
+
 func WithLogger(logger Logger) Option {
 	return func(c *Config) {
 		c.Logger = logger
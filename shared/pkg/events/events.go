@@ -0,0 +1,13 @@
+package events
+
+// Типы событий, передаваемые в заголовке ce_type.
+const (
+	OrderPaidType     = "rocket_factory.order.paid"
+	ShipAssembledType = "rocket_factory.assembly.ship_assembled"
+	LowStockType      = "rocket_factory.inventory.low_stock"
+)
+
+// Версии схем событий, передаваемые в заголовке ce_schemaversion.
+const (
+	SchemaVersionV1 = "v1"
+)
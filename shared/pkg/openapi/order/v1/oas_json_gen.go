@@ -367,14 +367,23 @@ func (s *CreateOrderRequest) encodeFields(e *jx.Encoder) {
 		s.UserUUID.Encode(e)
 	}
 	{
-		e.FieldStart("part_uuids")
-		s.PartUuids.Encode(e)
+		if s.PartUuids != nil {
+			e.FieldStart("part_uuids")
+			s.PartUuids.Encode(e)
+		}
+	}
+	{
+		if s.KitUUID.Set {
+			e.FieldStart("kit_uuid")
+			s.KitUUID.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfCreateOrderRequest = [2]string{
+var jsonFieldsNameOfCreateOrderRequest = [3]string{
 	0: "user_uuid",
 	1: "part_uuids",
+	2: "kit_uuid",
 }
 
 // Decode decodes CreateOrderRequest from json.
@@ -397,15 +406,27 @@ func (s *CreateOrderRequest) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
 		case "part_uuids":
-			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.PartUuids.Decode(d); err != nil {
+				s.PartUuids = nil
+				var elem PartUuids
+				if err := elem.Decode(d); err != nil {
 					return err
 				}
+				s.PartUuids = elem
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"part_uuids\"")
 			}
+		case "kit_uuid":
+			if err := func() error {
+				s.KitUUID.Reset()
+				if err := s.KitUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kit_uuid\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -416,7 +437,7 @@ func (s *CreateOrderRequest) Decode(d *jx.Decoder) error {
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -121,6 +121,8 @@ func (*ConflictError) payOrderRes()       {}
 type CreateOrderRequest struct {
 	UserUUID  UserUUID  `json:"user_uuid"`
 	PartUuids PartUuids `json:"part_uuids"`
+	// UUID набора деталей из inventory. Набор разворачивается в детали заказа с учётом количества.
+	KitUUID OptUUID `json:"kit_uuid"`
 }
 
 // GetUserUUID returns the value of UserUUID.
@@ -133,6 +135,11 @@ func (s *CreateOrderRequest) GetPartUuids() PartUuids {
 	return s.PartUuids
 }
 
+// GetKitUUID returns the value of KitUUID.
+func (s *CreateOrderRequest) GetKitUUID() OptUUID {
+	return s.KitUUID
+}
+
 // SetUserUUID sets the value of UserUUID.
 func (s *CreateOrderRequest) SetUserUUID(val UserUUID) {
 	s.UserUUID = val
@@ -143,6 +150,11 @@ func (s *CreateOrderRequest) SetPartUuids(val PartUuids) {
 	s.PartUuids = val
 }
 
+// SetKitUUID sets the value of KitUUID.
+func (s *CreateOrderRequest) SetKitUUID(val OptUUID) {
+	s.KitUUID = val
+}
+
 // Ref: #/components/schemas/create_order_response
 type CreateOrderResponse struct {
 	OrderUUID  OrderUUID     `json:"order_uuid"`
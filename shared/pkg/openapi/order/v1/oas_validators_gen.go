@@ -16,6 +16,9 @@ func (s *CreateOrderRequest) Validate() error {
 
 	var failures []validate.FieldError
 	if err := func() error {
+		if s.PartUuids == nil {
+			return nil // optional
+		}
 		if err := s.PartUuids.Validate(); err != nil {
 			return err
 		}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/inventory_low_stock.proto
+
+package events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Остаток детали на складе опустился до порога дозаказа
+type InventoryLowStock struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid             string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`                                        // Уникальный идентификатор события (для идемпотентности)
+	PartUuid              string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`                                           // Уникальный идентификатор детали
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                                   // Название детали
+	Category              string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`                                                           // Категория детали (ENGINE, FUEL, PORTHOLE, WING)
+	StockQuantity         int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`                           // Остаток на складе после изменения
+	PreviousStockQuantity int64                  `protobuf:"varint,6,opt,name=previous_stock_quantity,json=previousStockQuantity,proto3" json:"previous_stock_quantity,omitempty"` // Остаток на складе до изменения
+	Threshold             int64                  `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`                                                        // Порог дозаказа, который был пересечён
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *InventoryLowStock) Reset() {
+	*x = InventoryLowStock{}
+	mi := &file_events_v1_inventory_low_stock_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryLowStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryLowStock) ProtoMessage() {}
+
+func (x *InventoryLowStock) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_low_stock_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryLowStock.ProtoReflect.Descriptor instead.
+func (*InventoryLowStock) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_low_stock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InventoryLowStock) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *InventoryLowStock) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *InventoryLowStock) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InventoryLowStock) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *InventoryLowStock) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *InventoryLowStock) GetPreviousStockQuantity() int64 {
+	if x != nil {
+		return x.PreviousStockQuantity
+	}
+	return 0
+}
+
+func (x *InventoryLowStock) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+var File_events_v1_inventory_low_stock_proto protoreflect.FileDescriptor
+
+const file_events_v1_inventory_low_stock_proto_rawDesc = "" +
+	"\n" +
+	"#events/v1/inventory_low_stock.proto\x12\tevents.v1\"\xfc\x01\n" +
+	"\x11InventoryLowStock\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12%\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x126\n" +
+	"\x17previous_stock_quantity\x18\x06 \x01(\x03R\x15previousStockQuantity\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x03R\tthresholdBKZIgithub.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/events/v1;events_v1b\x06proto3"
+
+var (
+	file_events_v1_inventory_low_stock_proto_rawDescOnce sync.Once
+	file_events_v1_inventory_low_stock_proto_rawDescData []byte
+)
+
+func file_events_v1_inventory_low_stock_proto_rawDescGZIP() []byte {
+	file_events_v1_inventory_low_stock_proto_rawDescOnce.Do(func() {
+		file_events_v1_inventory_low_stock_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_inventory_low_stock_proto_rawDesc), len(file_events_v1_inventory_low_stock_proto_rawDesc)))
+	})
+	return file_events_v1_inventory_low_stock_proto_rawDescData
+}
+
+var file_events_v1_inventory_low_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_events_v1_inventory_low_stock_proto_goTypes = []any{
+	(*InventoryLowStock)(nil), // 0: events.v1.InventoryLowStock
+}
+var file_events_v1_inventory_low_stock_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_inventory_low_stock_proto_init() }
+func file_events_v1_inventory_low_stock_proto_init() {
+	if File_events_v1_inventory_low_stock_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_inventory_low_stock_proto_rawDesc), len(file_events_v1_inventory_low_stock_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_inventory_low_stock_proto_goTypes,
+		DependencyIndexes: file_events_v1_inventory_low_stock_proto_depIdxs,
+		MessageInfos:      file_events_v1_inventory_low_stock_proto_msgTypes,
+	}.Build()
+	File_events_v1_inventory_low_stock_proto = out.File
+	file_events_v1_inventory_low_stock_proto_goTypes = nil
+	file_events_v1_inventory_low_stock_proto_depIdxs = nil
+}
@@ -9,8 +9,10 @@
 package inventory_v1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,6 +26,251 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PartEventType вид изменения детали
+type PartEventType int32
+
+const (
+	// 0 - Неизвестное изменение
+	PartEventType_PART_EVENT_TYPE_UNSPECIFIED PartEventType = 0
+	// 1 - Деталь добавлена
+	PartEventType_PART_EVENT_TYPE_CREATED PartEventType = 1
+	// 2 - Деталь обновлена
+	PartEventType_PART_EVENT_TYPE_UPDATED PartEventType = 2
+	// 3 - Деталь удалена
+	PartEventType_PART_EVENT_TYPE_DELETED PartEventType = 3
+	// 4 - Прогресс подписки: подходящих под фильтр изменений не было, но поток
+	// продвинулся. Клиенту достаточно сохранить resume_token
+	PartEventType_PART_EVENT_TYPE_PROGRESS PartEventType = 4
+)
+
+// Enum value maps for PartEventType.
+var (
+	PartEventType_name = map[int32]string{
+		0: "PART_EVENT_TYPE_UNSPECIFIED",
+		1: "PART_EVENT_TYPE_CREATED",
+		2: "PART_EVENT_TYPE_UPDATED",
+		3: "PART_EVENT_TYPE_DELETED",
+		4: "PART_EVENT_TYPE_PROGRESS",
+	}
+	PartEventType_value = map[string]int32{
+		"PART_EVENT_TYPE_UNSPECIFIED": 0,
+		"PART_EVENT_TYPE_CREATED":     1,
+		"PART_EVENT_TYPE_UPDATED":     2,
+		"PART_EVENT_TYPE_DELETED":     3,
+		"PART_EVENT_TYPE_PROGRESS":    4,
+	}
+)
+
+func (x PartEventType) Enum() *PartEventType {
+	p := new(PartEventType)
+	*p = x
+	return p
+}
+
+func (x PartEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (PartEventType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x PartEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartEventType.Descriptor instead.
+func (PartEventType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// AttachmentKind вид вложения детали
+type AttachmentKind int32
+
+const (
+	// 0 - Вид не указан
+	AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	// 1 - Чертёж (CAD)
+	AttachmentKind_ATTACHMENT_KIND_DRAWING AttachmentKind = 1
+	// 2 - Техпаспорт
+	AttachmentKind_ATTACHMENT_KIND_DATASHEET AttachmentKind = 2
+	// 3 - Изображение
+	AttachmentKind_ATTACHMENT_KIND_IMAGE AttachmentKind = 3
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNSPECIFIED",
+		1: "ATTACHMENT_KIND_DRAWING",
+		2: "ATTACHMENT_KIND_DATASHEET",
+		3: "ATTACHMENT_KIND_IMAGE",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNSPECIFIED": 0,
+		"ATTACHMENT_KIND_DRAWING":     1,
+		"ATTACHMENT_KIND_DATASHEET":   2,
+		"ATTACHMENT_KIND_IMAGE":       3,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// TagMatchMode режим сравнения тегов в фильтре
+type TagMatchMode int32
+
+const (
+	// 0 - Не задан, равнозначен TAG_MATCH_MODE_ANY
+	TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED TagMatchMode = 0
+	// 1 - Деталь содержит хотя бы один из тегов
+	TagMatchMode_TAG_MATCH_MODE_ANY TagMatchMode = 1
+	// 2 - Деталь содержит все теги
+	TagMatchMode_TAG_MATCH_MODE_ALL TagMatchMode = 2
+)
+
+// Enum value maps for TagMatchMode.
+var (
+	TagMatchMode_name = map[int32]string{
+		0: "TAG_MATCH_MODE_UNSPECIFIED",
+		1: "TAG_MATCH_MODE_ANY",
+		2: "TAG_MATCH_MODE_ALL",
+	}
+	TagMatchMode_value = map[string]int32{
+		"TAG_MATCH_MODE_UNSPECIFIED": 0,
+		"TAG_MATCH_MODE_ANY":         1,
+		"TAG_MATCH_MODE_ALL":         2,
+	}
+)
+
+func (x TagMatchMode) Enum() *TagMatchMode {
+	p := new(TagMatchMode)
+	*p = x
+	return p
+}
+
+func (x TagMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (TagMatchMode) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x TagMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatchMode.Descriptor instead.
+func (TagMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// MetadataOperator оператор сравнения метаданных
+type MetadataOperator int32
+
+const (
+	// 0 - Не задан
+	MetadataOperator_METADATA_OPERATOR_UNSPECIFIED MetadataOperator = 0
+	// 1 - Равно
+	MetadataOperator_METADATA_OPERATOR_EQ MetadataOperator = 1
+	// 2 - Не равно
+	MetadataOperator_METADATA_OPERATOR_NE MetadataOperator = 2
+	// 3 - Больше
+	MetadataOperator_METADATA_OPERATOR_GT MetadataOperator = 3
+	// 4 - Больше или равно
+	MetadataOperator_METADATA_OPERATOR_GTE MetadataOperator = 4
+	// 5 - Меньше
+	MetadataOperator_METADATA_OPERATOR_LT MetadataOperator = 5
+	// 6 - Меньше или равно
+	MetadataOperator_METADATA_OPERATOR_LTE MetadataOperator = 6
+	// 7 - Ключ присутствует
+	MetadataOperator_METADATA_OPERATOR_EXISTS MetadataOperator = 7
+)
+
+// Enum value maps for MetadataOperator.
+var (
+	MetadataOperator_name = map[int32]string{
+		0: "METADATA_OPERATOR_UNSPECIFIED",
+		1: "METADATA_OPERATOR_EQ",
+		2: "METADATA_OPERATOR_NE",
+		3: "METADATA_OPERATOR_GT",
+		4: "METADATA_OPERATOR_GTE",
+		5: "METADATA_OPERATOR_LT",
+		6: "METADATA_OPERATOR_LTE",
+		7: "METADATA_OPERATOR_EXISTS",
+	}
+	MetadataOperator_value = map[string]int32{
+		"METADATA_OPERATOR_UNSPECIFIED": 0,
+		"METADATA_OPERATOR_EQ":          1,
+		"METADATA_OPERATOR_NE":          2,
+		"METADATA_OPERATOR_GT":          3,
+		"METADATA_OPERATOR_GTE":         4,
+		"METADATA_OPERATOR_LT":          5,
+		"METADATA_OPERATOR_LTE":         6,
+		"METADATA_OPERATOR_EXISTS":      7,
+	}
+)
+
+func (x MetadataOperator) Enum() *MetadataOperator {
+	p := new(MetadataOperator)
+	*p = x
+	return p
+}
+
+func (x MetadataOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (MetadataOperator) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x MetadataOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataOperator.Descriptor instead.
+func (MetadataOperator) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
 // Category категория детали
 type Category int32
 
@@ -69,11 +316,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -82,14 +329,16 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
 }
 
 // GetPartRequest запрашивает информацию о детали по UUID
 type GetPartRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// part_uuid уникальный идентификатор детали
-	PartUuid      string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// price_at момент, на который нужна цена детали. Не задан — текущая цена
+	PriceAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,56 +354,3017 @@ func (x *GetPartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartRequest) ProtoMessage() {}
-
-func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+func (*GetPartRequest) ProtoMessage() {}
+
+func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
+func (*GetPartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPartRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *GetPartRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+// GetPartResponse отвечает за запрос информации о детали по UUID
+type GetPartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part информация о детали
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartResponse) Reset() {
+	*x = GetPartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartResponse) ProtoMessage() {}
+
+func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
+func (*GetPartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// ListPartsRequest запрашивает список деталей
+type ListPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter фильтр для списка деталей
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size максимальное количество деталей на странице. 0 — размер по умолчанию (100)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token токен страницы из next_page_token предыдущего ответа.
+	// Остальные параметры запроса должны совпадать с запросом, вернувшим токен
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// order_by поле сортировки: price, name, created_at или stock, с необязательным
+	// суффиксом " desc". По умолчанию — created_at по возрастанию
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// read_mask поля детали, которые нужно вернуть. Пустая маска — все поля;
+	// part_uuid возвращается всегда
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// price_at момент, на который возвращаются цены деталей. Не задан — текущие цены.
+	// Фильтр и сортировка по цене всегда используют текущую цену. Деталь, у которой
+	// на этот момент не было цены, возвращается с price = 0
+	PriceAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+// ListPartsResponse отвечает за запрос списка деталей
+type ListPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part информация о деталях
+	Parts []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// next_page_token токен следующей страницы. Пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SearchPartsRequest запрашивает полнотекстовый поиск деталей
+type SearchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query поисковая строка. Слова сравниваются по основе (русский и английский стемминг),
+	// деталь находится, если совпало хотя бы одно слово
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// filter дополнительный фильтр по полям детали
+	Filter *PartsFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size максимальное количество результатов на странице. 0 — размер по умолчанию (100)
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token токен страницы из next_page_token предыдущего ответа
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsRequest) Reset() {
+	*x = SearchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsRequest) ProtoMessage() {}
+
+func (x *SearchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchPartsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchPartsResponse отвечает на запрос поиска деталей
+type SearchPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results найденные детали в порядке убывания релевантности
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// next_page_token токен следующей страницы. Пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsResponse) Reset() {
+	*x = SearchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsResponse) ProtoMessage() {}
+
+func (x *SearchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchPartsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SearchResult найденная деталь
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part информация о детали
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// score релевантность: чем больше, тем лучше деталь соответствует запросу
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// highlights фрагменты полей с найденными словами, выделенными тегом <em>
+	Highlights    []*Highlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchResult) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// Highlight фрагмент поля детали с подсветкой совпадений
+type Highlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field поле детали: name, description, tags или manufacturer.name
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// snippet фрагмент текста поля, экранированный для HTML; разметка в нём — только теги <em>
+	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Highlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Highlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// WatchPartsRequest запрашивает подписку на изменения деталей
+type WatchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter фильтр по снимку детали после изменения
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resume_token токен последнего полученного события. Если задан, поток продолжится
+	// со следующего за ним события, иначе — с момента подписки
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsRequest) Reset() {
+	*x = WatchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsRequest) ProtoMessage() {}
+
+func (x *WatchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchPartsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// WatchPartsResponse событие изменения детали или прогресс подписки
+type WatchPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type вид изменения
+	Type PartEventType `protobuf:"varint,1,opt,name=type,proto3,enum=inventory.v1.PartEventType" json:"type,omitempty"`
+	// part снимок детали после изменения. Не заполнен для PART_EVENT_TYPE_PROGRESS
+	Part *Part `protobuf:"bytes,2,opt,name=part,proto3" json:"part,omitempty"`
+	// resume_token токен для возобновления подписки после этого события
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// occurred_at время изменения
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsResponse) Reset() {
+	*x = WatchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsResponse) ProtoMessage() {}
+
+func (x *WatchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchPartsResponse) GetType() PartEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPartsResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *WatchPartsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchPartsResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// CreatePartRequest запрашивает добавление детали
+type CreatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part деталь. Если part_uuid не задан, он генерируется сервером;
+	// created_at и updated_at всегда выставляются сервером
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePartRequest) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// CreatePartResponse отвечает на запрос добавления детали
+type CreatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part созданная деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// UpdatePartRequest запрашивает обновление детали
+type UpdatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part деталь с новыми значениями полей; part_uuid обязателен
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// update_mask список обновляемых полей (например, "price", "dimensions.weight").
+	// Пустая маска обновляет все изменяемые поля
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdatePartRequest) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdatePartResponse отвечает на запрос обновления детали
+type UpdatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part обновлённая деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// DeletePartRequest запрашивает удаление детали
+type DeletePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid      string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeletePartRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+// DeletePartResponse отвечает на запрос удаления детали
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+// BatchCreatePartsRequest запрашивает добавление нескольких деталей
+type BatchCreatePartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// parts детали
+	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreatePartsRequest) Reset() {
+	*x = BatchCreatePartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreatePartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePartsRequest) ProtoMessage() {}
+
+func (x *BatchCreatePartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePartsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreatePartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchCreatePartsRequest) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// BatchCreatePartsResponse отвечает на запрос добавления нескольких деталей
+type BatchCreatePartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// parts созданные детали
+	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreatePartsResponse) Reset() {
+	*x = BatchCreatePartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreatePartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePartsResponse) ProtoMessage() {}
+
+func (x *BatchCreatePartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePartsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreatePartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchCreatePartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// GetPriceHistoryRequest запрашивает историю цен детали
+type GetPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid      string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPriceHistoryRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+// GetPriceHistoryResponse отвечает на запрос истории цен
+type GetPriceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prices периоды действия цен в порядке возрастания effective_from
+	Prices        []*PricePeriod `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPriceHistoryResponse) GetPrices() []*PricePeriod {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// SchedulePriceRequest запрашивает изменение цены детали
+type SchedulePriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// price новая цена за единицу
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// effective_from момент, с которого действует цена. Не задан — сразу.
+	// Цена действует до следующего запланированного изменения; прошедшие моменты не принимаются
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceRequest) Reset() {
+	*x = SchedulePriceRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceRequest) ProtoMessage() {}
+
+func (x *SchedulePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SchedulePriceRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SchedulePriceRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+// SchedulePriceResponse отвечает на запрос изменения цены
+type SchedulePriceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prices история цен детали после изменения
+	Prices        []*PricePeriod `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceResponse) Reset() {
+	*x = SchedulePriceResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceResponse) ProtoMessage() {}
+
+func (x *SchedulePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SchedulePriceResponse) GetPrices() []*PricePeriod {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// PricePeriod цена детали, действующая в течение периода
+type PricePeriod struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// price цена за единицу
+	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	// effective_from начало периода (включительно)
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// effective_to конец периода (не включительно). Не задан — цена действует до следующего изменения
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricePeriod) Reset() {
+	*x = PricePeriod{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricePeriod) ProtoMessage() {}
+
+func (x *PricePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricePeriod.ProtoReflect.Descriptor instead.
+func (*PricePeriod) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PricePeriod) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PricePeriod) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PricePeriod) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+// CreateManufacturerRequest запрашивает добавление производителя
+type CreateManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer производитель. manufacturer_uuid, created_at и updated_at выставляются сервером
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManufacturerRequest) Reset() {
+	*x = CreateManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturerRequest) ProtoMessage() {}
+
+func (x *CreateManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*CreateManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateManufacturerRequest) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// CreateManufacturerResponse отвечает на запрос добавления производителя
+type CreateManufacturerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer созданный производитель
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManufacturerResponse) Reset() {
+	*x = CreateManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturerResponse) ProtoMessage() {}
+
+func (x *CreateManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*CreateManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// GetManufacturerRequest запрашивает производителя
+type GetManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer_uuid уникальный идентификатор производителя
+	ManufacturerUuid string `protobuf:"bytes,1,opt,name=manufacturer_uuid,json=manufacturerUuid,proto3" json:"manufacturer_uuid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetManufacturerRequest) Reset() {
+	*x = GetManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerRequest) ProtoMessage() {}
+
+func (x *GetManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*GetManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetManufacturerRequest) GetManufacturerUuid() string {
+	if x != nil {
+		return x.ManufacturerUuid
+	}
+	return ""
+}
+
+// GetManufacturerResponse отвечает на запрос производителя
+type GetManufacturerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer производитель
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManufacturerResponse) Reset() {
+	*x = GetManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerResponse) ProtoMessage() {}
+
+func (x *GetManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*GetManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// ListManufacturersRequest запрашивает список производителей
+type ListManufacturersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// country страны производителей. Пусто — все производители
+	Country       []string `protobuf:"bytes,1,rep,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturersRequest) Reset() {
+	*x = ListManufacturersRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersRequest) ProtoMessage() {}
+
+func (x *ListManufacturersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersRequest.ProtoReflect.Descriptor instead.
+func (*ListManufacturersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListManufacturersRequest) GetCountry() []string {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+// ListManufacturersResponse отвечает на запрос списка производителей
+type ListManufacturersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturers производители в порядке названия
+	Manufacturers []*Manufacturer `protobuf:"bytes,1,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturersResponse) Reset() {
+	*x = ListManufacturersResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersResponse) ProtoMessage() {}
+
+func (x *ListManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*ListManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListManufacturersResponse) GetManufacturers() []*Manufacturer {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+// UpdateManufacturerRequest запрашивает обновление производителя
+type UpdateManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer производитель с новыми значениями полей; manufacturer_uuid обязателен
+	Manufacturer *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// update_mask список обновляемых полей: "name", "country", "url".
+	// Пустая маска обновляет все поля
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturerRequest) Reset() {
+	*x = UpdateManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturerRequest) ProtoMessage() {}
+
+func (x *UpdateManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateManufacturerRequest) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *UpdateManufacturerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateManufacturerResponse отвечает на запрос обновления производителя
+type UpdateManufacturerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer обновлённый производитель
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturerResponse) Reset() {
+	*x = UpdateManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturerResponse) ProtoMessage() {}
+
+func (x *UpdateManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+// DeleteManufacturerRequest запрашивает удаление производителя
+type DeleteManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manufacturer_uuid уникальный идентификатор производителя
+	ManufacturerUuid string `protobuf:"bytes,1,opt,name=manufacturer_uuid,json=manufacturerUuid,proto3" json:"manufacturer_uuid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturerRequest) Reset() {
+	*x = DeleteManufacturerRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturerRequest) ProtoMessage() {}
+
+func (x *DeleteManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteManufacturerRequest) GetManufacturerUuid() string {
+	if x != nil {
+		return x.ManufacturerUuid
+	}
+	return ""
+}
+
+// DeleteManufacturerResponse отвечает на запрос удаления производителя
+type DeleteManufacturerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturerResponse) Reset() {
+	*x = DeleteManufacturerResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturerResponse) ProtoMessage() {}
+
+func (x *DeleteManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+// GetCompatiblePartsRequest запрашивает детали, совместимые с указанной
+type GetCompatiblePartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// category категории совместимых деталей. Пусто — все категории
+	Category      []Category `protobuf:"varint,2,rep,packed,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompatiblePartsRequest) Reset() {
+	*x = GetCompatiblePartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompatiblePartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompatiblePartsRequest) ProtoMessage() {}
+
+func (x *GetCompatiblePartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompatiblePartsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompatiblePartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCompatiblePartsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *GetCompatiblePartsRequest) GetCategory() []Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// GetCompatiblePartsResponse отвечает на запрос совместимых деталей
+type GetCompatiblePartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// compatible_parts совместимые детали в порядке part_uuid. Удалённые детали не возвращаются
+	CompatibleParts []*CompatiblePart `protobuf:"bytes,1,rep,name=compatible_parts,json=compatibleParts,proto3" json:"compatible_parts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCompatiblePartsResponse) Reset() {
+	*x = GetCompatiblePartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompatiblePartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompatiblePartsResponse) ProtoMessage() {}
+
+func (x *GetCompatiblePartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompatiblePartsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompatiblePartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCompatiblePartsResponse) GetCompatibleParts() []*CompatiblePart {
+	if x != nil {
+		return x.CompatibleParts
+	}
+	return nil
+}
+
+// ListKitsRequest запрашивает список наборов деталей
+type ListKitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitsRequest) Reset() {
+	*x = ListKitsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitsRequest) ProtoMessage() {}
+
+func (x *ListKitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitsRequest.ProtoReflect.Descriptor instead.
+func (*ListKitsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+// ListKitsResponse отвечает на запрос списка наборов
+type ListKitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kits наборы в порядке названия
+	Kits          []*Kit `protobuf:"bytes,1,rep,name=kits,proto3" json:"kits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitsResponse) Reset() {
+	*x = ListKitsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitsResponse) ProtoMessage() {}
+
+func (x *ListKitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitsResponse.ProtoReflect.Descriptor instead.
+func (*ListKitsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListKitsResponse) GetKits() []*Kit {
+	if x != nil {
+		return x.Kits
+	}
+	return nil
+}
+
+// ExpandKitRequest запрашивает состав набора
+type ExpandKitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kit_uuid уникальный идентификатор набора
+	KitUuid       string `protobuf:"bytes,1,opt,name=kit_uuid,json=kitUuid,proto3" json:"kit_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandKitRequest) Reset() {
+	*x = ExpandKitRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandKitRequest) ProtoMessage() {}
+
+func (x *ExpandKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandKitRequest.ProtoReflect.Descriptor instead.
+func (*ExpandKitRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExpandKitRequest) GetKitUuid() string {
+	if x != nil {
+		return x.KitUuid
+	}
+	return ""
+}
+
+// ExpandKitResponse отвечает на запрос состава набора
+type ExpandKitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kit набор
+	Kit *Kit `protobuf:"bytes,1,opt,name=kit,proto3" json:"kit,omitempty"`
+	// lines детали набора с количеством в порядке позиций набора
+	Lines []*KitLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// total_price стоимость набора по текущим ценам деталей
+	TotalPrice    float64 `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandKitResponse) Reset() {
+	*x = ExpandKitResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandKitResponse) ProtoMessage() {}
+
+func (x *ExpandKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandKitResponse.ProtoReflect.Descriptor instead.
+func (*ExpandKitResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExpandKitResponse) GetKit() *Kit {
+	if x != nil {
+		return x.Kit
+	}
+	return nil
+}
+
+func (x *ExpandKitResponse) GetLines() []*KitLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ExpandKitResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+// UploadAttachmentRequest сообщение потока загрузки вложения
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentInfo {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Info struct {
+	// info описание вложения, только в первом сообщении потока
+	Info *AttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	// chunk очередной фрагмент содержимого файла
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+// UploadAttachmentResponse отвечает на загрузку вложения
+type UploadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attachment сохранённое вложение
+	Attachment    *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DownloadAttachmentRequest запрашивает содержимое вложения
+type DownloadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// attachment_uuid уникальный идентификатор вложения
+	AttachmentUuid string `protobuf:"bytes,2,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DownloadAttachmentRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentUuid() string {
+	if x != nil {
+		return x.AttachmentUuid
+	}
+	return ""
+}
+
+// DownloadAttachmentResponse сообщение потока выгрузки вложения
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	// attachment описание вложения, только в первом сообщении потока
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	// chunk очередной фрагмент содержимого файла
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+// TransferStockRequest запрашивает перемещение остатка детали между складами
+type TransferStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// from_warehouse код склада-отправителя
+	FromWarehouse string `protobuf:"bytes,2,opt,name=from_warehouse,json=fromWarehouse,proto3" json:"from_warehouse,omitempty"`
+	// to_warehouse код склада-получателя
+	ToWarehouse string `protobuf:"bytes,3,opt,name=to_warehouse,json=toWarehouse,proto3" json:"to_warehouse,omitempty"`
+	// quantity количество перемещаемых деталей
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// reason причина перемещения
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TransferStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetFromWarehouse() string {
+	if x != nil {
+		return x.FromWarehouse
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetToWarehouse() string {
+	if x != nil {
+		return x.ToWarehouse
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// TransferStockResponse отвечает на запрос перемещения остатка
+type TransferStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part деталь с остатками после перемещения
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// transfer запись о перемещении
+	Transfer      *StockTransfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransferStockResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *TransferStockResponse) GetTransfer() *StockTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// StockTransfer запись о перемещении остатка между складами
+type StockTransfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transfer_uuid уникальный идентификатор перемещения
+	TransferUuid string `protobuf:"bytes,1,opt,name=transfer_uuid,json=transferUuid,proto3" json:"transfer_uuid,omitempty"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// from_warehouse код склада-отправителя
+	FromWarehouse string `protobuf:"bytes,3,opt,name=from_warehouse,json=fromWarehouse,proto3" json:"from_warehouse,omitempty"`
+	// to_warehouse код склада-получателя
+	ToWarehouse string `protobuf:"bytes,4,opt,name=to_warehouse,json=toWarehouse,proto3" json:"to_warehouse,omitempty"`
+	// quantity количество перемещённых деталей
+	Quantity int64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// reason причина перемещения
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// created_at время перемещения
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockTransfer) Reset() {
+	*x = StockTransfer{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockTransfer) ProtoMessage() {}
+
+func (x *StockTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockTransfer.ProtoReflect.Descriptor instead.
+func (*StockTransfer) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StockTransfer) GetTransferUuid() string {
+	if x != nil {
+		return x.TransferUuid
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetFromWarehouse() string {
+	if x != nil {
+		return x.FromWarehouse
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetToWarehouse() string {
+	if x != nil {
+		return x.ToWarehouse
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockTransfer) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListWarehousesRequest запрашивает список складов
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+// ListWarehousesResponse отвечает на запрос списка складов
+type ListWarehousesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// warehouses склады
+	Warehouses    []*Warehouse `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// Warehouse склад
+type Warehouse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code код склада, например BAIKONUR
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// name название склада
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// location расположение склада
+	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// CompatiblePart деталь, совместимая с запрошенной
+type CompatiblePart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part совместимая деталь
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// note пояснение к совместимости, например условия применения
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatiblePart) Reset() {
+	*x = CompatiblePart{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatiblePart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatiblePart) ProtoMessage() {}
+
+func (x *CompatiblePart) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatiblePart.ProtoReflect.Descriptor instead.
+func (*CompatiblePart) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CompatiblePart) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *CompatiblePart) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// Kit именованный набор деталей для сборки ракеты
+type Kit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kit_uuid уникальный идентификатор набора
+	KitUuid string `protobuf:"bytes,1,opt,name=kit_uuid,json=kitUuid,proto3" json:"kit_uuid,omitempty"`
+	// name название набора
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description описание набора
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// items позиции набора
+	Items         []*KitItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Kit) Reset() {
+	*x = Kit{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kit) ProtoMessage() {}
+
+func (x *Kit) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kit.ProtoReflect.Descriptor instead.
+func (*Kit) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Kit) GetKitUuid() string {
+	if x != nil {
+		return x.KitUuid
+	}
+	return ""
+}
+
+func (x *Kit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Kit) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Kit) GetItems() []*KitItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// KitItem позиция набора
+type KitItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// quantity количество деталей в наборе
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitItem) Reset() {
+	*x = KitItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitItem) ProtoMessage() {}
+
+func (x *KitItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitItem.ProtoReflect.Descriptor instead.
+func (*KitItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *KitItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *KitItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// KitLine позиция развёрнутого набора
+type KitLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part деталь
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// quantity количество деталей в наборе
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitLine) Reset() {
+	*x = KitLine{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitLine) ProtoMessage() {}
+
+func (x *KitLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitLine.ProtoReflect.Descriptor instead.
+func (*KitLine) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *KitLine) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *KitLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// AttachmentInfo описание загружаемого вложения
+type AttachmentInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// part_uuid уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// file_name имя файла
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// content_type MIME-тип содержимого. Не задан — определяется по первым байтам файла
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// kind вид вложения
+	Kind AttachmentKind `protobuf:"varint,4,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// checksum_sha256 ожидаемая контрольная сумма SHA-256 содержимого в hex.
+	// Если задана, вложение с другой суммой не сохраняется
+	ChecksumSha256 string `protobuf:"bytes,5,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttachmentInfo) Reset() {
+	*x = AttachmentInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentInfo) ProtoMessage() {}
+
+func (x *AttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AttachmentInfo) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetContentType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ContentType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
-func (*GetPartRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+func (x *AttachmentInfo) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
 }
 
-func (x *GetPartRequest) GetPartUuid() string {
+func (x *AttachmentInfo) GetChecksumSha256() string {
 	if x != nil {
-		return x.PartUuid
+		return x.ChecksumSha256
 	}
 	return ""
 }
 
-// GetPartResponse отвечает за запрос информации о детали по UUID
-type GetPartResponse struct {
+// Attachment вложение детали: чертёж, техпаспорт или изображение
+type Attachment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// part информация о детали
-	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// attachment_uuid уникальный идентификатор вложения
+	AttachmentUuid string `protobuf:"bytes,1,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	// file_name имя файла
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// content_type MIME-тип содержимого
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// kind вид вложения
+	Kind AttachmentKind `protobuf:"varint,4,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// size_bytes размер содержимого в байтах
+	SizeBytes int64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// checksum_sha256 контрольная сумма SHA-256 содержимого в hex
+	ChecksumSha256 string `protobuf:"bytes,6,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	// created_at дата загрузки
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartResponse) Reset() {
-	*x = GetPartResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartResponse) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartResponse) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,88 +3375,86 @@ func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
-func (*GetPartResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetPartResponse) GetPart() *Part {
+func (x *Attachment) GetAttachmentUuid() string {
 	if x != nil {
-		return x.Part
+		return x.AttachmentUuid
 	}
-	return nil
+	return ""
 }
 
-// ListPartsRequest запрашивает список деталей
-type ListPartsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// filter фильтр для списка деталей
-	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (x *ListPartsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Attachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
-
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *Attachment) GetSizeBytes() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SizeBytes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+func (x *Attachment) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Filter
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// ListPartsResponse отвечает за запрос списка деталей
-type ListPartsResponse struct {
+// StockLevel остаток детали на складе
+type StockLevel struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// part информация о деталях
-	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// warehouse код склада
+	Warehouse string `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	// quantity количество на складе
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *StockLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*StockLevel) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,16 +3465,23 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *StockLevel) GetWarehouse() string {
 	if x != nil {
-		return x.Parts
+		return x.Warehouse
 	}
-	return nil
+	return ""
+}
+
+func (x *StockLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
 // PartsFilter фильтр для списка деталей
@@ -281,14 +3496,31 @@ type PartsFilter struct {
 	// manufacturer_country страна производителя детали
 	ManufacturerCountry []string `protobuf:"bytes,4,rep,name=manufacturer_country,json=manufacturerCountry,proto3" json:"manufacturer_country,omitempty"`
 	// tags теги детали
-	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// price_min минимальная цена (включительно)
+	PriceMin *float64 `protobuf:"fixed64,6,opt,name=price_min,json=priceMin,proto3,oneof" json:"price_min,omitempty"`
+	// price_max максимальная цена (включительно)
+	PriceMax *float64 `protobuf:"fixed64,7,opt,name=price_max,json=priceMax,proto3,oneof" json:"price_max,omitempty"`
+	// min_stock минимальный остаток на складе (включительно)
+	MinStock *int64 `protobuf:"varint,8,opt,name=min_stock,json=minStock,proto3,oneof" json:"min_stock,omitempty"`
+	// in_stock_only только детали, которые есть на складе
+	InStockOnly bool `protobuf:"varint,9,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	// manufacturer_name название производителя детали
+	ManufacturerName []string `protobuf:"bytes,10,rep,name=manufacturer_name,json=manufacturerName,proto3" json:"manufacturer_name,omitempty"`
+	// tag_match режим сравнения тегов; по умолчанию достаточно одного совпадения
+	TagMatch TagMatchMode `protobuf:"varint,11,opt,name=tag_match,json=tagMatch,proto3,enum=inventory.v1.TagMatchMode" json:"tag_match,omitempty"`
+	// metadata условия на значения метаданных; все условия должны выполняться
+	Metadata []*MetadataPredicate `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// warehouse коды складов. Деталь подходит, если на одном из складов есть её остаток;
+	// min_stock и in_stock_only тогда проверяются по остатку на этом складе, а не по общему
+	Warehouse     []string `protobuf:"bytes,13,rep,name=warehouse,proto3" json:"warehouse,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PartsFilter) Reset() {
 	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +3532,7 @@ func (x *PartsFilter) String() string {
 func (*PartsFilter) ProtoMessage() {}
 
 func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +3545,7 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
 func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *PartsFilter) GetPartUuid() []string {
@@ -351,6 +3583,127 @@ func (x *PartsFilter) GetTags() []string {
 	return nil
 }
 
+func (x *PartsFilter) GetPriceMin() float64 {
+	if x != nil && x.PriceMin != nil {
+		return *x.PriceMin
+	}
+	return 0
+}
+
+func (x *PartsFilter) GetPriceMax() float64 {
+	if x != nil && x.PriceMax != nil {
+		return *x.PriceMax
+	}
+	return 0
+}
+
+func (x *PartsFilter) GetMinStock() int64 {
+	if x != nil && x.MinStock != nil {
+		return *x.MinStock
+	}
+	return 0
+}
+
+func (x *PartsFilter) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *PartsFilter) GetManufacturerName() []string {
+	if x != nil {
+		return x.ManufacturerName
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTagMatch() TagMatchMode {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *PartsFilter) GetMetadata() []*MetadataPredicate {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetWarehouse() []string {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// MetadataPredicate условие на значение метаданных детали.
+// Сравнение выполняется с полем того же типа, что и value
+type MetadataPredicate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key ключ метаданных
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// operator оператор сравнения
+	Operator MetadataOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=inventory.v1.MetadataOperator" json:"operator,omitempty"`
+	// value значение для сравнения; не нужно для METADATA_OPERATOR_EXISTS
+	Value         *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataPredicate) Reset() {
+	*x = MetadataPredicate{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataPredicate) ProtoMessage() {}
+
+func (x *MetadataPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataPredicate.ProtoReflect.Descriptor instead.
+func (*MetadataPredicate) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MetadataPredicate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataPredicate) GetOperator() MetadataOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return MetadataOperator_METADATA_OPERATOR_UNSPECIFIED
+}
+
+func (x *MetadataPredicate) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 // Part информация о детали
 type Part struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -360,15 +3713,18 @@ type Part struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// description описание детали
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// price цена за единицу
+	// price цена за единицу на момент price_at запроса, по умолчанию — текущая
 	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	// stock_quantity количество на складе
+	// stock_quantity общее количество на складах. Если заданы остатки stock,
+	// вычисляется сервером как их сумма
 	StockQuantity int64 `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
 	// category категория
 	Category Category `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
 	// dimensions размеры детали
 	Dimensions *Dimensions `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
-	// manufacturer информация о производителе
+	// manufacturer производитель из справочника. При записи детали используется
+	// manufacturer_uuid; если он не задан, производитель ищется по manufacturer.name
+	// и добавляется в справочник, если его там нет. Остальные поля при записи игнорируются
 	Manufacturer *Manufacturer `protobuf:"bytes,8,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
 	// tags теги для быстрого поиска
 	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
@@ -377,14 +3733,24 @@ type Part struct {
 	// created_at дата создания
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at дата обновления
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// reorder_threshold порог дозаказа: при падении остатка до этого значения
+	// публикуется событие InventoryLowStock. Не задан — действует порог категории
+	ReorderThreshold *int64 `protobuf:"varint,13,opt,name=reorder_threshold,json=reorderThreshold,proto3,oneof" json:"reorder_threshold,omitempty"`
+	// stock остатки детали по складам
+	Stock []*StockLevel `protobuf:"bytes,14,rep,name=stock,proto3" json:"stock,omitempty"`
+	// manufacturer_uuid идентификатор производителя из справочника
+	ManufacturerUuid string `protobuf:"bytes,15,opt,name=manufacturer_uuid,json=manufacturerUuid,proto3" json:"manufacturer_uuid,omitempty"`
+	// attachments вложения детали в порядке загрузки. Загружаются через UploadAttachment,
+	// при записи детали игнорируются
+	Attachments   []*Attachment `protobuf:"bytes,16,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +3762,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +3775,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *Part) GetPartUuid() string {
@@ -496,6 +3862,34 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetReorderThreshold() int64 {
+	if x != nil && x.ReorderThreshold != nil {
+		return *x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Part) GetStock() []*StockLevel {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+func (x *Part) GetManufacturerUuid() string {
+	if x != nil {
+		return x.ManufacturerUuid
+	}
+	return ""
+}
+
+func (x *Part) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
 // Dimensions размеры детали
 type Dimensions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -513,7 +3907,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +3919,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +3932,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -569,22 +3963,28 @@ func (x *Dimensions) GetWeight() float64 {
 	return 0
 }
 
-// Manufacturer информация о производителе
+// Manufacturer производитель из справочника
 type Manufacturer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name название производителя
+	// name название производителя, уникальное без учёта регистра
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// country страна производителя
 	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
 	// url ссылка на страницу производителя
-	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// manufacturer_uuid уникальный идентификатор производителя
+	ManufacturerUuid string `protobuf:"bytes,4,opt,name=manufacturer_uuid,json=manufacturerUuid,proto3" json:"manufacturer_uuid,omitempty"`
+	// created_at дата добавления в справочник
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at дата обновления
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -596,7 +3996,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +4009,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -633,6 +4033,27 @@ func (x *Manufacturer) GetUrl() string {
 	return ""
 }
 
+func (x *Manufacturer) GetManufacturerUuid() string {
+	if x != nil {
+		return x.ManufacturerUuid
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Manufacturer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // Универсальное значение одного из возможных типов
 type Value struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -649,7 +4070,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +4082,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +4095,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *Value) GetKind() isValue_Kind {
@@ -752,21 +4173,234 @@ var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"-\n" +
-	"\x0eGetPartRequest\x12\x1b\n" +
-	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\"9\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"n\n" +
+	"\x0eGetPartRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x125\n" +
+	"\bprice_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xdb\x02\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\x12R\n" +
+	"\border_by\x18\x04 \x01(\tB7\xbaH4r220^((price|name|created_at|stock)( (asc|desc))?)?$R\aorderBy\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x125\n" +
+	"\bprice_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\"e\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xc2\x01\n" +
-	"\vPartsFilter\x12\x1b\n" +
-	"\tpart_uuid\x18\x01 \x03(\tR\bpartUuid\x12\x1b\n" +
-	"\tpart_name\x18\x02 \x03(\tR\bpartName\x122\n" +
-	"\bcategory\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\bcategory\x121\n" +
-	"\x14manufacturer_country\x18\x04 \x03(\tR\x13manufacturerCountry\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xde\x04\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
+	"\x12SearchPartsRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05query\x121\n" +
+	"\x06filter\x18\x02 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\tpageToken\"s\n" +
+	"\x13SearchPartsResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.inventory.v1.SearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x01\n" +
+	"\fSearchResult\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x127\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x17.inventory.v1.HighlightR\n" +
+	"highlights\";\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"s\n" +
+	"\x11WatchPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12+\n" +
+	"\fresume_token\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vresumeToken\"\xcd\x01\n" +
+	"\x12WatchPartsResponse\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.inventory.v1.PartEventTypeR\x04type\x12&\n" +
+	"\x04part\x18\x02 \x01(\v2\x12.inventory.v1.PartR\x04part\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"C\n" +
+	"\x11CreatePartRequest\x12.\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartB\x06\xbaH\x03\xc8\x01\x01R\x04part\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\x80\x01\n" +
+	"\x11UpdatePartRequest\x12.\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartB\x06\xbaH\x03\xc8\x01\x01R\x04part\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\":\n" +
+	"\x11DeletePartRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\"\x14\n" +
+	"\x12DeletePartResponse\"O\n" +
+	"\x17BatchCreatePartsRequest\x124\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\x05parts\"D\n" +
+	"\x18BatchCreatePartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"?\n" +
+	"\x16GetPriceHistoryRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\"L\n" +
+	"\x17GetPriceHistoryResponse\x121\n" +
+	"\x06prices\x18\x01 \x03(\v2\x19.inventory.v1.PricePeriodR\x06prices\"\xa6\x01\n" +
+	"\x14SchedulePriceRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x12$\n" +
+	"\x05price\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"J\n" +
+	"\x15SchedulePriceResponse\x121\n" +
+	"\x06prices\x18\x01 \x03(\v2\x19.inventory.v1.PricePeriodR\x06prices\"\xa5\x01\n" +
+	"\vPricePeriod\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\"c\n" +
+	"\x19CreateManufacturerRequest\x12F\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerB\x06\xbaH\x03\xc8\x01\x01R\fmanufacturer\"\\\n" +
+	"\x1aCreateManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"O\n" +
+	"\x16GetManufacturerRequest\x125\n" +
+	"\x11manufacturer_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10manufacturerUuid\"Y\n" +
+	"\x17GetManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"G\n" +
+	"\x18ListManufacturersRequest\x12+\n" +
+	"\acountry\x18\x01 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\"\ar\x05\x10\x01\x18\x80\x01R\acountry\"]\n" +
+	"\x19ListManufacturersResponse\x12@\n" +
+	"\rmanufacturers\x18\x01 \x03(\v2\x1a.inventory.v1.ManufacturerR\rmanufacturers\"\xa0\x01\n" +
+	"\x19UpdateManufacturerRequest\x12F\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerB\x06\xbaH\x03\xc8\x01\x01R\fmanufacturer\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\\\n" +
+	"\x1aUpdateManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"R\n" +
+	"\x19DeleteManufacturerRequest\x125\n" +
+	"\x11manufacturer_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10manufacturerUuid\"\x1c\n" +
+	"\x1aDeleteManufacturerResponse\"\x87\x01\n" +
+	"\x19GetCompatiblePartsRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x12C\n" +
+	"\bcategory\x18\x02 \x03(\x0e2\x16.inventory.v1.CategoryB\x0f\xbaH\f\x92\x01\t\x10\n" +
+	"\"\x05\x82\x01\x02\x10\x01R\bcategory\"e\n" +
+	"\x1aGetCompatiblePartsResponse\x12G\n" +
+	"\x10compatible_parts\x18\x01 \x03(\v2\x1c.inventory.v1.CompatiblePartR\x0fcompatibleParts\"\x11\n" +
+	"\x0fListKitsRequest\"9\n" +
+	"\x10ListKitsResponse\x12%\n" +
+	"\x04kits\x18\x01 \x03(\v2\x11.inventory.v1.KitR\x04kits\"7\n" +
+	"\x10ExpandKitRequest\x12#\n" +
+	"\bkit_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\akitUuid\"\x86\x01\n" +
+	"\x11ExpandKitResponse\x12#\n" +
+	"\x03kit\x18\x01 \x01(\v2\x11.inventory.v1.KitR\x03kit\x12+\n" +
+	"\x05lines\x18\x02 \x03(\v2\x15.inventory.v1.KitLineR\x05lines\x12\x1f\n" +
+	"\vtotal_price\x18\x03 \x01(\x01R\n" +
+	"totalPrice\"\x7f\n" +
+	"\x17UploadAttachmentRequest\x122\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.inventory.v1.AttachmentInfoH\x00R\x04info\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\r\n" +
+	"\x04data\x12\x05\xbaH\x02\b\x01\"T\n" +
+	"\x18UploadAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentR\n" +
+	"attachment\"u\n" +
+	"\x19DownloadAttachmentRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x121\n" +
+	"\x0fattachment_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eattachmentUuid\"x\n" +
+	"\x1aDownloadAttachmentResponse\x12:\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\xdd\x02\n" +
+	"\x14TransferStockRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x120\n" +
+	"\x0efrom_warehouse\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\rfromWarehouse\x12,\n" +
+	"\fto_warehouse\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\vtoWarehouse\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\x12 \n" +
+	"\x06reason\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\x06reason:w\xbaHt\x1ar\n" +
+	"\x19transfer_stock.warehouses\x12+from_warehouse and to_warehouse must differ\x1a(this.from_warehouse != this.to_warehouse\"x\n" +
+	"\x15TransferStockResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x127\n" +
+	"\btransfer\x18\x02 \x01(\v2\x1b.inventory.v1.StockTransferR\btransfer\"\x8a\x02\n" +
+	"\rStockTransfer\x12#\n" +
+	"\rtransfer_uuid\x18\x01 \x01(\tR\ftransferUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12%\n" +
+	"\x0efrom_warehouse\x18\x03 \x01(\tR\rfromWarehouse\x12!\n" +
+	"\fto_warehouse\x18\x04 \x01(\tR\vtoWarehouse\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x17\n" +
+	"\x15ListWarehousesRequest\"Q\n" +
+	"\x16ListWarehousesResponse\x127\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x17.inventory.v1.WarehouseR\n" +
+	"warehouses\"O\n" +
+	"\tWarehouse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\"L\n" +
+	"\x0eCompatiblePart\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"\x83\x01\n" +
+	"\x03Kit\x12\x19\n" +
+	"\bkit_uuid\x18\x01 \x01(\tR\akitUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12+\n" +
+	"\x05items\x18\x04 \x03(\v2\x15.inventory.v1.KitItemR\x05items\"B\n" +
+	"\aKitItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"M\n" +
+	"\aKitLine\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x8c\x02\n" +
+	"\x0eAttachmentInfo\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x12'\n" +
+	"\tfile_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfileName\x12+\n" +
+	"\fcontent_type\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vcontentType\x12:\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1c.inventory.v1.AttachmentKindB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x12A\n" +
+	"\x0fchecksum_sha256\x18\x05 \x01(\tB\x18\xbaH\x15r\x132\x11^([0-9a-f]{64})?$R\x0echecksumSha256\"\xaa\x02\n" +
+	"\n" +
+	"Attachment\x12'\n" +
+	"\x0fattachment_uuid\x18\x01 \x01(\tR\x0eattachmentUuid\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x120\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1c.inventory.v1.AttachmentKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12'\n" +
+	"\x0fchecksum_sha256\x18\x06 \x01(\tR\x0echecksumSha256\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"F\n" +
+	"\n" +
+	"StockLevel\x12\x1c\n" +
+	"\twarehouse\x18\x01 \x01(\tR\twarehouse\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x9e\a\n" +
+	"\vPartsFilter\x12,\n" +
+	"\tpart_uuid\x18\x01 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10d\"\x05r\x03\xb0\x01\x01R\bpartUuid\x12.\n" +
+	"\tpart_name\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\"\ar\x05\x10\x01\x18\x80\x02R\bpartName\x12E\n" +
+	"\bcategory\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryB\x11\xbaH\x0e\x92\x01\v\x10\n" +
+	"\"\a\x82\x01\x04\x10\x01 \x00R\bcategory\x12D\n" +
+	"\x14manufacturer_country\x18\x04 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\"\ar\x05\x10\x01\x18\x80\x01R\x13manufacturerCountry\x12$\n" +
+	"\x04tags\x18\x05 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x102\"\x06r\x04\x10\x01\x18@R\x04tags\x120\n" +
+	"\tprice_min\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\bpriceMin\x88\x01\x01\x120\n" +
+	"\tprice_max\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\bpriceMax\x88\x01\x01\x12)\n" +
+	"\tmin_stock\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x02R\bminStock\x88\x01\x01\x12\"\n" +
+	"\rin_stock_only\x18\t \x01(\bR\vinStockOnly\x12>\n" +
+	"\x11manufacturer_name\x18\n" +
+	" \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\"\ar\x05\x10\x01\x18\x80\x02R\x10manufacturerName\x12A\n" +
+	"\ttag_match\x18\v \x01(\x0e2\x1a.inventory.v1.TagMatchModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\btagMatch\x12E\n" +
+	"\bmetadata\x18\f \x03(\v2\x1f.inventory.v1.MetadataPredicateB\b\xbaH\x05\x92\x01\x02\x10\x14R\bmetadata\x12.\n" +
+	"\twarehouse\x18\r \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18@R\twarehouse:\xa6\x01\xbaH\xa2\x01\x1a\x9f\x01\n" +
+	"\x18parts_filter.price_range\x121price_min must be less than or equal to price_max\x1aP!has(this.price_min) || !has(this.price_max) || this.price_min <= this.price_maxB\f\n" +
+	"\n" +
+	"_price_minB\f\n" +
+	"\n" +
+	"_price_maxB\f\n" +
+	"\n" +
+	"_min_stock\"\xae\x03\n" +
+	"\x11MetadataPredicate\x12-\n" +
+	"\x03key\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x01\x18@2\x10^[A-Za-z0-9_-]+$R\x03key\x12F\n" +
+	"\boperator\x18\x02 \x01(\x0e2\x1e.inventory.v1.MetadataOperatorB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\boperator\x12)\n" +
+	"\x05value\x18\x03 \x01(\v2\x13.inventory.v1.ValueR\x05value:\xf6\x01\xbaH\xf2\x01\x1a\xef\x01\n" +
+	"\x18metadata_predicate.value\x12*value is required for comparison operators\x1a\xa6\x01this.operator == 7 || (has(this.value) && (has(this.value.string_value) || has(this.value.int64_value) || has(this.value.double_value) || has(this.value.bool_value)))\"\xbf\x06\n" +
 	"\x04Part\x12\x1b\n" +
 	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -784,20 +4418,30 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x11reorder_threshold\x18\r \x01(\x03H\x00R\x10reorderThreshold\x88\x01\x01\x12.\n" +
+	"\x05stock\x18\x0e \x03(\v2\x18.inventory.v1.StockLevelR\x05stock\x12+\n" +
+	"\x11manufacturer_uuid\x18\x0f \x01(\tR\x10manufacturerUuid\x12:\n" +
+	"\vattachments\x18\x10 \x03(\v2\x18.inventory.v1.AttachmentR\vattachments\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01B\x14\n" +
+	"\x12_reorder_threshold\"j\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
 	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
 	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x01R\x06weight\"N\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\"\xf1\x01\n" +
 	"\fManufacturer\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03url\"\x9d\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12+\n" +
+	"\x11manufacturer_uuid\x18\x04 \x01(\tR\x10manufacturerUuid\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x01\n" +
 	"\x05Value\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
 	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
@@ -805,16 +4449,64 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
-	"\x04kind*v\n" +
+	"\x04kind*\xa5\x01\n" +
+	"\rPartEventType\x12\x1f\n" +
+	"\x1bPART_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_DELETED\x10\x03\x12\x1c\n" +
+	"\x18PART_EVENT_TYPE_PROGRESS\x10\x04*\x88\x01\n" +
+	"\x0eAttachmentKind\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ATTACHMENT_KIND_DRAWING\x10\x01\x12\x1d\n" +
+	"\x19ATTACHMENT_KIND_DATASHEET\x10\x02\x12\x19\n" +
+	"\x15ATTACHMENT_KIND_IMAGE\x10\x03*^\n" +
+	"\fTagMatchMode\x12\x1e\n" +
+	"\x1aTAG_MATCH_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ANY\x10\x01\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ALL\x10\x02*\xf1\x01\n" +
+	"\x10MetadataOperator\x12!\n" +
+	"\x1dMETADATA_OPERATOR_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14METADATA_OPERATOR_EQ\x10\x01\x12\x18\n" +
+	"\x14METADATA_OPERATOR_NE\x10\x02\x12\x18\n" +
+	"\x14METADATA_OPERATOR_GT\x10\x03\x12\x19\n" +
+	"\x15METADATA_OPERATOR_GTE\x10\x04\x12\x18\n" +
+	"\x14METADATA_OPERATOR_LT\x10\x05\x12\x19\n" +
+	"\x15METADATA_OPERATOR_LTE\x10\x06\x12\x1c\n" +
+	"\x18METADATA_OPERATOR_EXISTS\x10\a*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x042\xe9\x0f\n" +
 	"\x10InventoryService\x12F\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseBQZOgithub.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12O\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\x12O\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\x12O\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\x12a\n" +
+	"\x10BatchCreateParts\x12%.inventory.v1.BatchCreatePartsRequest\x1a&.inventory.v1.BatchCreatePartsResponse\x12R\n" +
+	"\vSearchParts\x12 .inventory.v1.SearchPartsRequest\x1a!.inventory.v1.SearchPartsResponse\x12Q\n" +
+	"\n" +
+	"WatchParts\x12\x1f.inventory.v1.WatchPartsRequest\x1a .inventory.v1.WatchPartsResponse0\x01\x12X\n" +
+	"\rTransferStock\x12\".inventory.v1.TransferStockRequest\x1a#.inventory.v1.TransferStockResponse\x12[\n" +
+	"\x0eListWarehouses\x12#.inventory.v1.ListWarehousesRequest\x1a$.inventory.v1.ListWarehousesResponse\x12^\n" +
+	"\x0fGetPriceHistory\x12$.inventory.v1.GetPriceHistoryRequest\x1a%.inventory.v1.GetPriceHistoryResponse\x12X\n" +
+	"\rSchedulePrice\x12\".inventory.v1.SchedulePriceRequest\x1a#.inventory.v1.SchedulePriceResponse\x12g\n" +
+	"\x12CreateManufacturer\x12'.inventory.v1.CreateManufacturerRequest\x1a(.inventory.v1.CreateManufacturerResponse\x12^\n" +
+	"\x0fGetManufacturer\x12$.inventory.v1.GetManufacturerRequest\x1a%.inventory.v1.GetManufacturerResponse\x12d\n" +
+	"\x11ListManufacturers\x12&.inventory.v1.ListManufacturersRequest\x1a'.inventory.v1.ListManufacturersResponse\x12g\n" +
+	"\x12UpdateManufacturer\x12'.inventory.v1.UpdateManufacturerRequest\x1a(.inventory.v1.UpdateManufacturerResponse\x12g\n" +
+	"\x12DeleteManufacturer\x12'.inventory.v1.DeleteManufacturerRequest\x1a(.inventory.v1.DeleteManufacturerResponse\x12g\n" +
+	"\x12GetCompatibleParts\x12'.inventory.v1.GetCompatiblePartsRequest\x1a(.inventory.v1.GetCompatiblePartsResponse\x12I\n" +
+	"\bListKits\x12\x1d.inventory.v1.ListKitsRequest\x1a\x1e.inventory.v1.ListKitsResponse\x12L\n" +
+	"\tExpandKit\x12\x1e.inventory.v1.ExpandKitRequest\x1a\x1f.inventory.v1.ExpandKitResponse\x12c\n" +
+	"\x10UploadAttachment\x12%.inventory.v1.UploadAttachmentRequest\x1a&.inventory.v1.UploadAttachmentResponse(\x01\x12i\n" +
+	"\x12DownloadAttachment\x12'.inventory.v1.DownloadAttachmentRequest\x1a(.inventory.v1.DownloadAttachmentResponse0\x01BQZOgithub.com/kont1n/MSA_Rocket_Factory/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -828,43 +4520,197 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*Part)(nil),                  // 6: inventory.v1.Part
-	(*Dimensions)(nil),            // 7: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 8: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 9: inventory.v1.Value
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(PartEventType)(0),                 // 0: inventory.v1.PartEventType
+	(AttachmentKind)(0),                // 1: inventory.v1.AttachmentKind
+	(TagMatchMode)(0),                  // 2: inventory.v1.TagMatchMode
+	(MetadataOperator)(0),              // 3: inventory.v1.MetadataOperator
+	(Category)(0),                      // 4: inventory.v1.Category
+	(*GetPartRequest)(nil),             // 5: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),            // 6: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),           // 7: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),          // 8: inventory.v1.ListPartsResponse
+	(*SearchPartsRequest)(nil),         // 9: inventory.v1.SearchPartsRequest
+	(*SearchPartsResponse)(nil),        // 10: inventory.v1.SearchPartsResponse
+	(*SearchResult)(nil),               // 11: inventory.v1.SearchResult
+	(*Highlight)(nil),                  // 12: inventory.v1.Highlight
+	(*WatchPartsRequest)(nil),          // 13: inventory.v1.WatchPartsRequest
+	(*WatchPartsResponse)(nil),         // 14: inventory.v1.WatchPartsResponse
+	(*CreatePartRequest)(nil),          // 15: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),         // 16: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),          // 17: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),         // 18: inventory.v1.UpdatePartResponse
+	(*DeletePartRequest)(nil),          // 19: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),         // 20: inventory.v1.DeletePartResponse
+	(*BatchCreatePartsRequest)(nil),    // 21: inventory.v1.BatchCreatePartsRequest
+	(*BatchCreatePartsResponse)(nil),   // 22: inventory.v1.BatchCreatePartsResponse
+	(*GetPriceHistoryRequest)(nil),     // 23: inventory.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),    // 24: inventory.v1.GetPriceHistoryResponse
+	(*SchedulePriceRequest)(nil),       // 25: inventory.v1.SchedulePriceRequest
+	(*SchedulePriceResponse)(nil),      // 26: inventory.v1.SchedulePriceResponse
+	(*PricePeriod)(nil),                // 27: inventory.v1.PricePeriod
+	(*CreateManufacturerRequest)(nil),  // 28: inventory.v1.CreateManufacturerRequest
+	(*CreateManufacturerResponse)(nil), // 29: inventory.v1.CreateManufacturerResponse
+	(*GetManufacturerRequest)(nil),     // 30: inventory.v1.GetManufacturerRequest
+	(*GetManufacturerResponse)(nil),    // 31: inventory.v1.GetManufacturerResponse
+	(*ListManufacturersRequest)(nil),   // 32: inventory.v1.ListManufacturersRequest
+	(*ListManufacturersResponse)(nil),  // 33: inventory.v1.ListManufacturersResponse
+	(*UpdateManufacturerRequest)(nil),  // 34: inventory.v1.UpdateManufacturerRequest
+	(*UpdateManufacturerResponse)(nil), // 35: inventory.v1.UpdateManufacturerResponse
+	(*DeleteManufacturerRequest)(nil),  // 36: inventory.v1.DeleteManufacturerRequest
+	(*DeleteManufacturerResponse)(nil), // 37: inventory.v1.DeleteManufacturerResponse
+	(*GetCompatiblePartsRequest)(nil),  // 38: inventory.v1.GetCompatiblePartsRequest
+	(*GetCompatiblePartsResponse)(nil), // 39: inventory.v1.GetCompatiblePartsResponse
+	(*ListKitsRequest)(nil),            // 40: inventory.v1.ListKitsRequest
+	(*ListKitsResponse)(nil),           // 41: inventory.v1.ListKitsResponse
+	(*ExpandKitRequest)(nil),           // 42: inventory.v1.ExpandKitRequest
+	(*ExpandKitResponse)(nil),          // 43: inventory.v1.ExpandKitResponse
+	(*UploadAttachmentRequest)(nil),    // 44: inventory.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),   // 45: inventory.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),  // 46: inventory.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil), // 47: inventory.v1.DownloadAttachmentResponse
+	(*TransferStockRequest)(nil),       // 48: inventory.v1.TransferStockRequest
+	(*TransferStockResponse)(nil),      // 49: inventory.v1.TransferStockResponse
+	(*StockTransfer)(nil),              // 50: inventory.v1.StockTransfer
+	(*ListWarehousesRequest)(nil),      // 51: inventory.v1.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),     // 52: inventory.v1.ListWarehousesResponse
+	(*Warehouse)(nil),                  // 53: inventory.v1.Warehouse
+	(*CompatiblePart)(nil),             // 54: inventory.v1.CompatiblePart
+	(*Kit)(nil),                        // 55: inventory.v1.Kit
+	(*KitItem)(nil),                    // 56: inventory.v1.KitItem
+	(*KitLine)(nil),                    // 57: inventory.v1.KitLine
+	(*AttachmentInfo)(nil),             // 58: inventory.v1.AttachmentInfo
+	(*Attachment)(nil),                 // 59: inventory.v1.Attachment
+	(*StockLevel)(nil),                 // 60: inventory.v1.StockLevel
+	(*PartsFilter)(nil),                // 61: inventory.v1.PartsFilter
+	(*MetadataPredicate)(nil),          // 62: inventory.v1.MetadataPredicate
+	(*Part)(nil),                       // 63: inventory.v1.Part
+	(*Dimensions)(nil),                 // 64: inventory.v1.Dimensions
+	(*Manufacturer)(nil),               // 65: inventory.v1.Manufacturer
+	(*Value)(nil),                      // 66: inventory.v1.Value
+	nil,                                // 67: inventory.v1.Part.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 68: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 69: google.protobuf.FieldMask
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	6,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	6,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.PartsFilter.category:type_name -> inventory.v1.Category
-	0,  // 4: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	7,  // 5: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	8,  // 6: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 7: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 8: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	68, // 0: inventory.v1.GetPartRequest.price_at:type_name -> google.protobuf.Timestamp
+	63, // 1: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	61, // 2: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	69, // 3: inventory.v1.ListPartsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	68, // 4: inventory.v1.ListPartsRequest.price_at:type_name -> google.protobuf.Timestamp
+	63, // 5: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	61, // 6: inventory.v1.SearchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	11, // 7: inventory.v1.SearchPartsResponse.results:type_name -> inventory.v1.SearchResult
+	63, // 8: inventory.v1.SearchResult.part:type_name -> inventory.v1.Part
+	12, // 9: inventory.v1.SearchResult.highlights:type_name -> inventory.v1.Highlight
+	61, // 10: inventory.v1.WatchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	0,  // 11: inventory.v1.WatchPartsResponse.type:type_name -> inventory.v1.PartEventType
+	63, // 12: inventory.v1.WatchPartsResponse.part:type_name -> inventory.v1.Part
+	68, // 13: inventory.v1.WatchPartsResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	63, // 14: inventory.v1.CreatePartRequest.part:type_name -> inventory.v1.Part
+	63, // 15: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	63, // 16: inventory.v1.UpdatePartRequest.part:type_name -> inventory.v1.Part
+	69, // 17: inventory.v1.UpdatePartRequest.update_mask:type_name -> google.protobuf.FieldMask
+	63, // 18: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	63, // 19: inventory.v1.BatchCreatePartsRequest.parts:type_name -> inventory.v1.Part
+	63, // 20: inventory.v1.BatchCreatePartsResponse.parts:type_name -> inventory.v1.Part
+	27, // 21: inventory.v1.GetPriceHistoryResponse.prices:type_name -> inventory.v1.PricePeriod
+	68, // 22: inventory.v1.SchedulePriceRequest.effective_from:type_name -> google.protobuf.Timestamp
+	27, // 23: inventory.v1.SchedulePriceResponse.prices:type_name -> inventory.v1.PricePeriod
+	68, // 24: inventory.v1.PricePeriod.effective_from:type_name -> google.protobuf.Timestamp
+	68, // 25: inventory.v1.PricePeriod.effective_to:type_name -> google.protobuf.Timestamp
+	65, // 26: inventory.v1.CreateManufacturerRequest.manufacturer:type_name -> inventory.v1.Manufacturer
+	65, // 27: inventory.v1.CreateManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	65, // 28: inventory.v1.GetManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	65, // 29: inventory.v1.ListManufacturersResponse.manufacturers:type_name -> inventory.v1.Manufacturer
+	65, // 30: inventory.v1.UpdateManufacturerRequest.manufacturer:type_name -> inventory.v1.Manufacturer
+	69, // 31: inventory.v1.UpdateManufacturerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	65, // 32: inventory.v1.UpdateManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	4,  // 33: inventory.v1.GetCompatiblePartsRequest.category:type_name -> inventory.v1.Category
+	54, // 34: inventory.v1.GetCompatiblePartsResponse.compatible_parts:type_name -> inventory.v1.CompatiblePart
+	55, // 35: inventory.v1.ListKitsResponse.kits:type_name -> inventory.v1.Kit
+	55, // 36: inventory.v1.ExpandKitResponse.kit:type_name -> inventory.v1.Kit
+	57, // 37: inventory.v1.ExpandKitResponse.lines:type_name -> inventory.v1.KitLine
+	58, // 38: inventory.v1.UploadAttachmentRequest.info:type_name -> inventory.v1.AttachmentInfo
+	59, // 39: inventory.v1.UploadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	59, // 40: inventory.v1.DownloadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	63, // 41: inventory.v1.TransferStockResponse.part:type_name -> inventory.v1.Part
+	50, // 42: inventory.v1.TransferStockResponse.transfer:type_name -> inventory.v1.StockTransfer
+	68, // 43: inventory.v1.StockTransfer.created_at:type_name -> google.protobuf.Timestamp
+	53, // 44: inventory.v1.ListWarehousesResponse.warehouses:type_name -> inventory.v1.Warehouse
+	63, // 45: inventory.v1.CompatiblePart.part:type_name -> inventory.v1.Part
+	56, // 46: inventory.v1.Kit.items:type_name -> inventory.v1.KitItem
+	63, // 47: inventory.v1.KitLine.part:type_name -> inventory.v1.Part
+	1,  // 48: inventory.v1.AttachmentInfo.kind:type_name -> inventory.v1.AttachmentKind
+	1,  // 49: inventory.v1.Attachment.kind:type_name -> inventory.v1.AttachmentKind
+	68, // 50: inventory.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 51: inventory.v1.PartsFilter.category:type_name -> inventory.v1.Category
+	2,  // 52: inventory.v1.PartsFilter.tag_match:type_name -> inventory.v1.TagMatchMode
+	62, // 53: inventory.v1.PartsFilter.metadata:type_name -> inventory.v1.MetadataPredicate
+	3,  // 54: inventory.v1.MetadataPredicate.operator:type_name -> inventory.v1.MetadataOperator
+	66, // 55: inventory.v1.MetadataPredicate.value:type_name -> inventory.v1.Value
+	4,  // 56: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	64, // 57: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	65, // 58: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	67, // 59: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	68, // 60: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	68, // 61: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	60, // 62: inventory.v1.Part.stock:type_name -> inventory.v1.StockLevel
+	59, // 63: inventory.v1.Part.attachments:type_name -> inventory.v1.Attachment
+	68, // 64: inventory.v1.Manufacturer.created_at:type_name -> google.protobuf.Timestamp
+	68, // 65: inventory.v1.Manufacturer.updated_at:type_name -> google.protobuf.Timestamp
+	66, // 66: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	5,  // 67: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	7,  // 68: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	15, // 69: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	17, // 70: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	19, // 71: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	21, // 72: inventory.v1.InventoryService.BatchCreateParts:input_type -> inventory.v1.BatchCreatePartsRequest
+	9,  // 73: inventory.v1.InventoryService.SearchParts:input_type -> inventory.v1.SearchPartsRequest
+	13, // 74: inventory.v1.InventoryService.WatchParts:input_type -> inventory.v1.WatchPartsRequest
+	48, // 75: inventory.v1.InventoryService.TransferStock:input_type -> inventory.v1.TransferStockRequest
+	51, // 76: inventory.v1.InventoryService.ListWarehouses:input_type -> inventory.v1.ListWarehousesRequest
+	23, // 77: inventory.v1.InventoryService.GetPriceHistory:input_type -> inventory.v1.GetPriceHistoryRequest
+	25, // 78: inventory.v1.InventoryService.SchedulePrice:input_type -> inventory.v1.SchedulePriceRequest
+	28, // 79: inventory.v1.InventoryService.CreateManufacturer:input_type -> inventory.v1.CreateManufacturerRequest
+	30, // 80: inventory.v1.InventoryService.GetManufacturer:input_type -> inventory.v1.GetManufacturerRequest
+	32, // 81: inventory.v1.InventoryService.ListManufacturers:input_type -> inventory.v1.ListManufacturersRequest
+	34, // 82: inventory.v1.InventoryService.UpdateManufacturer:input_type -> inventory.v1.UpdateManufacturerRequest
+	36, // 83: inventory.v1.InventoryService.DeleteManufacturer:input_type -> inventory.v1.DeleteManufacturerRequest
+	38, // 84: inventory.v1.InventoryService.GetCompatibleParts:input_type -> inventory.v1.GetCompatiblePartsRequest
+	40, // 85: inventory.v1.InventoryService.ListKits:input_type -> inventory.v1.ListKitsRequest
+	42, // 86: inventory.v1.InventoryService.ExpandKit:input_type -> inventory.v1.ExpandKitRequest
+	44, // 87: inventory.v1.InventoryService.UploadAttachment:input_type -> inventory.v1.UploadAttachmentRequest
+	46, // 88: inventory.v1.InventoryService.DownloadAttachment:input_type -> inventory.v1.DownloadAttachmentRequest
+	6,  // 89: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	8,  // 90: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	16, // 91: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	18, // 92: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	20, // 93: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	22, // 94: inventory.v1.InventoryService.BatchCreateParts:output_type -> inventory.v1.BatchCreatePartsResponse
+	10, // 95: inventory.v1.InventoryService.SearchParts:output_type -> inventory.v1.SearchPartsResponse
+	14, // 96: inventory.v1.InventoryService.WatchParts:output_type -> inventory.v1.WatchPartsResponse
+	49, // 97: inventory.v1.InventoryService.TransferStock:output_type -> inventory.v1.TransferStockResponse
+	52, // 98: inventory.v1.InventoryService.ListWarehouses:output_type -> inventory.v1.ListWarehousesResponse
+	24, // 99: inventory.v1.InventoryService.GetPriceHistory:output_type -> inventory.v1.GetPriceHistoryResponse
+	26, // 100: inventory.v1.InventoryService.SchedulePrice:output_type -> inventory.v1.SchedulePriceResponse
+	29, // 101: inventory.v1.InventoryService.CreateManufacturer:output_type -> inventory.v1.CreateManufacturerResponse
+	31, // 102: inventory.v1.InventoryService.GetManufacturer:output_type -> inventory.v1.GetManufacturerResponse
+	33, // 103: inventory.v1.InventoryService.ListManufacturers:output_type -> inventory.v1.ListManufacturersResponse
+	35, // 104: inventory.v1.InventoryService.UpdateManufacturer:output_type -> inventory.v1.UpdateManufacturerResponse
+	37, // 105: inventory.v1.InventoryService.DeleteManufacturer:output_type -> inventory.v1.DeleteManufacturerResponse
+	39, // 106: inventory.v1.InventoryService.GetCompatibleParts:output_type -> inventory.v1.GetCompatiblePartsResponse
+	41, // 107: inventory.v1.InventoryService.ListKits:output_type -> inventory.v1.ListKitsResponse
+	43, // 108: inventory.v1.InventoryService.ExpandKit:output_type -> inventory.v1.ExpandKitResponse
+	45, // 109: inventory.v1.InventoryService.UploadAttachment:output_type -> inventory.v1.UploadAttachmentResponse
+	47, // 110: inventory.v1.InventoryService.DownloadAttachment:output_type -> inventory.v1.DownloadAttachmentResponse
+	89, // [89:111] is the sub-list for method output_type
+	67, // [67:89] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -872,7 +4718,17 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[39].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[42].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[56].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[58].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[61].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -883,8 +4739,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      5,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -59,6 +59,35 @@ func (m *GetPartRequest) validate(all bool) error {
 
 	// no validation rules for PartUuid
 
+	if all {
+		switch v := interface{}(m.GetPriceAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPartRequestValidationError{
+					field:  "PriceAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPartRequestValidationError{
+					field:  "PriceAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPartRequestValidationError{
+				field:  "PriceAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetPartRequestMultiError(errors)
 	}
@@ -317,6 +346,70 @@ func (m *ListPartsRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for OrderBy
+
+	if all {
+		switch v := interface{}(m.GetReadMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPartsRequestValidationError{
+					field:  "ReadMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPartsRequestValidationError{
+					field:  "ReadMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPartsRequestValidationError{
+				field:  "ReadMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPartsRequestValidationError{
+					field:  "PriceAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPartsRequestValidationError{
+					field:  "PriceAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPartsRequestValidationError{
+				field:  "PriceAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ListPartsRequestMultiError(errors)
 	}
@@ -330,7 +423,6822 @@ func (m *ListPartsRequest) validate(all bool) error {
 type ListPartsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPartsRequestMultiError) Error() string {
+func (m ListPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPartsRequestMultiError) AllErrors() []error { return m }
+
+// ListPartsRequestValidationError is the validation error returned by
+// ListPartsRequest.Validate if the designated constraints aren't met.
+type ListPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPartsRequestValidationError) ErrorName() string { return "ListPartsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPartsRequestValidationError{}
+
+// Validate checks the field values on ListPartsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPartsResponseMultiError, or nil if none found.
+func (m *ListPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPartsResponseValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPartsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPartsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPartsResponseMultiError) AllErrors() []error { return m }
+
+// ListPartsResponseValidationError is the validation error returned by
+// ListPartsResponse.Validate if the designated constraints aren't met.
+type ListPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPartsResponseValidationError) ErrorName() string {
+	return "ListPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPartsResponseValidationError{}
+
+// Validate checks the field values on SearchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPartsRequestMultiError, or nil if none found.
+func (m *SearchPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPartsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPartsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPartsRequestValidationError is the validation error returned by
+// SearchPartsRequest.Validate if the designated constraints aren't met.
+type SearchPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPartsRequestValidationError) ErrorName() string {
+	return "SearchPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPartsRequestValidationError{}
+
+// Validate checks the field values on SearchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPartsResponseMultiError, or nil if none found.
+func (m *SearchPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPartsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPartsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPartsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchPartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPartsResponseMultiError) AllErrors() []error { return m }
+
+// SearchPartsResponseValidationError is the validation error returned by
+// SearchPartsResponse.Validate if the designated constraints aren't met.
+type SearchPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPartsResponseValidationError) ErrorName() string {
+	return "SearchPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPartsResponseValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchResultValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't met.
+type SearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultMultiError) AllErrors() []error { return m }
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Highlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Highlight with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HighlightMultiError, or nil
+// if none found.
+func (m *Highlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Highlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return HighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// HighlightMultiError is an error wrapping multiple validation errors returned
+// by Highlight.ValidateAll() if the designated constraints aren't met.
+type HighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HighlightMultiError) AllErrors() []error { return m }
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on WatchPartsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPartsRequestMultiError, or nil if none found.
+func (m *WatchPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPartsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPartsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchPartsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchPartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPartsRequestMultiError) AllErrors() []error { return m }
+
+// WatchPartsRequestValidationError is the validation error returned by
+// WatchPartsRequest.Validate if the designated constraints aren't met.
+type WatchPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPartsRequestValidationError) ErrorName() string {
+	return "WatchPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPartsRequestValidationError{}
+
+// Validate checks the field values on WatchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPartsResponseMultiError, or nil if none found.
+func (m *WatchPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPartsResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPartsResponseValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPartsResponseValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchPartsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchPartsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPartsResponseMultiError) AllErrors() []error { return m }
+
+// WatchPartsResponseValidationError is the validation error returned by
+// WatchPartsResponse.Validate if the designated constraints aren't met.
+type WatchPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPartsResponseValidationError) ErrorName() string {
+	return "WatchPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPartsResponseValidationError{}
+
+// Validate checks the field values on CreatePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreatePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePartRequestMultiError, or nil if none found.
+func (m *CreatePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePartRequestValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePartRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePartRequestMultiError) AllErrors() []error { return m }
+
+// CreatePartRequestValidationError is the validation error returned by
+// CreatePartRequest.Validate if the designated constraints aren't met.
+type CreatePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePartRequestValidationError) ErrorName() string {
+	return "CreatePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePartRequestValidationError{}
+
+// Validate checks the field values on CreatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePartResponseMultiError, or nil if none found.
+func (m *CreatePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePartResponseMultiError is an error wrapping multiple validation errors
+// returned by CreatePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePartResponseMultiError) AllErrors() []error { return m }
+
+// CreatePartResponseValidationError is the validation error returned by
+// CreatePartResponse.Validate if the designated constraints aren't met.
+type CreatePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePartResponseValidationError) ErrorName() string {
+	return "CreatePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePartResponseValidationError{}
+
+// Validate checks the field values on UpdatePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePartRequestMultiError, or nil if none found.
+func (m *UpdatePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartRequestValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePartRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdatePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePartRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePartRequestValidationError is the validation error returned by
+// UpdatePartRequest.Validate if the designated constraints aren't met.
+type UpdatePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePartRequestValidationError) ErrorName() string {
+	return "UpdatePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePartRequestValidationError{}
+
+// Validate checks the field values on UpdatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePartResponseMultiError, or nil if none found.
+func (m *UpdatePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePartResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePartResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePartResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdatePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePartResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePartResponseValidationError is the validation error returned by
+// UpdatePartResponse.Validate if the designated constraints aren't met.
+type UpdatePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePartResponseValidationError) ErrorName() string {
+	return "UpdatePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePartResponseValidationError{}
+
+// Validate checks the field values on DeletePartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletePartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePartRequestMultiError, or nil if none found.
+func (m *DeletePartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	if len(errors) > 0 {
+		return DeletePartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePartRequestMultiError is an error wrapping multiple validation errors
+// returned by DeletePartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePartRequestMultiError) AllErrors() []error { return m }
+
+// DeletePartRequestValidationError is the validation error returned by
+// DeletePartRequest.Validate if the designated constraints aren't met.
+type DeletePartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePartRequestValidationError) ErrorName() string {
+	return "DeletePartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePartRequestValidationError{}
+
+// Validate checks the field values on DeletePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePartResponseMultiError, or nil if none found.
+func (m *DeletePartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePartResponseMultiError is an error wrapping multiple validation errors
+// returned by DeletePartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePartResponseMultiError) AllErrors() []error { return m }
+
+// DeletePartResponseValidationError is the validation error returned by
+// DeletePartResponse.Validate if the designated constraints aren't met.
+type DeletePartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePartResponseValidationError) ErrorName() string {
+	return "DeletePartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePartResponseValidationError{}
+
+// Validate checks the field values on BatchCreatePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreatePartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreatePartsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreatePartsRequestMultiError, or nil if none found.
+func (m *BatchCreatePartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreatePartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreatePartsRequestValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreatePartsRequestValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreatePartsRequestValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCreatePartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreatePartsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchCreatePartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreatePartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreatePartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreatePartsRequestMultiError) AllErrors() []error { return m }
+
+// BatchCreatePartsRequestValidationError is the validation error returned by
+// BatchCreatePartsRequest.Validate if the designated constraints aren't met.
+type BatchCreatePartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreatePartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreatePartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreatePartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreatePartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreatePartsRequestValidationError) ErrorName() string {
+	return "BatchCreatePartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreatePartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreatePartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreatePartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreatePartsRequestValidationError{}
+
+// Validate checks the field values on BatchCreatePartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreatePartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreatePartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreatePartsResponseMultiError, or nil if none found.
+func (m *BatchCreatePartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreatePartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreatePartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreatePartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreatePartsResponseValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCreatePartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreatePartsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchCreatePartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreatePartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreatePartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreatePartsResponseMultiError) AllErrors() []error { return m }
+
+// BatchCreatePartsResponseValidationError is the validation error returned by
+// BatchCreatePartsResponse.Validate if the designated constraints aren't met.
+type BatchCreatePartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreatePartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreatePartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreatePartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreatePartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreatePartsResponseValidationError) ErrorName() string {
+	return "BatchCreatePartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreatePartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreatePartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreatePartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreatePartsResponseValidationError{}
+
+// Validate checks the field values on GetPriceHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPriceHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPriceHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPriceHistoryRequestMultiError, or nil if none found.
+func (m *GetPriceHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPriceHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	if len(errors) > 0 {
+		return GetPriceHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPriceHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPriceHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPriceHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPriceHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPriceHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetPriceHistoryRequestValidationError is the validation error returned by
+// GetPriceHistoryRequest.Validate if the designated constraints aren't met.
+type GetPriceHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPriceHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPriceHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPriceHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPriceHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPriceHistoryRequestValidationError) ErrorName() string {
+	return "GetPriceHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPriceHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPriceHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPriceHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPriceHistoryRequestValidationError{}
+
+// Validate checks the field values on GetPriceHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPriceHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPriceHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPriceHistoryResponseMultiError, or nil if none found.
+func (m *GetPriceHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPriceHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPrices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPriceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPriceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPriceHistoryResponseValidationError{
+					field:  fmt.Sprintf("Prices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPriceHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPriceHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPriceHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPriceHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPriceHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPriceHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetPriceHistoryResponseValidationError is the validation error returned by
+// GetPriceHistoryResponse.Validate if the designated constraints aren't met.
+type GetPriceHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPriceHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPriceHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPriceHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPriceHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPriceHistoryResponseValidationError) ErrorName() string {
+	return "GetPriceHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPriceHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPriceHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPriceHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPriceHistoryResponseValidationError{}
+
+// Validate checks the field values on SchedulePriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchedulePriceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchedulePriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchedulePriceRequestMultiError, or nil if none found.
+func (m *SchedulePriceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchedulePriceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for Price
+
+	if all {
+		switch v := interface{}(m.GetEffectiveFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchedulePriceRequestValidationError{
+					field:  "EffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchedulePriceRequestValidationError{
+					field:  "EffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchedulePriceRequestValidationError{
+				field:  "EffectiveFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchedulePriceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchedulePriceRequestMultiError is an error wrapping multiple validation
+// errors returned by SchedulePriceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchedulePriceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchedulePriceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchedulePriceRequestMultiError) AllErrors() []error { return m }
+
+// SchedulePriceRequestValidationError is the validation error returned by
+// SchedulePriceRequest.Validate if the designated constraints aren't met.
+type SchedulePriceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchedulePriceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchedulePriceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchedulePriceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchedulePriceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchedulePriceRequestValidationError) ErrorName() string {
+	return "SchedulePriceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchedulePriceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchedulePriceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchedulePriceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchedulePriceRequestValidationError{}
+
+// Validate checks the field values on SchedulePriceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchedulePriceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchedulePriceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchedulePriceResponseMultiError, or nil if none found.
+func (m *SchedulePriceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchedulePriceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPrices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchedulePriceResponseValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchedulePriceResponseValidationError{
+						field:  fmt.Sprintf("Prices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchedulePriceResponseValidationError{
+					field:  fmt.Sprintf("Prices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchedulePriceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchedulePriceResponseMultiError is an error wrapping multiple validation
+// errors returned by SchedulePriceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchedulePriceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchedulePriceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchedulePriceResponseMultiError) AllErrors() []error { return m }
+
+// SchedulePriceResponseValidationError is the validation error returned by
+// SchedulePriceResponse.Validate if the designated constraints aren't met.
+type SchedulePriceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchedulePriceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchedulePriceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchedulePriceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchedulePriceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchedulePriceResponseValidationError) ErrorName() string {
+	return "SchedulePriceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchedulePriceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchedulePriceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchedulePriceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchedulePriceResponseValidationError{}
+
+// Validate checks the field values on PricePeriod with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PricePeriod) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PricePeriod with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PricePeriodMultiError, or
+// nil if none found.
+func (m *PricePeriod) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PricePeriod) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Price
+
+	if all {
+		switch v := interface{}(m.GetEffectiveFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PricePeriodValidationError{
+					field:  "EffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PricePeriodValidationError{
+					field:  "EffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PricePeriodValidationError{
+				field:  "EffectiveFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEffectiveTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PricePeriodValidationError{
+					field:  "EffectiveTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PricePeriodValidationError{
+					field:  "EffectiveTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PricePeriodValidationError{
+				field:  "EffectiveTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PricePeriodMultiError(errors)
+	}
+
+	return nil
+}
+
+// PricePeriodMultiError is an error wrapping multiple validation errors
+// returned by PricePeriod.ValidateAll() if the designated constraints aren't met.
+type PricePeriodMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PricePeriodMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PricePeriodMultiError) AllErrors() []error { return m }
+
+// PricePeriodValidationError is the validation error returned by
+// PricePeriod.Validate if the designated constraints aren't met.
+type PricePeriodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PricePeriodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PricePeriodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PricePeriodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PricePeriodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PricePeriodValidationError) ErrorName() string { return "PricePeriodValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PricePeriodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPricePeriod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PricePeriodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PricePeriodValidationError{}
+
+// Validate checks the field values on CreateManufacturerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateManufacturerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateManufacturerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateManufacturerRequestMultiError, or nil if none found.
+func (m *CreateManufacturerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateManufacturerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateManufacturerRequestValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateManufacturerRequestValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateManufacturerRequestValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateManufacturerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateManufacturerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateManufacturerRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateManufacturerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateManufacturerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateManufacturerRequestMultiError) AllErrors() []error { return m }
+
+// CreateManufacturerRequestValidationError is the validation error returned by
+// CreateManufacturerRequest.Validate if the designated constraints aren't met.
+type CreateManufacturerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateManufacturerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateManufacturerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateManufacturerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateManufacturerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateManufacturerRequestValidationError) ErrorName() string {
+	return "CreateManufacturerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateManufacturerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateManufacturerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateManufacturerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateManufacturerRequestValidationError{}
+
+// Validate checks the field values on CreateManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateManufacturerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateManufacturerResponseMultiError, or nil if none found.
+func (m *CreateManufacturerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateManufacturerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateManufacturerResponseValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateManufacturerResponseValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateManufacturerResponseValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateManufacturerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateManufacturerResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateManufacturerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateManufacturerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateManufacturerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateManufacturerResponseMultiError) AllErrors() []error { return m }
+
+// CreateManufacturerResponseValidationError is the validation error returned
+// by CreateManufacturerResponse.Validate if the designated constraints aren't met.
+type CreateManufacturerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateManufacturerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateManufacturerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateManufacturerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateManufacturerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateManufacturerResponseValidationError) ErrorName() string {
+	return "CreateManufacturerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateManufacturerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateManufacturerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateManufacturerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateManufacturerResponseValidationError{}
+
+// Validate checks the field values on GetManufacturerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetManufacturerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetManufacturerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetManufacturerRequestMultiError, or nil if none found.
+func (m *GetManufacturerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetManufacturerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ManufacturerUuid
+
+	if len(errors) > 0 {
+		return GetManufacturerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetManufacturerRequestMultiError is an error wrapping multiple validation
+// errors returned by GetManufacturerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetManufacturerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetManufacturerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetManufacturerRequestMultiError) AllErrors() []error { return m }
+
+// GetManufacturerRequestValidationError is the validation error returned by
+// GetManufacturerRequest.Validate if the designated constraints aren't met.
+type GetManufacturerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetManufacturerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetManufacturerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetManufacturerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetManufacturerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetManufacturerRequestValidationError) ErrorName() string {
+	return "GetManufacturerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetManufacturerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetManufacturerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetManufacturerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetManufacturerRequestValidationError{}
+
+// Validate checks the field values on GetManufacturerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetManufacturerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetManufacturerResponseMultiError, or nil if none found.
+func (m *GetManufacturerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetManufacturerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetManufacturerResponseValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetManufacturerResponseValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetManufacturerResponseValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetManufacturerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetManufacturerResponseMultiError is an error wrapping multiple validation
+// errors returned by GetManufacturerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetManufacturerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetManufacturerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetManufacturerResponseMultiError) AllErrors() []error { return m }
+
+// GetManufacturerResponseValidationError is the validation error returned by
+// GetManufacturerResponse.Validate if the designated constraints aren't met.
+type GetManufacturerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetManufacturerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetManufacturerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetManufacturerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetManufacturerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetManufacturerResponseValidationError) ErrorName() string {
+	return "GetManufacturerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetManufacturerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetManufacturerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetManufacturerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetManufacturerResponseValidationError{}
+
+// Validate checks the field values on ListManufacturersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListManufacturersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListManufacturersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListManufacturersRequestMultiError, or nil if none found.
+func (m *ListManufacturersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListManufacturersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListManufacturersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListManufacturersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListManufacturersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListManufacturersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListManufacturersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListManufacturersRequestMultiError) AllErrors() []error { return m }
+
+// ListManufacturersRequestValidationError is the validation error returned by
+// ListManufacturersRequest.Validate if the designated constraints aren't met.
+type ListManufacturersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListManufacturersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListManufacturersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListManufacturersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListManufacturersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListManufacturersRequestValidationError) ErrorName() string {
+	return "ListManufacturersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListManufacturersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListManufacturersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListManufacturersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListManufacturersRequestValidationError{}
+
+// Validate checks the field values on ListManufacturersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListManufacturersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListManufacturersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListManufacturersResponseMultiError, or nil if none found.
+func (m *ListManufacturersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListManufacturersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetManufacturers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListManufacturersResponseValidationError{
+						field:  fmt.Sprintf("Manufacturers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListManufacturersResponseValidationError{
+						field:  fmt.Sprintf("Manufacturers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListManufacturersResponseValidationError{
+					field:  fmt.Sprintf("Manufacturers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListManufacturersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListManufacturersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListManufacturersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListManufacturersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListManufacturersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListManufacturersResponseMultiError) AllErrors() []error { return m }
+
+// ListManufacturersResponseValidationError is the validation error returned by
+// ListManufacturersResponse.Validate if the designated constraints aren't met.
+type ListManufacturersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListManufacturersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListManufacturersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListManufacturersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListManufacturersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListManufacturersResponseValidationError) ErrorName() string {
+	return "ListManufacturersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListManufacturersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListManufacturersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListManufacturersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListManufacturersResponseValidationError{}
+
+// Validate checks the field values on UpdateManufacturerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateManufacturerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateManufacturerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateManufacturerRequestMultiError, or nil if none found.
+func (m *UpdateManufacturerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateManufacturerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateManufacturerRequestValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateManufacturerRequestValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateManufacturerRequestValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateManufacturerRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateManufacturerRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateManufacturerRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateManufacturerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateManufacturerRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateManufacturerRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateManufacturerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateManufacturerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateManufacturerRequestMultiError) AllErrors() []error { return m }
+
+// UpdateManufacturerRequestValidationError is the validation error returned by
+// UpdateManufacturerRequest.Validate if the designated constraints aren't met.
+type UpdateManufacturerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateManufacturerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateManufacturerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateManufacturerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateManufacturerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateManufacturerRequestValidationError) ErrorName() string {
+	return "UpdateManufacturerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateManufacturerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateManufacturerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateManufacturerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateManufacturerRequestValidationError{}
+
+// Validate checks the field values on UpdateManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateManufacturerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateManufacturerResponseMultiError, or nil if none found.
+func (m *UpdateManufacturerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateManufacturerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManufacturer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateManufacturerResponseValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateManufacturerResponseValidationError{
+					field:  "Manufacturer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManufacturer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateManufacturerResponseValidationError{
+				field:  "Manufacturer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateManufacturerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateManufacturerResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateManufacturerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateManufacturerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateManufacturerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateManufacturerResponseMultiError) AllErrors() []error { return m }
+
+// UpdateManufacturerResponseValidationError is the validation error returned
+// by UpdateManufacturerResponse.Validate if the designated constraints aren't met.
+type UpdateManufacturerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateManufacturerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateManufacturerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateManufacturerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateManufacturerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateManufacturerResponseValidationError) ErrorName() string {
+	return "UpdateManufacturerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateManufacturerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateManufacturerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateManufacturerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateManufacturerResponseValidationError{}
+
+// Validate checks the field values on DeleteManufacturerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteManufacturerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteManufacturerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteManufacturerRequestMultiError, or nil if none found.
+func (m *DeleteManufacturerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteManufacturerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ManufacturerUuid
+
+	if len(errors) > 0 {
+		return DeleteManufacturerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteManufacturerRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteManufacturerRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteManufacturerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteManufacturerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteManufacturerRequestMultiError) AllErrors() []error { return m }
+
+// DeleteManufacturerRequestValidationError is the validation error returned by
+// DeleteManufacturerRequest.Validate if the designated constraints aren't met.
+type DeleteManufacturerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteManufacturerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteManufacturerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteManufacturerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteManufacturerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteManufacturerRequestValidationError) ErrorName() string {
+	return "DeleteManufacturerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteManufacturerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteManufacturerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteManufacturerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteManufacturerRequestValidationError{}
+
+// Validate checks the field values on DeleteManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteManufacturerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteManufacturerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteManufacturerResponseMultiError, or nil if none found.
+func (m *DeleteManufacturerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteManufacturerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteManufacturerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteManufacturerResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteManufacturerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteManufacturerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteManufacturerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteManufacturerResponseMultiError) AllErrors() []error { return m }
+
+// DeleteManufacturerResponseValidationError is the validation error returned
+// by DeleteManufacturerResponse.Validate if the designated constraints aren't met.
+type DeleteManufacturerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteManufacturerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteManufacturerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteManufacturerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteManufacturerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteManufacturerResponseValidationError) ErrorName() string {
+	return "DeleteManufacturerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteManufacturerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteManufacturerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteManufacturerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteManufacturerResponseValidationError{}
+
+// Validate checks the field values on GetCompatiblePartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCompatiblePartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCompatiblePartsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCompatiblePartsRequestMultiError, or nil if none found.
+func (m *GetCompatiblePartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCompatiblePartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	if len(errors) > 0 {
+		return GetCompatiblePartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCompatiblePartsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCompatiblePartsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCompatiblePartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCompatiblePartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCompatiblePartsRequestMultiError) AllErrors() []error { return m }
+
+// GetCompatiblePartsRequestValidationError is the validation error returned by
+// GetCompatiblePartsRequest.Validate if the designated constraints aren't met.
+type GetCompatiblePartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCompatiblePartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCompatiblePartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCompatiblePartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCompatiblePartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCompatiblePartsRequestValidationError) ErrorName() string {
+	return "GetCompatiblePartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCompatiblePartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCompatiblePartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCompatiblePartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCompatiblePartsRequestValidationError{}
+
+// Validate checks the field values on GetCompatiblePartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCompatiblePartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCompatiblePartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCompatiblePartsResponseMultiError, or nil if none found.
+func (m *GetCompatiblePartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCompatiblePartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCompatibleParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCompatiblePartsResponseValidationError{
+						field:  fmt.Sprintf("CompatibleParts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCompatiblePartsResponseValidationError{
+						field:  fmt.Sprintf("CompatibleParts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCompatiblePartsResponseValidationError{
+					field:  fmt.Sprintf("CompatibleParts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCompatiblePartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCompatiblePartsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCompatiblePartsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCompatiblePartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCompatiblePartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCompatiblePartsResponseMultiError) AllErrors() []error { return m }
+
+// GetCompatiblePartsResponseValidationError is the validation error returned
+// by GetCompatiblePartsResponse.Validate if the designated constraints aren't met.
+type GetCompatiblePartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCompatiblePartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCompatiblePartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCompatiblePartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCompatiblePartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCompatiblePartsResponseValidationError) ErrorName() string {
+	return "GetCompatiblePartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCompatiblePartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCompatiblePartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCompatiblePartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCompatiblePartsResponseValidationError{}
+
+// Validate checks the field values on ListKitsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListKitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKitsRequestMultiError, or nil if none found.
+func (m *ListKitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListKitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKitsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListKitsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListKitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKitsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKitsRequestMultiError) AllErrors() []error { return m }
+
+// ListKitsRequestValidationError is the validation error returned by
+// ListKitsRequest.Validate if the designated constraints aren't met.
+type ListKitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKitsRequestValidationError) ErrorName() string { return "ListKitsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListKitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKitsRequestValidationError{}
+
+// Validate checks the field values on ListKitsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListKitsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKitsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKitsResponseMultiError, or nil if none found.
+func (m *ListKitsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKitsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListKitsResponseValidationError{
+						field:  fmt.Sprintf("Kits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListKitsResponseValidationError{
+						field:  fmt.Sprintf("Kits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListKitsResponseValidationError{
+					field:  fmt.Sprintf("Kits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListKitsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKitsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListKitsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListKitsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKitsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKitsResponseMultiError) AllErrors() []error { return m }
+
+// ListKitsResponseValidationError is the validation error returned by
+// ListKitsResponse.Validate if the designated constraints aren't met.
+type ListKitsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKitsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKitsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKitsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKitsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKitsResponseValidationError) ErrorName() string { return "ListKitsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListKitsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKitsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKitsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKitsResponseValidationError{}
+
+// Validate checks the field values on ExpandKitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExpandKitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandKitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpandKitRequestMultiError, or nil if none found.
+func (m *ExpandKitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandKitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KitUuid
+
+	if len(errors) > 0 {
+		return ExpandKitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpandKitRequestMultiError is an error wrapping multiple validation errors
+// returned by ExpandKitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExpandKitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpandKitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpandKitRequestMultiError) AllErrors() []error { return m }
+
+// ExpandKitRequestValidationError is the validation error returned by
+// ExpandKitRequest.Validate if the designated constraints aren't met.
+type ExpandKitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpandKitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpandKitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpandKitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpandKitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpandKitRequestValidationError) ErrorName() string { return "ExpandKitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExpandKitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpandKitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpandKitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpandKitRequestValidationError{}
+
+// Validate checks the field values on ExpandKitResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExpandKitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandKitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpandKitResponseMultiError, or nil if none found.
+func (m *ExpandKitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandKitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandKitResponseValidationError{
+					field:  "Kit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandKitResponseValidationError{
+					field:  "Kit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandKitResponseValidationError{
+				field:  "Kit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpandKitResponseValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExpandKitResponseValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExpandKitResponseValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalPrice
+
+	if len(errors) > 0 {
+		return ExpandKitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpandKitResponseMultiError is an error wrapping multiple validation errors
+// returned by ExpandKitResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ExpandKitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpandKitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpandKitResponseMultiError) AllErrors() []error { return m }
+
+// ExpandKitResponseValidationError is the validation error returned by
+// ExpandKitResponse.Validate if the designated constraints aren't met.
+type ExpandKitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpandKitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpandKitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpandKitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpandKitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpandKitResponseValidationError) ErrorName() string {
+	return "ExpandKitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpandKitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpandKitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpandKitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpandKitResponseValidationError{}
+
+// Validate checks the field values on UploadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentRequestMultiError, or nil if none found.
+func (m *UploadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *UploadAttachmentRequest_Info:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadAttachmentRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	case *UploadAttachmentRequest_Chunk:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentRequestValidationError is the validation error returned by
+// UploadAttachmentRequest.Validate if the designated constraints aren't met.
+type UploadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentRequestValidationError) ErrorName() string {
+	return "UploadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentRequestValidationError{}
+
+// Validate checks the field values on UploadAttachmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentResponseMultiError, or nil if none found.
+func (m *UploadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentResponseValidationError is the validation error returned by
+// UploadAttachmentResponse.Validate if the designated constraints aren't met.
+type UploadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentResponseValidationError) ErrorName() string {
+	return "UploadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentResponseValidationError{}
+
+// Validate checks the field values on DownloadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentRequestMultiError, or nil if none found.
+func (m *DownloadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for AttachmentUuid
+
+	if len(errors) > 0 {
+		return DownloadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadAttachmentRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DownloadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentRequestValidationError is the validation error returned by
+// DownloadAttachmentRequest.Validate if the designated constraints aren't met.
+type DownloadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentRequestValidationError) ErrorName() string {
+	return "DownloadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentRequestValidationError{}
+
+// Validate checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentResponseMultiError, or nil if none found.
+func (m *DownloadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *DownloadAttachmentResponse_Attachment:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		if all {
+			switch v := interface{}(m.GetAttachment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Attachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Attachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	case *DownloadAttachmentResponse_Chunk:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentResponseValidationError is the validation error returned
+// by DownloadAttachmentResponse.Validate if the designated constraints aren't met.
+type DownloadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentResponseValidationError) ErrorName() string {
+	return "DownloadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentResponseValidationError{}
+
+// Validate checks the field values on TransferStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferStockRequestMultiError, or nil if none found.
+func (m *TransferStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for FromWarehouse
+
+	// no validation rules for ToWarehouse
+
+	// no validation rules for Quantity
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return TransferStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferStockRequestMultiError is an error wrapping multiple validation
+// errors returned by TransferStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TransferStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferStockRequestMultiError) AllErrors() []error { return m }
+
+// TransferStockRequestValidationError is the validation error returned by
+// TransferStockRequest.Validate if the designated constraints aren't met.
+type TransferStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferStockRequestValidationError) ErrorName() string {
+	return "TransferStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferStockRequestValidationError{}
+
+// Validate checks the field values on TransferStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferStockResponseMultiError, or nil if none found.
+func (m *TransferStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferStockResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferStockResponseValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferStockResponseValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferStockResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferStockResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferStockResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransferStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferStockResponseMultiError is an error wrapping multiple validation
+// errors returned by TransferStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransferStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferStockResponseMultiError) AllErrors() []error { return m }
+
+// TransferStockResponseValidationError is the validation error returned by
+// TransferStockResponse.Validate if the designated constraints aren't met.
+type TransferStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferStockResponseValidationError) ErrorName() string {
+	return "TransferStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferStockResponseValidationError{}
+
+// Validate checks the field values on StockTransfer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockTransfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockTransfer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockTransferMultiError, or
+// nil if none found.
+func (m *StockTransfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockTransfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransferUuid
+
+	// no validation rules for PartUuid
+
+	// no validation rules for FromWarehouse
+
+	// no validation rules for ToWarehouse
+
+	// no validation rules for Quantity
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockTransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockTransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockTransferValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StockTransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockTransferMultiError is an error wrapping multiple validation errors
+// returned by StockTransfer.ValidateAll() if the designated constraints
+// aren't met.
+type StockTransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockTransferMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockTransferMultiError) AllErrors() []error { return m }
+
+// StockTransferValidationError is the validation error returned by
+// StockTransfer.Validate if the designated constraints aren't met.
+type StockTransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockTransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockTransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockTransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockTransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockTransferValidationError) ErrorName() string { return "StockTransferValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockTransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockTransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockTransferValidationError{}
+
+// Validate checks the field values on ListWarehousesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWarehousesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWarehousesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWarehousesRequestMultiError, or nil if none found.
+func (m *ListWarehousesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWarehousesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListWarehousesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWarehousesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWarehousesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWarehousesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWarehousesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWarehousesRequestMultiError) AllErrors() []error { return m }
+
+// ListWarehousesRequestValidationError is the validation error returned by
+// ListWarehousesRequest.Validate if the designated constraints aren't met.
+type ListWarehousesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWarehousesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWarehousesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWarehousesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWarehousesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWarehousesRequestValidationError) ErrorName() string {
+	return "ListWarehousesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWarehousesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWarehousesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWarehousesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWarehousesRequestValidationError{}
+
+// Validate checks the field values on ListWarehousesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWarehousesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWarehousesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWarehousesResponseMultiError, or nil if none found.
+func (m *ListWarehousesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWarehousesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWarehouses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWarehousesResponseValidationError{
+						field:  fmt.Sprintf("Warehouses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWarehousesResponseValidationError{
+						field:  fmt.Sprintf("Warehouses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWarehousesResponseValidationError{
+					field:  fmt.Sprintf("Warehouses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWarehousesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWarehousesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWarehousesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWarehousesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWarehousesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWarehousesResponseMultiError) AllErrors() []error { return m }
+
+// ListWarehousesResponseValidationError is the validation error returned by
+// ListWarehousesResponse.Validate if the designated constraints aren't met.
+type ListWarehousesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWarehousesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWarehousesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWarehousesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWarehousesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWarehousesResponseValidationError) ErrorName() string {
+	return "ListWarehousesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWarehousesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWarehousesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWarehousesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWarehousesResponseValidationError{}
+
+// Validate checks the field values on Warehouse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Warehouse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Warehouse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarehouseMultiError, or nil
+// if none found.
+func (m *Warehouse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Warehouse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for Location
+
+	if len(errors) > 0 {
+		return WarehouseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarehouseMultiError is an error wrapping multiple validation errors returned
+// by Warehouse.ValidateAll() if the designated constraints aren't met.
+type WarehouseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarehouseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarehouseMultiError) AllErrors() []error { return m }
+
+// WarehouseValidationError is the validation error returned by
+// Warehouse.Validate if the designated constraints aren't met.
+type WarehouseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarehouseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarehouseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarehouseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarehouseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarehouseValidationError) ErrorName() string { return "WarehouseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarehouseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarehouse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarehouseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarehouseValidationError{}
+
+// Validate checks the field values on CompatiblePart with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CompatiblePart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompatiblePart with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CompatiblePartMultiError,
+// or nil if none found.
+func (m *CompatiblePart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompatiblePart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompatiblePartValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompatiblePartValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompatiblePartValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return CompatiblePartMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompatiblePartMultiError is an error wrapping multiple validation errors
+// returned by CompatiblePart.ValidateAll() if the designated constraints
+// aren't met.
+type CompatiblePartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompatiblePartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompatiblePartMultiError) AllErrors() []error { return m }
+
+// CompatiblePartValidationError is the validation error returned by
+// CompatiblePart.Validate if the designated constraints aren't met.
+type CompatiblePartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompatiblePartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompatiblePartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompatiblePartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompatiblePartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompatiblePartValidationError) ErrorName() string { return "CompatiblePartValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CompatiblePartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompatiblePart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompatiblePartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompatiblePartValidationError{}
+
+// Validate checks the field values on Kit with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Kit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in KitMultiError, or nil if none found.
+func (m *Kit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KitUuid
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KitValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KitValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KitValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return KitMultiError(errors)
+	}
+
+	return nil
+}
+
+// KitMultiError is an error wrapping multiple validation errors returned by
+// Kit.ValidateAll() if the designated constraints aren't met.
+type KitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KitMultiError) AllErrors() []error { return m }
+
+// KitValidationError is the validation error returned by Kit.Validate if the
+// designated constraints aren't met.
+type KitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KitValidationError) ErrorName() string { return "KitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KitValidationError{}
+
+// Validate checks the field values on KitItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KitItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KitItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in KitItemMultiError, or nil if none found.
+func (m *KitItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KitItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for Quantity
+
+	if len(errors) > 0 {
+		return KitItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// KitItemMultiError is an error wrapping multiple validation errors returned
+// by KitItem.ValidateAll() if the designated constraints aren't met.
+type KitItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KitItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KitItemMultiError) AllErrors() []error { return m }
+
+// KitItemValidationError is the validation error returned by KitItem.Validate
+// if the designated constraints aren't met.
+type KitItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KitItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KitItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KitItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KitItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KitItemValidationError) ErrorName() string { return "KitItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KitItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKitItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KitItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KitItemValidationError{}
+
+// Validate checks the field values on KitLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KitLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KitLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in KitLineMultiError, or nil if none found.
+func (m *KitLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KitLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KitLineValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KitLineValidationError{
+					field:  "Part",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KitLineValidationError{
+				field:  "Part",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Quantity
+
+	if len(errors) > 0 {
+		return KitLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// KitLineMultiError is an error wrapping multiple validation errors returned
+// by KitLine.ValidateAll() if the designated constraints aren't met.
+type KitLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KitLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KitLineMultiError) AllErrors() []error { return m }
+
+// KitLineValidationError is the validation error returned by KitLine.Validate
+// if the designated constraints aren't met.
+type KitLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KitLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KitLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KitLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KitLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KitLineValidationError) ErrorName() string { return "KitLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KitLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKitLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KitLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KitLineValidationError{}
+
+// Validate checks the field values on AttachmentInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttachmentInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachmentInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentInfoMultiError,
+// or nil if none found.
+func (m *AttachmentInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachmentInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Kind
+
+	// no validation rules for ChecksumSha256
+
+	if len(errors) > 0 {
+		return AttachmentInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentInfoMultiError is an error wrapping multiple validation errors
+// returned by AttachmentInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AttachmentInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentInfoMultiError) AllErrors() []error { return m }
+
+// AttachmentInfoValidationError is the validation error returned by
+// AttachmentInfo.Validate if the designated constraints aren't met.
+type AttachmentInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentInfoValidationError) ErrorName() string { return "AttachmentInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachmentInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentInfoValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AttachmentUuid
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Kind
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for ChecksumSha256
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttachmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttachmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttachmentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on StockLevel with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockLevel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockLevel with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockLevelMultiError, or
+// nil if none found.
+func (m *StockLevel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockLevel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Warehouse
+
+	// no validation rules for Quantity
+
+	if len(errors) > 0 {
+		return StockLevelMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockLevelMultiError is an error wrapping multiple validation errors
+// returned by StockLevel.ValidateAll() if the designated constraints aren't met.
+type StockLevelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockLevelMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -339,11 +7247,11 @@ func (m ListPartsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPartsRequestMultiError) AllErrors() []error { return m }
+func (m StockLevelMultiError) AllErrors() []error { return m }
 
-// ListPartsRequestValidationError is the validation error returned by
-// ListPartsRequest.Validate if the designated constraints aren't met.
-type ListPartsRequestValidationError struct {
+// StockLevelValidationError is the validation error returned by
+// StockLevel.Validate if the designated constraints aren't met.
+type StockLevelValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -351,22 +7259,22 @@ type ListPartsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPartsRequestValidationError) Field() string { return e.field }
+func (e StockLevelValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPartsRequestValidationError) Reason() string { return e.reason }
+func (e StockLevelValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPartsRequestValidationError) Cause() error { return e.cause }
+func (e StockLevelValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPartsRequestValidationError) Key() bool { return e.key }
+func (e StockLevelValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPartsRequestValidationError) ErrorName() string { return "ListPartsRequestValidationError" }
+func (e StockLevelValidationError) ErrorName() string { return "StockLevelValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPartsRequestValidationError) Error() string {
+func (e StockLevelValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -378,14 +7286,14 @@ func (e ListPartsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPartsRequest.%s: %s%s",
+		"invalid %sStockLevel.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPartsRequestValidationError{}
+var _ error = StockLevelValidationError{}
 
 var _ interface {
 	Field() string
@@ -393,47 +7301,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPartsRequestValidationError{}
+} = StockLevelValidationError{}
 
-// Validate checks the field values on ListPartsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListPartsResponse) Validate() error {
+// Validate checks the field values on PartsFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartsFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPartsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListPartsResponseMultiError, or nil if none found.
-func (m *ListPartsResponse) ValidateAll() error {
+// ValidateAll checks the field values on PartsFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartsFilterMultiError, or
+// nil if none found.
+func (m *PartsFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPartsResponse) validate(all bool) error {
+func (m *PartsFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetParts() {
+	// no validation rules for InStockOnly
+
+	// no validation rules for TagMatch
+
+	for idx, item := range m.GetMetadata() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPartsResponseValidationError{
-						field:  fmt.Sprintf("Parts[%v]", idx),
+					errors = append(errors, PartsFilterValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPartsResponseValidationError{
-						field:  fmt.Sprintf("Parts[%v]", idx),
+					errors = append(errors, PartsFilterValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -441,8 +7353,8 @@ func (m *ListPartsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListPartsResponseValidationError{
-					field:  fmt.Sprintf("Parts[%v]", idx),
+				return PartsFilterValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -451,20 +7363,31 @@ func (m *ListPartsResponse) validate(all bool) error {
 
 	}
 
+	if m.PriceMin != nil {
+		// no validation rules for PriceMin
+	}
+
+	if m.PriceMax != nil {
+		// no validation rules for PriceMax
+	}
+
+	if m.MinStock != nil {
+		// no validation rules for MinStock
+	}
+
 	if len(errors) > 0 {
-		return ListPartsResponseMultiError(errors)
+		return PartsFilterMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPartsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListPartsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListPartsResponseMultiError []error
+// PartsFilterMultiError is an error wrapping multiple validation errors
+// returned by PartsFilter.ValidateAll() if the designated constraints aren't met.
+type PartsFilterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPartsResponseMultiError) Error() string {
+func (m PartsFilterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -473,11 +7396,11 @@ func (m ListPartsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPartsResponseMultiError) AllErrors() []error { return m }
+func (m PartsFilterMultiError) AllErrors() []error { return m }
 
-// ListPartsResponseValidationError is the validation error returned by
-// ListPartsResponse.Validate if the designated constraints aren't met.
-type ListPartsResponseValidationError struct {
+// PartsFilterValidationError is the validation error returned by
+// PartsFilter.Validate if the designated constraints aren't met.
+type PartsFilterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -485,24 +7408,22 @@ type ListPartsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPartsResponseValidationError) Field() string { return e.field }
+func (e PartsFilterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPartsResponseValidationError) Reason() string { return e.reason }
+func (e PartsFilterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPartsResponseValidationError) Cause() error { return e.cause }
+func (e PartsFilterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPartsResponseValidationError) Key() bool { return e.key }
+func (e PartsFilterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPartsResponseValidationError) ErrorName() string {
-	return "ListPartsResponseValidationError"
-}
+func (e PartsFilterValidationError) ErrorName() string { return "PartsFilterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPartsResponseValidationError) Error() string {
+func (e PartsFilterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -514,14 +7435,14 @@ func (e ListPartsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPartsResponse.%s: %s%s",
+		"invalid %sPartsFilter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPartsResponseValidationError{}
+var _ error = PartsFilterValidationError{}
 
 var _ interface {
 	Field() string
@@ -529,43 +7450,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPartsResponseValidationError{}
+} = PartsFilterValidationError{}
 
-// Validate checks the field values on PartsFilter with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PartsFilter) Validate() error {
+// Validate checks the field values on MetadataPredicate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetadataPredicate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PartsFilter with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PartsFilterMultiError, or
-// nil if none found.
-func (m *PartsFilter) ValidateAll() error {
+// ValidateAll checks the field values on MetadataPredicate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetadataPredicateMultiError, or nil if none found.
+func (m *MetadataPredicate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PartsFilter) validate(all bool) error {
+func (m *MetadataPredicate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Key
+
+	// no validation rules for Operator
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetadataPredicateValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetadataPredicateValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetadataPredicateValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return PartsFilterMultiError(errors)
+		return MetadataPredicateMultiError(errors)
 	}
 
 	return nil
 }
 
-// PartsFilterMultiError is an error wrapping multiple validation errors
-// returned by PartsFilter.ValidateAll() if the designated constraints aren't met.
-type PartsFilterMultiError []error
+// MetadataPredicateMultiError is an error wrapping multiple validation errors
+// returned by MetadataPredicate.ValidateAll() if the designated constraints
+// aren't met.
+type MetadataPredicateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PartsFilterMultiError) Error() string {
+func (m MetadataPredicateMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -574,11 +7529,11 @@ func (m PartsFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PartsFilterMultiError) AllErrors() []error { return m }
+func (m MetadataPredicateMultiError) AllErrors() []error { return m }
 
-// PartsFilterValidationError is the validation error returned by
-// PartsFilter.Validate if the designated constraints aren't met.
-type PartsFilterValidationError struct {
+// MetadataPredicateValidationError is the validation error returned by
+// MetadataPredicate.Validate if the designated constraints aren't met.
+type MetadataPredicateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -586,22 +7541,24 @@ type PartsFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e PartsFilterValidationError) Field() string { return e.field }
+func (e MetadataPredicateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PartsFilterValidationError) Reason() string { return e.reason }
+func (e MetadataPredicateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PartsFilterValidationError) Cause() error { return e.cause }
+func (e MetadataPredicateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PartsFilterValidationError) Key() bool { return e.key }
+func (e MetadataPredicateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PartsFilterValidationError) ErrorName() string { return "PartsFilterValidationError" }
+func (e MetadataPredicateValidationError) ErrorName() string {
+	return "MetadataPredicateValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PartsFilterValidationError) Error() string {
+func (e MetadataPredicateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -613,14 +7570,14 @@ func (e PartsFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPartsFilter.%s: %s%s",
+		"invalid %sMetadataPredicate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PartsFilterValidationError{}
+var _ error = MetadataPredicateValidationError{}
 
 var _ interface {
 	Field() string
@@ -628,7 +7585,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PartsFilterValidationError{}
+} = MetadataPredicateValidationError{}
 
 // Validate checks the field values on Part with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
@@ -825,6 +7782,80 @@ func (m *Part) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetStock() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PartValidationError{
+						field:  fmt.Sprintf("Stock[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PartValidationError{
+						field:  fmt.Sprintf("Stock[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PartValidationError{
+					field:  fmt.Sprintf("Stock[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ManufacturerUuid
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PartValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PartValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PartValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReorderThreshold != nil {
+		// no validation rules for ReorderThreshold
+	}
+
 	if len(errors) > 0 {
 		return PartMultiError(errors)
 	}
@@ -1037,6 +8068,66 @@ func (m *Manufacturer) validate(all bool) error {
 
 	// no validation rules for Url
 
+	// no validation rules for ManufacturerUuid
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ManufacturerValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ManufacturerValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ManufacturerValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ManufacturerValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ManufacturerValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ManufacturerValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ManufacturerMultiError(errors)
 	}